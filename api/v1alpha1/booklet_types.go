@@ -31,10 +31,25 @@ type BookletSpec struct {
 	Defaults   map[string]string  `json:"-"` // TODO: expand
 }
 
+// Colocation strategies.
+const (
+	// ColocationStrategyPod runs the group's modules as containers of one Pod.
+	ColocationStrategyPod = "Pod"
+	// ColocationStrategyNode schedules the group's Pods onto the same node.
+	ColocationStrategyNode = "Node"
+	// ColocationStrategyNone keeps the group declared but applies no affinity.
+	ColocationStrategyNone = "None"
+)
+
 type ColocationGroup struct {
 	Name     string   `json:"name"`
 	Modules  []string `json:"modules"`
-	Strategy string   `json:"strategy"` // "Node" or "Pod"
+	Strategy string   `json:"strategy"` // "Node", "Pod", or "None"
+
+	// UDSOnly publishes bindings between members of a Pod group as unix socket
+	// endpoints and creates no Service for them. Consumers outside the group still
+	// reach the provider through its Service.
+	UDSOnly bool `json:"udsOnly,omitempty"`
 }
 
 type BookletModuleRef struct {
@@ -32,8 +32,31 @@ type ObjectRef struct {
 	Name string `json:"name"`
 }
 
+// Endpoint types reported on EndpointRef.
+const (
+	// EndpointTypeKubernetesService is a Service name and port inside the namespace.
+	EndpointTypeKubernetesService = "kubernetesService"
+	EndpointTypeURL               = "url"
+	// EndpointTypeUnixSocket is a socket path on the Pod's shared UDS volume; only
+	// containers of the same Pod can reach it.
+	EndpointTypeUnixSocket = "unixSocket"
+	// EndpointTypeExternal is an out-of-cluster address published verbatim from
+	// ModuleManifest spec.external.
+	EndpointTypeExternal = "external"
+)
+
+// Endpoint schemes reported on EndpointRef.
+const (
+	EndpointSchemeGRPC  = "grpc"
+	EndpointSchemeGRPCS = "grpcs"
+)
+
 type EndpointRef struct {
 	Type  string `json:"type,omitempty"`
 	Value string `json:"value,omitempty"`
 	Port  int32  `json:"port,omitempty"`
+
+	// Scheme is "grpcs" when the provider serves TLS, otherwise "grpc". External
+	// endpoints report spec.external.scheme or their URI scheme.
+	Scheme string `json:"scheme,omitempty"`
 }
@@ -28,6 +28,7 @@ type ModuleManifest struct {
 type ModuleManifestSpec struct {
 	Module     ModuleIdentity       `json:"module"`
 	Runtime    *ModuleRuntimeSpec   `json:"runtime,omitempty"`
+	External   *ModuleExternalSpec  `json:"external,omitempty"`
 	Provides   []ProvidedCapability `json:"provides"`
 	Requires   []RequiredCapability `json:"requires"`
 	Scaling    ModuleScaling        `json:"scaling"`
@@ -63,6 +64,73 @@ type ModuleRuntimeSpec struct {
 
 	// PreStopCommand runs as a PreStop hook via `/bin/sh -c <command>`.
 	PreStopCommand string `json:"preStopCommand,omitempty"`
+
+	// ImagePullSecrets name Secrets in the module's namespace used to pull Image
+	// from a private registry.
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// Replicas is the number of pods to run per workload. Defaults to 1.
+	//
+	// Values above 1 are only honored for stateless modules.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// TLS, when set, mounts a certificate Secret into the container and has the
+	// module server listen with TLS.
+	TLS *ModuleTLSSpec `json:"tls,omitempty"`
+
+	// SecurityContext hardens the module container. When unset (or for unset
+	// fields) the container runs as a non-root user without privilege escalation
+	// and with all capabilities dropped.
+	SecurityContext *ModuleSecurityContext `json:"securityContext,omitempty"`
+}
+
+// DefaultModuleRunAsUser is the UID module containers run as when RunAsNonRoot is
+// in effect and RunAsUser is unset. It matches the distroless nonroot user.
+const DefaultModuleRunAsUser int64 = 65532
+
+// ModuleSecurityContext is the subset of the container security context a module
+// may tune.
+type ModuleSecurityContext struct {
+	// RunAsNonRoot refuses to start the container as UID 0. Defaults to true.
+	RunAsNonRoot *bool `json:"runAsNonRoot,omitempty"`
+
+	// RunAsUser is the container UID. Defaults to DefaultModuleRunAsUser while
+	// RunAsNonRoot is true, so images that name their user still pass the check.
+	RunAsUser *int64 `json:"runAsUser,omitempty"`
+
+	// ReadOnlyRootFilesystem mounts the container's root filesystem read-only.
+	ReadOnlyRootFilesystem bool `json:"readOnlyRootFilesystem,omitempty"`
+
+	// AllowPrivilegeEscalation defaults to false.
+	AllowPrivilegeEscalation *bool `json:"allowPrivilegeEscalation,omitempty"`
+
+	// DropCapabilities lists Linux capabilities to drop. Defaults to ["ALL"].
+	DropCapabilities []string `json:"dropCapabilities,omitempty"`
+
+	// AddCapabilities lists Linux capabilities to add back, e.g. NET_BIND_SERVICE.
+	AddCapabilities []string `json:"addCapabilities,omitempty"`
+}
+
+// ModuleTLSSpec declares the certificate a module server presents.
+type ModuleTLSSpec struct {
+	// SecretName names a kubernetes.io/tls Secret in the module's namespace
+	// (keys tls.crt and tls.key).
+	SecretName string `json:"secretName"`
+
+	// ClientAuth requires clients to present a certificate signed by the Secret's
+	// ca.crt (mutual TLS).
+	ClientAuth bool `json:"clientAuth,omitempty"`
+}
+
+// ModuleExternalSpec declares a provider that runs outside the cluster, such as a
+// managed message bus. The RuntimeOrchestrator publishes Endpoint verbatim and
+// creates no workload or Service; it takes precedence over runtime.
+type ModuleExternalSpec struct {
+	// Endpoint is host:port or a URI (e.g. nats://bus.example.com:4222).
+	Endpoint string `json:"endpoint"`
+
+	// Scheme is reported on the published endpoint. Defaults to grpc.
+	Scheme string `json:"scheme,omitempty"`
 }
 
 type ModuleIdentity struct {
@@ -75,6 +143,10 @@ type ProvidedCapability struct {
 	Version      string                 `json:"version"`
 	Scope        CapabilityScope        `json:"scope"`
 	Multiplicity CapabilityMultiplicity `json:"multiplicity"`
+
+	// Deprecated marks the provider as a last resort: the resolver only selects it
+	// when no non-deprecated provider satisfies the requirement.
+	Deprecated bool `json:"deprecated,omitempty"`
 }
 
 type RequiredCapability struct {
@@ -83,16 +155,51 @@ type RequiredCapability struct {
 	Scope             CapabilityScope        `json:"scope"`
 	Multiplicity      CapabilityMultiplicity `json:"multiplicity"`
 	DependencyMode    DependencyMode         `json:"dependencyMode"`
+
+	// RealmRef restricts the requirement to providers contributed by the named Realm,
+	// for worlds that can reach more than one realm's services.
+	RealmRef *ObjectRef `json:"realmRef,omitempty"`
 }
 
+const (
+	StatefulnessStateless = "stateless"
+	StatefulnessStateful  = "stateful"
+)
+
 type ModuleScaling struct {
 	DefaultScope CapabilityScope `json:"defaultScope"`
 	Statefulness string          `json:"statefulness"`
 }
 
+const (
+	ModuleManifestPhasePending  = "Pending"
+	ModuleManifestPhaseResolved = "Resolved"
+	ModuleManifestPhaseError    = "Error"
+)
+
 type ModuleManifestStatus struct {
-	Phase   string `json:"phase,omitempty"`
-	Message string `json:"message,omitempty"`
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	Phase              string `json:"phase,omitempty"`
+	Message            string `json:"message,omitempty"`
+
+	// Requirements reports, for each entry in spec.requires, whether a compatible provider
+	// is currently installed in the manifest's namespace.
+	Requirements []RequirementStatus `json:"requirements,omitempty"`
+}
+
+type RequirementStatus struct {
+	CapabilityID      string          `json:"capabilityId"`
+	VersionConstraint string          `json:"versionConstraint,omitempty"`
+	Scope             CapabilityScope `json:"scope,omitempty"`
+	DependencyMode    DependencyMode  `json:"dependencyMode,omitempty"`
+	Satisfied         bool            `json:"satisfied"`
+
+	// Provider and ProviderVersion name the provider the resolver would select today.
+	Provider        string `json:"provider,omitempty"`
+	ProviderVersion string `json:"providerVersion,omitempty"`
+
+	// Reason explains why the requirement is unsatisfied.
+	Reason string `json:"reason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,120 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/bayleafwalker/bindery-core/internal/semver"
+)
+
+var (
+	validScopes = []string{
+		string(CapabilityScopeCluster),
+		string(CapabilityScopeRegion),
+		string(CapabilityScopeRealm),
+		string(CapabilityScopeWorld),
+		string(CapabilityScopeWorldShard),
+		string(CapabilityScopeSession),
+	}
+	validMultiplicities  = []string{string(MultiplicityOne), string(MultiplicityMany)}
+	validDependencyModes = []string{string(DependencyModeRequired), string(DependencyModeOptional)}
+)
+
+// ModuleManifestValidator rejects manifests whose provides/requires entries the resolver
+// could never match, so mistakes surface at apply time instead of per world.
+//
+// +kubebuilder:webhook:path=/validate-bindery-platform-v1alpha1-modulemanifest,mutating=false,failurePolicy=fail,sideEffects=None,groups=bindery.platform,resources=modulemanifests,verbs=create;update,versions=v1alpha1,name=vmodulemanifest.bindery.platform,admissionReviewVersions=v1
+type ModuleManifestValidator struct{}
+
+var _ webhook.CustomValidator = &ModuleManifestValidator{}
+
+// SetupModuleManifestWebhookWithManager registers the ModuleManifest validating webhook.
+func SetupModuleManifestWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&ModuleManifest{}).
+		WithValidator(&ModuleManifestValidator{}).
+		Complete()
+}
+
+func (v *ModuleManifestValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, validateModuleManifestObject(obj)
+}
+
+func (v *ModuleManifestValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, validateModuleManifestObject(newObj)
+}
+
+func (v *ModuleManifestValidator) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateModuleManifestObject(obj runtime.Object) error {
+	mm, ok := obj.(*ModuleManifest)
+	if !ok {
+		return fmt.Errorf("expected a ModuleManifest, got %T", obj)
+	}
+	if errs := ValidateModuleManifestCapabilities(&mm.Spec); len(errs) > 0 {
+		return apierrors.NewInvalid(GroupVersion.WithKind("ModuleManifest").GroupKind(), mm.Name, errs)
+	}
+	return nil
+}
+
+// ValidateModuleManifestCapabilities checks every provided and required capability for an
+// empty capabilityId, an unparseable version or versionConstraint, and unknown enum values.
+func ValidateModuleManifestCapabilities(spec *ModuleManifestSpec) field.ErrorList {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	for i, p := range spec.Provides {
+		path := specPath.Child("provides").Index(i)
+		errs = append(errs, validateCapabilityID(path, p.CapabilityID)...)
+		if strings.TrimSpace(p.Version) == "" {
+			errs = append(errs, field.Required(path.Child("version"), ""))
+		} else if _, err := semver.ParseVersion(p.Version); err != nil {
+			errs = append(errs, field.Invalid(path.Child("version"), p.Version, err.Error()))
+		}
+		errs = append(errs, validateEnum(path.Child("scope"), string(p.Scope), validScopes)...)
+		errs = append(errs, validateEnum(path.Child("multiplicity"), string(p.Multiplicity), validMultiplicities)...)
+	}
+
+	for i, r := range spec.Requires {
+		path := specPath.Child("requires").Index(i)
+		errs = append(errs, validateCapabilityID(path, r.CapabilityID)...)
+		if strings.TrimSpace(r.VersionConstraint) == "" {
+			errs = append(errs, field.Required(path.Child("versionConstraint"), ""))
+		} else if _, err := semver.ParseConstraint(r.VersionConstraint); err != nil {
+			errs = append(errs, field.Invalid(path.Child("versionConstraint"), r.VersionConstraint, err.Error()))
+		}
+		errs = append(errs, validateEnum(path.Child("scope"), string(r.Scope), validScopes)...)
+		errs = append(errs, validateEnum(path.Child("multiplicity"), string(r.Multiplicity), validMultiplicities)...)
+		errs = append(errs, validateEnum(path.Child("dependencyMode"), string(r.DependencyMode), validDependencyModes)...)
+	}
+	return errs
+}
+
+func validateCapabilityID(path *field.Path, id string) field.ErrorList {
+	if strings.TrimSpace(id) == "" {
+		return field.ErrorList{field.Required(path.Child("capabilityId"), "")}
+	}
+	return nil
+}
+
+func validateEnum(path *field.Path, value string, allowed []string) field.ErrorList {
+	if value == "" {
+		return field.ErrorList{field.Required(path, "")}
+	}
+	for _, a := range allowed {
+		if value == a {
+			return nil
+		}
+	}
+	return field.ErrorList{field.NotSupported(path, value, allowed)}
+}
@@ -0,0 +1,77 @@
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func validModuleManifest() *ModuleManifest {
+	return &ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "physics", Namespace: "default"},
+		Spec: ModuleManifestSpec{
+			Module: ModuleIdentity{ID: "physics", Version: "1.0.0"},
+			Provides: []ProvidedCapability{{
+				CapabilityID: "physics.engine",
+				Version:      "1.2.0",
+				Scope:        CapabilityScopeWorld,
+				Multiplicity: MultiplicityOne,
+			}},
+			Requires: []RequiredCapability{{
+				CapabilityID:      "bus.events",
+				VersionConstraint: "^1.0.0",
+				Scope:             CapabilityScopeRealm,
+				Multiplicity:      MultiplicityOne,
+				DependencyMode:    DependencyModeRequired,
+			}},
+		},
+	}
+}
+
+func TestModuleManifestValidator_AcceptsValidManifest(t *testing.T) {
+	v := &ModuleManifestValidator{}
+	if _, err := v.ValidateCreate(context.Background(), validModuleManifest()); err != nil {
+		t.Fatalf("expected valid manifest to be admitted: %v", err)
+	}
+}
+
+func TestModuleManifestValidator_RejectsInvalidCapabilities(t *testing.T) {
+	cases := []struct {
+		name   string
+		mutate func(*ModuleManifest)
+		field  string
+	}{
+		{"empty provided capabilityId", func(m *ModuleManifest) { m.Spec.Provides[0].CapabilityID = "" }, "spec.provides[0].capabilityId"},
+		{"empty required capabilityId", func(m *ModuleManifest) { m.Spec.Requires[0].CapabilityID = " " }, "spec.requires[0].capabilityId"},
+		{"unparseable version", func(m *ModuleManifest) { m.Spec.Provides[0].Version = "one.two" }, "spec.provides[0].version"},
+		{"unparseable constraint", func(m *ModuleManifest) { m.Spec.Requires[0].VersionConstraint = ">>1" }, "spec.requires[0].versionConstraint"},
+		{"unknown provided scope", func(m *ModuleManifest) { m.Spec.Provides[0].Scope = "wrold" }, "spec.provides[0].scope"},
+		{"unknown required scope", func(m *ModuleManifest) { m.Spec.Requires[0].Scope = "galaxy" }, "spec.requires[0].scope"},
+		{"unknown provided multiplicity", func(m *ModuleManifest) { m.Spec.Provides[0].Multiplicity = "2" }, "spec.provides[0].multiplicity"},
+		{"unknown required multiplicity", func(m *ModuleManifest) { m.Spec.Requires[0].Multiplicity = "several" }, "spec.requires[0].multiplicity"},
+		{"unknown dependencyMode", func(m *ModuleManifest) { m.Spec.Requires[0].DependencyMode = "soft" }, "spec.requires[0].dependencyMode"},
+	}
+
+	v := &ModuleManifestValidator{}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mm := validModuleManifest()
+			tc.mutate(mm)
+
+			_, err := v.ValidateCreate(context.Background(), mm)
+			if err == nil {
+				t.Fatalf("expected %s to be rejected", tc.name)
+			}
+			if !strings.Contains(err.Error(), tc.field) {
+				t.Fatalf("expected error to name %s, got %v", tc.field, err)
+			}
+
+			old := validModuleManifest()
+			if _, err := v.ValidateUpdate(context.Background(), old, mm); err == nil {
+				t.Fatalf("expected update with %s to be rejected", tc.name)
+			}
+		})
+	}
+}
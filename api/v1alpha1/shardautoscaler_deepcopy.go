@@ -67,6 +67,11 @@ func (in *ShardAutoscalerList) DeepCopyObject() runtime.Object {
 func (in *ShardAutoscalerSpec) DeepCopyInto(out *ShardAutoscalerSpec) {
 	*out = *in
 	out.WorldRef = in.WorldRef
+	if in.StabilizationWindowSeconds != nil {
+		in, out := &in.StabilizationWindowSeconds, &out.StabilizationWindowSeconds
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Metrics != nil {
 		out.Metrics = make([]MetricSpec, len(in.Metrics))
 		for i := range in.Metrics {
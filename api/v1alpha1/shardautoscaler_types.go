@@ -27,13 +27,21 @@ type ShardAutoscalerSpec struct {
 	WorldRef ObjectRef `json:"worldRef"`
 
 	// MinShards is the minimum number of shards.
-	// +kubebuilder:validation:Minimum=1
+	//
+	// Zero enables scale-to-zero: a world with no recorded activity for the
+	// stabilization window is paused, and resumed when activity is recorded again.
+	// +kubebuilder:validation:Minimum=0
 	MinShards int32 `json:"minShards"`
 
 	// MaxShards is the maximum number of shards.
 	// +kubebuilder:validation:Minimum=1
 	MaxShards int32 `json:"maxShards"`
 
+	// StabilizationWindowSeconds is how long a world must stay idle before it is
+	// scaled to zero, and how long it stays up after being activated. Defaults to 300.
+	// +kubebuilder:validation:Minimum=0
+	StabilizationWindowSeconds *int32 `json:"stabilizationWindowSeconds,omitempty"`
+
 	// Metrics defines the metrics to use for scaling.
 	// Currently only supports CPU and Memory utilization.
 	Metrics []MetricSpec `json:"metrics,omitempty"`
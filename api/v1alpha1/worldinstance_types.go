@@ -27,18 +27,78 @@ type WorldInstanceSpec struct {
 	GameRef ObjectRef `json:"gameRef"`
 	// RealmRef identifies the Realm this world belongs to.
 	// If not specified, the world is considered standalone or part of a default realm.
-	RealmRef     *ObjectRef `json:"realmRef,omitempty"`
-	WorldID      string     `json:"worldId"`
-	Region       string     `json:"region"`
-	ShardCount   int32      `json:"shardCount"`
-	DesiredState string     `json:"desiredState,omitempty"`
+	RealmRef   *ObjectRef `json:"realmRef,omitempty"`
+	WorldID    string     `json:"worldId"`
+	Region     string     `json:"region"`
+	ShardCount int32      `json:"shardCount"`
+	// DesiredState is Running (default), Paused, or Stopped.
+	// Paused scales the world's workloads to zero but keeps bindings and storage.
+	DesiredState string `json:"desiredState,omitempty"`
 }
 
+// DefaultShardCount is the shard count of a world that does not set spec.shardCount.
+const DefaultShardCount int32 = 1
+
+// EffectiveShardCount returns ShardCount, or DefaultShardCount when it is unset.
+// The defaulting webhook persists the same value; controllers use this so worlds
+// admitted without the webhook behave identically.
+func (s WorldInstanceSpec) EffectiveShardCount() int32 {
+	if s.ShardCount < 1 {
+		return DefaultShardCount
+	}
+	return s.ShardCount
+}
+
+const (
+	WorldDesiredStateRunning = "Running"
+	WorldDesiredStatePaused  = "Paused"
+	WorldDesiredStateStopped = "Stopped"
+)
+
 type WorldInstanceStatus struct {
 	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
 	Phase              string             `json:"phase,omitempty"`
 	Message            string             `json:"message,omitempty"`
 	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+
+	// Endpoints aggregates the endpoints published on the world's CapabilityBindings,
+	// sorted by capability, so the world's wiring is visible in one place.
+	Endpoints []WorldEndpointStatus `json:"endpoints,omitempty"`
+
+	// MissingModules lists ModuleManifests referenced by the Booklet or Realm that could
+	// not be loaded. Optional entries do not block resolution but are reported here.
+	MissingModules []MissingModuleRef `json:"missingModules,omitempty"`
+
+	// RuntimeReadyTime is when RuntimeReady first became True. It is set once and
+	// backs the world startup latency metric.
+	RuntimeReadyTime *metav1.Time `json:"runtimeReadyTime,omitempty"`
+}
+
+// Sources of a MissingModuleRef.
+const (
+	ModuleRefSourceBooklet = "Booklet"
+	ModuleRefSourceRealm   = "Realm"
+)
+
+// MissingModuleRef is a referenced ModuleManifest that was not found.
+type MissingModuleRef struct {
+	Name     string `json:"name"`
+	Required bool   `json:"required"`
+	// Source is Booklet or Realm.
+	Source string `json:"source"`
+}
+
+// WorldEndpointStatus is one capability endpoint reachable from a world.
+type WorldEndpointStatus struct {
+	CapabilityID string          `json:"capabilityId"`
+	Scope        CapabilityScope `json:"scope,omitempty"`
+	// Provider is the ModuleManifest serving the capability.
+	Provider string `json:"provider,omitempty"`
+	// Endpoint is host:port as injected into consumers.
+	Endpoint string `json:"endpoint"`
+	Scheme   string `json:"scheme,omitempty"`
+	// Serving mirrors the binding's EndpointServing condition.
+	Serving bool `json:"serving"`
 }
 
 // +kubebuilder:object:root=true
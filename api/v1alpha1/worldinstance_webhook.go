@@ -0,0 +1,71 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// WorldInstanceWebhook defaults spec.shardCount and validates spec.region at admission.
+//
+// +kubebuilder:webhook:path=/mutate-bindery-platform-v1alpha1-worldinstance,mutating=true,failurePolicy=fail,sideEffects=None,groups=bindery.platform,resources=worldinstances,verbs=create;update,versions=v1alpha1,name=mworldinstance.bindery.platform,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-bindery-platform-v1alpha1-worldinstance,mutating=false,failurePolicy=fail,sideEffects=None,groups=bindery.platform,resources=worldinstances,verbs=create;update,versions=v1alpha1,name=vworldinstance.bindery.platform,admissionReviewVersions=v1
+type WorldInstanceWebhook struct{}
+
+var (
+	_ webhook.CustomDefaulter = &WorldInstanceWebhook{}
+	_ webhook.CustomValidator = &WorldInstanceWebhook{}
+)
+
+// SetupWorldInstanceWebhookWithManager registers the WorldInstance defaulting and validating webhooks.
+func SetupWorldInstanceWebhookWithManager(mgr ctrl.Manager) error {
+	w := &WorldInstanceWebhook{}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&WorldInstance{}).
+		WithDefaulter(w).
+		WithValidator(w).
+		Complete()
+}
+
+func (w *WorldInstanceWebhook) Default(_ context.Context, obj runtime.Object) error {
+	world, ok := obj.(*WorldInstance)
+	if !ok {
+		return fmt.Errorf("expected a WorldInstance, got %T", obj)
+	}
+	world.Spec.ShardCount = world.Spec.EffectiveShardCount()
+	return nil
+}
+
+func (w *WorldInstanceWebhook) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, validateWorldInstanceObject(obj)
+}
+
+func (w *WorldInstanceWebhook) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, validateWorldInstanceObject(newObj)
+}
+
+func (w *WorldInstanceWebhook) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateWorldInstanceObject(obj runtime.Object) error {
+	world, ok := obj.(*WorldInstance)
+	if !ok {
+		return fmt.Errorf("expected a WorldInstance, got %T", obj)
+	}
+	var errs field.ErrorList
+	if strings.TrimSpace(world.Spec.Region) == "" {
+		errs = append(errs, field.Required(field.NewPath("spec", "region"), "a world must be placed in a region"))
+	}
+	if len(errs) > 0 {
+		return apierrors.NewInvalid(GroupVersion.WithKind("WorldInstance").GroupKind(), world.Name, errs)
+	}
+	return nil
+}
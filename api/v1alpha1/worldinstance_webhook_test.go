@@ -0,0 +1,51 @@
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWorldInstanceWebhook_DefaultsShardCount(t *testing.T) {
+	w := &WorldInstanceWebhook{}
+	world := &WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "w1"},
+		Spec:       WorldInstanceSpec{WorldID: "w1", Region: "us-test-1"},
+	}
+	if err := w.Default(context.Background(), world); err != nil {
+		t.Fatalf("Default: %v", err)
+	}
+	if world.Spec.ShardCount != 1 {
+		t.Fatalf("expected shardCount defaulted to 1, got %d", world.Spec.ShardCount)
+	}
+
+	world.Spec.ShardCount = 4
+	if err := w.Default(context.Background(), world); err != nil {
+		t.Fatalf("Default: %v", err)
+	}
+	if world.Spec.ShardCount != 4 {
+		t.Fatalf("expected explicit shardCount kept, got %d", world.Spec.ShardCount)
+	}
+}
+
+func TestWorldInstanceWebhook_RejectsEmptyRegion(t *testing.T) {
+	w := &WorldInstanceWebhook{}
+	world := &WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "w1"},
+		Spec:       WorldInstanceSpec{WorldID: "w1", Region: " ", ShardCount: 1},
+	}
+	_, err := w.ValidateCreate(context.Background(), world)
+	if err == nil || !strings.Contains(err.Error(), "spec.region") {
+		t.Fatalf("expected spec.region to be rejected, got %v", err)
+	}
+	if _, err := w.ValidateUpdate(context.Background(), world, world); err == nil {
+		t.Fatalf("expected update with empty region to be rejected")
+	}
+
+	world.Spec.Region = "us-test-1"
+	if _, err := w.ValidateCreate(context.Background(), world); err != nil {
+		t.Fatalf("expected world with region to be admitted: %v", err)
+	}
+}
@@ -63,6 +63,15 @@ type WorldStorageClaimStatus struct {
 	ClaimName        string `json:"claimName,omitempty"`
 	StorageClassName string `json:"storageClassName,omitempty"`
 	ExternalURI      string `json:"externalUri,omitempty"`
+
+	// Capacity is the storage capacity reported by the bound PVC (e.g. "10Gi").
+	// It may differ from spec.size when the provisioner rounds up or a resize is in progress.
+	Capacity string `json:"capacity,omitempty"`
+	// SnapshotName is the VolumeSnapshot created for the most recent
+	// bindery.platform/snapshot-requested annotation value.
+	SnapshotName string `json:"snapshotName,omitempty"`
+	// Conditions mirrors the backing PVC's conditions (e.g. Resizing, FileSystemResizePending).
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
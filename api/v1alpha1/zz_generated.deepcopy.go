@@ -16,7 +16,7 @@ func (in *ModuleManifest) DeepCopyInto(out *ModuleManifest) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleManifest.
@@ -44,13 +44,19 @@ func (in *ModuleManifestSpec) DeepCopyInto(out *ModuleManifestSpec) {
 		out.Runtime = new(ModuleRuntimeSpec)
 		in.Runtime.DeepCopyInto(out.Runtime)
 	}
+	if in.External != nil {
+		out.External = new(ModuleExternalSpec)
+		*out.External = *in.External
+	}
 	if in.Provides != nil {
 		out.Provides = make([]ProvidedCapability, len(in.Provides))
 		copy(out.Provides, in.Provides)
 	}
 	if in.Requires != nil {
 		out.Requires = make([]RequiredCapability, len(in.Requires))
-		copy(out.Requires, in.Requires)
+		for i := range in.Requires {
+			in.Requires[i].DeepCopyInto(&out.Requires[i])
+		}
 	}
 	out.Scaling = in.Scaling
 	in.Scheduling.DeepCopyInto(&out.Scheduling)
@@ -62,8 +68,19 @@ func (in *ModuleManifestSpec) DeepCopyInto(out *ModuleManifestSpec) {
 	}
 }
 
+func (in *RequiredCapability) DeepCopyInto(out *RequiredCapability) {
+	*out = *in
+	if in.RealmRef != nil {
+		out.RealmRef = &ObjectRef{Name: in.RealmRef.Name}
+	}
+}
+
 func (in *ModuleRuntimeSpec) DeepCopyInto(out *ModuleRuntimeSpec) {
 	*out = *in
+	if in.Port != nil {
+		out.Port = new(int32)
+		*out.Port = *in.Port
+	}
 	if in.Command != nil {
 		out.Command = make([]string, len(in.Command))
 		copy(out.Command, in.Command)
@@ -78,6 +95,59 @@ func (in *ModuleRuntimeSpec) DeepCopyInto(out *ModuleRuntimeSpec) {
 			out.Env[k] = v
 		}
 	}
+	if in.TerminationGracePeriodSeconds != nil {
+		out.TerminationGracePeriodSeconds = new(int64)
+		*out.TerminationGracePeriodSeconds = *in.TerminationGracePeriodSeconds
+	}
+	if in.ImagePullSecrets != nil {
+		out.ImagePullSecrets = make([]corev1.LocalObjectReference, len(in.ImagePullSecrets))
+		copy(out.ImagePullSecrets, in.ImagePullSecrets)
+	}
+	if in.Replicas != nil {
+		out.Replicas = new(int32)
+		*out.Replicas = *in.Replicas
+	}
+	if in.TLS != nil {
+		out.TLS = new(ModuleTLSSpec)
+		*out.TLS = *in.TLS
+	}
+	if in.SecurityContext != nil {
+		out.SecurityContext = new(ModuleSecurityContext)
+		in.SecurityContext.DeepCopyInto(out.SecurityContext)
+	}
+}
+
+func (in *ModuleSecurityContext) DeepCopyInto(out *ModuleSecurityContext) {
+	*out = *in
+	if in.RunAsNonRoot != nil {
+		out.RunAsNonRoot = new(bool)
+		*out.RunAsNonRoot = *in.RunAsNonRoot
+	}
+	if in.RunAsUser != nil {
+		out.RunAsUser = new(int64)
+		*out.RunAsUser = *in.RunAsUser
+	}
+	if in.AllowPrivilegeEscalation != nil {
+		out.AllowPrivilegeEscalation = new(bool)
+		*out.AllowPrivilegeEscalation = *in.AllowPrivilegeEscalation
+	}
+	if in.DropCapabilities != nil {
+		out.DropCapabilities = make([]string, len(in.DropCapabilities))
+		copy(out.DropCapabilities, in.DropCapabilities)
+	}
+	if in.AddCapabilities != nil {
+		out.AddCapabilities = make([]string, len(in.AddCapabilities))
+		copy(out.AddCapabilities, in.AddCapabilities)
+	}
+}
+
+func (in *ModuleSecurityContext) DeepCopy() *ModuleSecurityContext {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleSecurityContext)
+	in.DeepCopyInto(out)
+	return out
 }
 
 func (in *ModuleRuntimeSpec) DeepCopy() *ModuleRuntimeSpec {
@@ -89,6 +159,25 @@ func (in *ModuleRuntimeSpec) DeepCopy() *ModuleRuntimeSpec {
 	return out
 }
 
+// DeepCopyInto copies the receiver into out.
+func (in *ModuleManifestStatus) DeepCopyInto(out *ModuleManifestStatus) {
+	*out = *in
+	if in.Requirements != nil {
+		out.Requirements = make([]RequirementStatus, len(in.Requirements))
+		copy(out.Requirements, in.Requirements)
+	}
+}
+
+// DeepCopy creates a new ModuleManifestStatus.
+func (in *ModuleManifestStatus) DeepCopy() *ModuleManifestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleManifestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto copies the receiver into out.
 func (in *ModuleManifestList) DeepCopyInto(out *ModuleManifestList) {
 	*out = *in
@@ -202,6 +291,17 @@ func (in *WorldInstance) DeepCopyInto(out *WorldInstance) {
 		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
 		copy(out.Status.Conditions, in.Status.Conditions)
 	}
+	if in.Status.Endpoints != nil {
+		out.Status.Endpoints = make([]WorldEndpointStatus, len(in.Status.Endpoints))
+		copy(out.Status.Endpoints, in.Status.Endpoints)
+	}
+	if in.Status.MissingModules != nil {
+		out.Status.MissingModules = make([]MissingModuleRef, len(in.Status.MissingModules))
+		copy(out.Status.MissingModules, in.Status.MissingModules)
+	}
+	if in.Status.RuntimeReadyTime != nil {
+		out.Status.RuntimeReadyTime = in.Status.RuntimeReadyTime.DeepCopy()
+	}
 }
 
 func (in *WorldInstance) DeepCopy() *WorldInstance {
@@ -312,9 +412,10 @@ func (in *ProviderStatus) DeepCopyInto(out *ProviderStatus) {
 	*out = *in
 	if in.Endpoint != nil {
 		out.Endpoint = &EndpointRef{
-			Type:  in.Endpoint.Type,
-			Value: in.Endpoint.Value,
-			Port:  in.Endpoint.Port,
+			Type:   in.Endpoint.Type,
+			Value:  in.Endpoint.Value,
+			Port:   in.Endpoint.Port,
+			Scheme: in.Endpoint.Scheme,
 		}
 	}
 }
@@ -404,7 +505,7 @@ func (in *WorldStorageClaim) DeepCopyInto(out *WorldStorageClaim) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 func (in *WorldStorageClaim) DeepCopy() *WorldStorageClaim {
@@ -435,6 +536,25 @@ func (in *WorldStorageClaimSpec) DeepCopyInto(out *WorldStorageClaimSpec) {
 	}
 }
 
+func (in *WorldStorageClaimStatus) DeepCopyInto(out *WorldStorageClaimStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		out.Conditions = make([]metav1.Condition, len(in.Conditions))
+		for i := range in.Conditions {
+			in.Conditions[i].DeepCopyInto(&out.Conditions[i])
+		}
+	}
+}
+
+func (in *WorldStorageClaimStatus) DeepCopy() *WorldStorageClaimStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorldStorageClaimStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 func (in *WorldStorageClaimList) DeepCopyInto(out *WorldStorageClaimList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
@@ -0,0 +1,211 @@
+// Command bindery-inspect lists what the controllers created for one world:
+// its shards, capability bindings, Services, Deployments, StatefulSets, and
+// storage claims, selected by the same labels the controllers stamp.
+//
+//	go run ./cmd/bindery-inspect -namespace default -world world-1 [-shard 0] [-module physics]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	binderyv1alpha1 "github.com/bayleafwalker/bindery-core/api/v1alpha1"
+	"github.com/bayleafwalker/bindery-core/controllers"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/homedir"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	scheme = runtime.NewScheme()
+)
+
+// Labels shown as columns; selection itself goes through controllers.WorldResourceSelector.
+const (
+	labelModule = "bindery.platform/module"
+	labelShard  = "bindery.platform/shard"
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(binderyv1alpha1.AddToScheme(scheme))
+}
+
+func main() {
+	var kubeconfig string
+	if home := homedir.HomeDir(); home != "" {
+		kubeconfig = filepath.Join(home, ".kube", "config")
+	} else {
+		kubeconfig = os.Getenv("KUBECONFIG")
+	}
+	flag.StringVar(&kubeconfig, "kubeconfig", kubeconfig, "absolute path to the kubeconfig file")
+
+	var namespace, world, shard, module string
+	var timeout time.Duration
+	flag.StringVar(&namespace, "namespace", "default", "Namespace of the world")
+	flag.StringVar(&world, "world", "", "WorldInstance name (required)")
+	flag.StringVar(&shard, "shard", "", "Only show resources of this shard ID")
+	flag.StringVar(&module, "module", "", "Only show resources of this ModuleManifest")
+	flag.DurationVar(&timeout, "timeout", 30*time.Second, "Timeout for the API queries")
+	flag.Parse()
+
+	if strings.TrimSpace(world) == "" {
+		log.Fatalf("-world is required")
+	}
+
+	config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	if err != nil {
+		log.Fatalf("Error building kubeconfig: %v", err)
+	}
+	k8sClient, err := client.New(config, client.Options{Scheme: scheme})
+	if err != nil {
+		log.Fatalf("Error creating client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := inspect(ctx, k8sClient, os.Stdout, namespace, world, shard, module); err != nil {
+		log.Fatalf("Error inspecting world %q: %v", world, err)
+	}
+}
+
+func inspect(ctx context.Context, c client.Client, out io.Writer, namespace, world, shard, module string) error {
+	var wi binderyv1alpha1.WorldInstance
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: world}, &wi); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "World %s/%s  worldId=%s  phase=%s\n", namespace, wi.Name, wi.Spec.WorldID, orDash(wi.Status.Phase))
+
+	// Shards and bindings carry no module label; filter bindings by provider below.
+	scoped := controllers.WorldResourceSelector(world, shard, "")
+	owned := controllers.WorldResourceSelector(world, shard, module)
+	inNS := client.InNamespace(namespace)
+
+	var shards binderyv1alpha1.WorldShardList
+	if err := c.List(ctx, &shards, inNS, scoped); err != nil {
+		return fmt.Errorf("list shards: %w", err)
+	}
+	section(out, "Shards", "NAME\tSHARD\tPHASE\tMESSAGE", len(shards.Items), func(w io.Writer) {
+		for _, s := range shards.Items {
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", s.Name, s.Spec.ShardID, orDash(s.Status.Phase), orDash(s.Status.Message))
+		}
+	})
+
+	var bindings binderyv1alpha1.CapabilityBindingList
+	if err := c.List(ctx, &bindings, inNS, scoped); err != nil {
+		return fmt.Errorf("list bindings: %w", err)
+	}
+	var matched []binderyv1alpha1.CapabilityBinding
+	for _, b := range bindings.Items {
+		if module == "" || b.Spec.Provider.ModuleManifestName == module {
+			matched = append(matched, b)
+		}
+	}
+	section(out, "Bindings", "NAME\tCAPABILITY\tPROVIDER\tRUNTIME-READY\tSERVING\tENDPOINT", len(matched), func(w io.Writer) {
+		for _, b := range matched {
+			endpoint := ""
+			if b.Status.Provider != nil && b.Status.Provider.Endpoint != nil {
+				endpoint = controllers.EndpointAddress(b.Status.Provider.Endpoint)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", b.Name, b.Spec.CapabilityID, b.Spec.Provider.ModuleManifestName,
+				conditionStatus(b.Status.Conditions, controllers.BindingConditionRuntimeReady),
+				conditionStatus(b.Status.Conditions, controllers.BindingConditionEndpointServing),
+				orDash(endpoint))
+		}
+	})
+
+	var services corev1.ServiceList
+	if err := c.List(ctx, &services, inNS, owned); err != nil {
+		return fmt.Errorf("list services: %w", err)
+	}
+	section(out, "Services", "NAME\tTYPE\tCLUSTER-IP\tPORTS", len(services.Items), func(w io.Writer) {
+		for _, s := range services.Items {
+			ports := make([]string, 0, len(s.Spec.Ports))
+			for _, p := range s.Spec.Ports {
+				ports = append(ports, fmt.Sprintf("%d/%s", p.Port, p.Protocol))
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", s.Name, s.Spec.Type, orDash(s.Spec.ClusterIP), orDash(strings.Join(ports, ",")))
+		}
+	})
+
+	var deployments appsv1.DeploymentList
+	if err := c.List(ctx, &deployments, inNS, owned); err != nil {
+		return fmt.Errorf("list deployments: %w", err)
+	}
+	section(out, "Deployments", "NAME\tMODULE\tSHARD\tREADY\tAVAILABLE", len(deployments.Items), func(w io.Writer) {
+		for _, d := range deployments.Items {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d/%d\t%d\n", d.Name, orDash(d.Labels[labelModule]), orDash(d.Labels[labelShard]),
+				d.Status.ReadyReplicas, replicas(d.Spec.Replicas), d.Status.AvailableReplicas)
+		}
+	})
+
+	var statefulSets appsv1.StatefulSetList
+	if err := c.List(ctx, &statefulSets, inNS, owned); err != nil {
+		return fmt.Errorf("list statefulsets: %w", err)
+	}
+	section(out, "StatefulSets", "NAME\tMODULE\tSHARD\tREADY\tAVAILABLE", len(statefulSets.Items), func(w io.Writer) {
+		for _, s := range statefulSets.Items {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d/%d\t%d\n", s.Name, orDash(s.Labels[labelModule]), orDash(s.Labels[labelShard]),
+				s.Status.ReadyReplicas, replicas(s.Spec.Replicas), s.Status.AvailableReplicas)
+		}
+	})
+
+	var claims binderyv1alpha1.WorldStorageClaimList
+	if err := c.List(ctx, &claims, inNS, scoped); err != nil {
+		return fmt.Errorf("list storage claims: %w", err)
+	}
+	section(out, "Storage claims", "NAME\tTIER\tSIZE\tPHASE", len(claims.Items), func(w io.Writer) {
+		for _, wsc := range claims.Items {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", wsc.Name, wsc.Spec.Tier, orDash(wsc.Spec.Size), orDash(wsc.Status.Phase))
+		}
+	})
+	return nil
+}
+
+func section(out io.Writer, title, header string, n int, rows func(io.Writer)) {
+	fmt.Fprintf(out, "\n%s (%d)\n", title, n)
+	if n == 0 {
+		return
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, header)
+	rows(w)
+	_ = w.Flush()
+}
+
+func replicas(r *int32) int32 {
+	if r == nil {
+		return 1
+	}
+	return *r
+}
+
+// conditionStatus returns the status of the condition of type t, or "-" if unset.
+func conditionStatus(conds []metav1.Condition, t string) string {
+	if c := meta.FindStatusCondition(conds, t); c != nil {
+		return string(c.Status)
+	}
+	return "-"
+}
+
+func orDash(s string) string {
+	if strings.TrimSpace(s) == "" {
+		return "-"
+	}
+	return s
+}
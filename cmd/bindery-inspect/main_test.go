@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	binderyv1alpha1 "github.com/bayleafwalker/bindery-core/api/v1alpha1"
+	"github.com/bayleafwalker/bindery-core/controllers"
+)
+
+func TestInspect_BindingEndpointAndConditions(t *testing.T) {
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "w1", Namespace: "ns"},
+		Spec:       binderyv1alpha1.WorldInstanceSpec{WorldID: "world-1"},
+	}
+	binding := &binderyv1alpha1.CapabilityBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "b1", Namespace: "ns", Labels: controllers.WorldResourceSelector("w1", "", "")},
+		Spec: binderyv1alpha1.CapabilityBindingSpec{
+			CapabilityID: "physics.engine",
+			Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: "physics"},
+		},
+		Status: binderyv1alpha1.CapabilityBindingStatus{
+			Provider: &binderyv1alpha1.ProviderStatus{Endpoint: &binderyv1alpha1.EndpointRef{
+				Type:  binderyv1alpha1.EndpointTypeKubernetesService,
+				Value: "w1-physics",
+				Port:  50051,
+			}},
+			Conditions: []metav1.Condition{
+				{Type: controllers.BindingConditionRuntimeReady, Status: metav1.ConditionTrue, Reason: "EndpointPublished"},
+				{Type: controllers.BindingConditionEndpointServing, Status: metav1.ConditionFalse, Reason: "NotServing"},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, binding).Build()
+
+	var out bytes.Buffer
+	if err := inspect(context.Background(), c, &out, "ns", "w1", "", ""); err != nil {
+		t.Fatalf("inspect: %v", err)
+	}
+
+	var row string
+	for _, line := range strings.Split(out.String(), "\n") {
+		if strings.HasPrefix(line, "b1 ") {
+			row = line
+		}
+	}
+	if got, want := strings.Fields(row), []string{"b1", "physics.engine", "physics", "True", "False", "w1-physics:50051"}; strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Fatalf("expected binding row %v, got %q\n%s", want, row, out.String())
+	}
+}
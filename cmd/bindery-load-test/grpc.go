@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	binderyv1alpha1 "github.com/bayleafwalker/bindery-core/api/v1alpha1"
+	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+)
+
+// grpcLoadOptions configures the data-plane phase run against each Running world.
+type grpcLoadOptions struct {
+	// Ops is the number of calls issued per world; 0 disables the phase.
+	Ops int
+	// Rate is the per-world call rate in calls per second.
+	Rate float64
+	// CapabilityID selects which binding's endpoint to drive.
+	CapabilityID string
+	// Target, if set, overrides the endpoint resolved from the binding (e.g. a port-forward).
+	Target string
+	// Timeout bounds each individual call.
+	Timeout time.Duration
+	// PhaseTimeout bounds the whole phase for one world, starting once it is Running.
+	PhaseTimeout time.Duration
+}
+
+// resolveEndpoint finds the published endpoint for capabilityID among the world's bindings.
+func resolveEndpoint(ctx context.Context, c client.Client, world *binderyv1alpha1.WorldInstance, capabilityID string) (string, error) {
+	var bindings binderyv1alpha1.CapabilityBindingList
+	if err := c.List(ctx, &bindings,
+		client.InNamespace(world.Namespace),
+		client.MatchingLabels{"bindery.platform/world": world.Name},
+	); err != nil {
+		return "", err
+	}
+	for _, b := range bindings.Items {
+		if b.Spec.CapabilityID != capabilityID {
+			continue
+		}
+		if b.Status.Provider == nil || b.Status.Provider.Endpoint == nil || b.Status.Provider.Endpoint.Value == "" {
+			continue
+		}
+		ep := b.Status.Provider.Endpoint
+		if ep.Type == binderyv1alpha1.EndpointTypeUnixSocket {
+			// Only reachable from inside the provider's Pod.
+			continue
+		}
+		if ep.Port == 0 {
+			// External URIs carry their own port; dial them as published.
+			return ep.Value, nil
+		}
+		host := ep.Value
+		if ep.Type == binderyv1alpha1.EndpointTypeKubernetesService && !strings.Contains(host, ".") {
+			host = fmt.Sprintf("%s.%s.svc", host, world.Namespace)
+		}
+		return fmt.Sprintf("%s:%d", host, ep.Port), nil
+	}
+	return "", fmt.Errorf("no published endpoint for capability %q", capabilityID)
+}
+
+// driveGRPC alternates ApplyCommand and GetStateSnapshot calls against the world's engine
+// at the configured rate, recording per-call latency.
+func driveGRPC(ctx context.Context, target, worldID string, opts grpcLoadOptions, rec *latencyRecorder) error {
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("dial %s: %w", target, err)
+	}
+	defer conn.Close()
+
+	c := enginev1.NewEngineModuleClient(conn)
+
+	interval := time.Duration(0)
+	if opts.Rate > 0 {
+		interval = time.Duration(float64(time.Second) / opts.Rate)
+	}
+
+	for i := 0; i < opts.Ops; i++ {
+		if i > 0 && interval > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(interval):
+			}
+		}
+
+		callCtx, cancel := context.WithTimeout(ctx, opts.Timeout)
+		if i%2 == 0 {
+			start := time.Now()
+			resp, err := c.ApplyCommand(callCtx, &enginev1.ApplyCommandRequest{
+				WorldId:   worldID,
+				RequestId: fmt.Sprintf("load-%s-%d", worldID, i),
+				Command: &enginev1.Command{
+					CommandId:          fmt.Sprintf("load-%d", i),
+					ActorId:            "bindery-load-test",
+					IssuedAtUnixMillis: time.Now().UnixMilli(),
+					Payload: &enginev1.Command_SpawnEntity{
+						SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: fmt.Sprintf("load-entity-%d", i)},
+					},
+				},
+			})
+			if err == nil && resp.GetError() != nil {
+				err = fmt.Errorf("%s: %s", resp.GetError().GetCode(), resp.GetError().GetMessage())
+			}
+			rec.observe("ApplyCommand", time.Since(start), err)
+		} else {
+			start := time.Now()
+			resp, err := c.GetStateSnapshot(callCtx, &enginev1.GetStateSnapshotRequest{
+				WorldId:   worldID,
+				RequestId: fmt.Sprintf("load-%s-%d", worldID, i),
+				Selector:  &enginev1.GetStateSnapshotRequest_Latest{Latest: &enginev1.SnapshotLatest{}},
+			})
+			if err == nil && resp.GetError() != nil {
+				err = fmt.Errorf("%s: %s", resp.GetError().GetCode(), resp.GetError().GetMessage())
+			}
+			rec.observe("GetStateSnapshot", time.Since(start), err)
+		}
+		cancel()
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	binderyv1alpha1 "github.com/bayleafwalker/bindery-core/api/v1alpha1"
+)
+
+func TestResolveEndpoint(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = binderyv1alpha1.AddToScheme(s)
+
+	world := &binderyv1alpha1.WorldInstance{ObjectMeta: metav1.ObjectMeta{Name: "w1", Namespace: "ns"}}
+	for _, tc := range []struct {
+		name string
+		ep   binderyv1alpha1.EndpointRef
+		want string
+	}{
+		{name: "service", ep: binderyv1alpha1.EndpointRef{Type: binderyv1alpha1.EndpointTypeKubernetesService, Value: "physics", Port: 50051}, want: "physics.ns.svc:50051"},
+		{name: "external host:port", ep: binderyv1alpha1.EndpointRef{Type: binderyv1alpha1.EndpointTypeExternal, Value: "physics.example.com", Port: 443}, want: "physics.example.com:443"},
+		{name: "external URI", ep: binderyv1alpha1.EndpointRef{Type: binderyv1alpha1.EndpointTypeExternal, Value: "dns:///physics.example.com:443"}, want: "dns:///physics.example.com:443"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ep := tc.ep
+			binding := &binderyv1alpha1.CapabilityBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "b1", Namespace: "ns", Labels: map[string]string{"bindery.platform/world": "w1"}},
+				Spec:       binderyv1alpha1.CapabilityBindingSpec{CapabilityID: "physics.engine"},
+				Status:     binderyv1alpha1.CapabilityBindingStatus{Provider: &binderyv1alpha1.ProviderStatus{Endpoint: &ep}},
+			}
+			c := fake.NewClientBuilder().WithScheme(s).WithObjects(binding).Build()
+
+			got, err := resolveEndpoint(context.Background(), c, world, "physics.engine")
+			if err != nil {
+				t.Fatalf("resolveEndpoint: %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSummarize_Empty(t *testing.T) {
+	s := summarize(nil)
+	if s.Count != 0 || s.Max != 0 {
+		t.Fatalf("expected zero summary, got %+v", s)
+	}
+}
+
+func TestLatencyRecorder_AggregatesPerOp(t *testing.T) {
+	r := newLatencyRecorder()
+	for i := 1; i <= 4; i++ {
+		r.observe("ApplyCommand", time.Duration(i)*time.Millisecond, nil)
+	}
+	r.observe("ApplyCommand", 0, errors.New("unavailable"))
+	r.observe("GetStateSnapshot", 7*time.Millisecond, nil)
+
+	if got := r.ops(); len(got) != 2 || got[0] != "ApplyCommand" || got[1] != "GetStateSnapshot" {
+		t.Fatalf("unexpected ops %v", got)
+	}
+
+	s, errs := r.summary("ApplyCommand")
+	if errs != 1 {
+		t.Fatalf("expected 1 error, got %d", errs)
+	}
+	if s.Count != 4 {
+		t.Fatalf("expected 4 samples, got %d", s.Count)
+	}
+	if s.Mean != 2500*time.Microsecond {
+		t.Fatalf("expected mean 2.5ms, got %v", s.Mean)
+	}
+	if s.P50 != 2*time.Millisecond || s.Max != 4*time.Millisecond {
+		t.Fatalf("unexpected distribution %+v", s)
+	}
+}
+
+func TestSummarize_Percentiles(t *testing.T) {
+	// 1..100ms in descending order so the helper has to sort.
+	var latencies []time.Duration
+	for i := 100; i >= 1; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+	first := latencies[0]
+
+	s := summarize(latencies)
+	if s.Count != 100 {
+		t.Fatalf("expected 100 samples, got %d", s.Count)
+	}
+	if s.P50 != 50*time.Millisecond || s.P95 != 95*time.Millisecond || s.P99 != 99*time.Millisecond {
+		t.Fatalf("unexpected percentiles p50=%v p95=%v p99=%v", s.P50, s.P95, s.P99)
+	}
+	if s.Max != 100*time.Millisecond {
+		t.Fatalf("expected max 100ms, got %v", s.Max)
+	}
+	if s.Mean != 50500*time.Microsecond {
+		t.Fatalf("expected mean 50.5ms, got %v", s.Mean)
+	}
+	if latencies[0] != first {
+		t.Fatalf("summarize must not reorder its input")
+	}
+}
+
+func TestPercentile_SmallSets(t *testing.T) {
+	sorted := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 30 * time.Millisecond}
+	cases := []struct {
+		p    float64
+		want time.Duration
+	}{
+		{0, 10 * time.Millisecond},
+		{50, 20 * time.Millisecond},
+		{95, 30 * time.Millisecond},
+		{100, 30 * time.Millisecond},
+	}
+	for _, tc := range cases {
+		if got := percentile(sorted, tc.p); got != tc.want {
+			t.Errorf("percentile(%v) = %v, want %v", tc.p, got, tc.want)
+		}
+	}
+	if got := percentile(nil, 50); got != 0 {
+		t.Errorf("percentile of empty set = %v, want 0", got)
+	}
+}
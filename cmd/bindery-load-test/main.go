@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	binderyv1alpha1 "github.com/bayleafwalker/bindery-core/api/v1alpha1"
@@ -47,6 +48,14 @@ func main() {
 	flag.StringVar(&namespace, "namespace", "default", "Namespace to spawn worlds in")
 	flag.StringVar(&bookletName, "booklet", "standard-match", "Booklet name")
 	flag.StringVar(&realmName, "realm", "eu-west", "Realm name")
+
+	var grpcOpts grpcLoadOptions
+	flag.IntVar(&grpcOpts.Ops, "grpc-ops", 0, "gRPC calls issued per world once Running (alternating ApplyCommand/GetStateSnapshot); 0 only measures startup")
+	flag.Float64Var(&grpcOpts.Rate, "grpc-rate", 10, "Per-world gRPC call rate in calls per second (0 = unthrottled)")
+	flag.StringVar(&grpcOpts.CapabilityID, "grpc-capability", "physics.engine", "Capability whose resolved endpoint is driven with gRPC calls")
+	flag.StringVar(&grpcOpts.Target, "grpc-target", "", "Override the resolved endpoint (host:port), e.g. for a port-forward")
+	flag.DurationVar(&grpcOpts.Timeout, "grpc-timeout", 3*time.Second, "Timeout for each gRPC call")
+	flag.DurationVar(&grpcOpts.PhaseTimeout, "grpc-phase-timeout", 5*time.Minute, "Timeout for each world's whole gRPC phase, separate from the startup timeout")
 	flag.Parse()
 
 	config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
@@ -64,6 +73,8 @@ func main() {
 	var wg sync.WaitGroup
 	start := time.Now()
 	latencies := make(chan time.Duration, numWorlds)
+	grpcLatencies := newLatencyRecorder()
+	var timedOut, failed atomic.Int32
 
 	for i := 0; i < numWorlds; i++ {
 		wg.Add(1)
@@ -89,6 +100,7 @@ func main() {
 			fmt.Printf("Creating world %s\n", worldName)
 			if err := k8sClient.Create(context.Background(), world); err != nil {
 				fmt.Printf("Error creating world %s: %v\n", worldName, err)
+				failed.Add(1)
 				return
 			}
 
@@ -100,6 +112,7 @@ func main() {
 				select {
 				case <-ctx.Done():
 					fmt.Printf("Timeout waiting for world %s\n", worldName)
+					timedOut.Add(1)
 					return
 				case <-time.After(1 * time.Second):
 					var currentWorld binderyv1alpha1.WorldInstance
@@ -110,6 +123,10 @@ func main() {
 						latency := time.Since(createStart)
 						latencies <- latency
 						fmt.Printf("World %s running in %v\n", worldName, latency)
+						if grpcOpts.Ops > 0 {
+							// The startup deadline may be nearly spent; the phase gets its own.
+							runGRPCPhase(context.Background(), k8sClient, &currentWorld, grpcOpts, grpcLatencies)
+						}
 						return
 					}
 				}
@@ -121,17 +138,44 @@ func main() {
 	close(latencies)
 	totalDuration := time.Since(start)
 
-	var totalLatency time.Duration
-	count := 0
+	var startup []time.Duration
 	for l := range latencies {
-		totalLatency += l
-		count++
+		startup = append(startup, l)
 	}
 
-	if count > 0 {
-		avgLatency := totalLatency / time.Duration(count)
-		fmt.Printf("Load test completed in %v. Avg startup latency: %v\n", totalDuration, avgLatency)
+	fmt.Printf("Load test completed in %v: %d/%d worlds Running, %d timed out, %d failed to create.\n",
+		totalDuration, len(startup), numWorlds, timedOut.Load(), failed.Load())
+	if len(startup) > 0 {
+		fmt.Printf("Startup latency: %s\n", summarize(startup))
 	} else {
-		fmt.Printf("Load test completed in %v. No worlds started successfully.\n", totalDuration)
+		fmt.Println("No worlds started successfully.")
+	}
+
+	if grpcOpts.Ops > 0 {
+		fmt.Println("gRPC latency:")
+		for _, op := range grpcLatencies.ops() {
+			summary, errs := grpcLatencies.summary(op)
+			fmt.Printf("  %-16s %s errors=%d\n", op, summary, errs)
+		}
+	}
+}
+
+func runGRPCPhase(ctx context.Context, c client.Client, world *binderyv1alpha1.WorldInstance, opts grpcLoadOptions, rec *latencyRecorder) {
+	if opts.PhaseTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.PhaseTimeout)
+		defer cancel()
+	}
+	target := opts.Target
+	if target == "" {
+		resolved, err := resolveEndpoint(ctx, c, world, opts.CapabilityID)
+		if err != nil {
+			fmt.Printf("World %s: cannot resolve %s endpoint: %v\n", world.Name, opts.CapabilityID, err)
+			return
+		}
+		target = resolved
+	}
+	if err := driveGRPC(ctx, target, world.Spec.WorldID, opts, rec); err != nil {
+		fmt.Printf("World %s: gRPC load against %s failed: %v\n", world.Name, target, err)
 	}
 }
@@ -1,13 +1,22 @@
 package main
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"flag"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
 
 	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
 )
@@ -15,14 +24,40 @@ import (
 func main() {
 	var target string
 	var worldID string
+	var list bool
+	var compress bool
+	var tlsCA, tlsCert, tlsKey string
+	var maxRecv, maxSend int
 	flag.StringVar(&target, "target", "127.0.0.1:50051", "gRPC server address")
 	flag.StringVar(&worldID, "world", "world-1", "world id")
+	flag.BoolVar(&list, "list", false, "list the worlds held by the module instead of probing -world")
+	flag.BoolVar(&compress, "compress", false, "request a gzip-compressed snapshot")
+	flag.StringVar(&tlsCA, "tls-ca", "", "PEM CA bundle to verify the server with; enables TLS")
+	flag.StringVar(&tlsCert, "tls-cert", "", "client certificate for mutual TLS (requires -tls-ca)")
+	flag.StringVar(&tlsKey, "tls-key", "", "client key for mutual TLS (requires -tls-ca)")
+	flag.IntVar(&maxRecv, "max-recv-msg-size", envInt("BINDERY_GRPC_MAX_RECV_MSG_SIZE"), "max response size in bytes (0 keeps the 4MB gRPC default)")
+	flag.IntVar(&maxSend, "max-send-msg-size", envInt("BINDERY_GRPC_MAX_SEND_MSG_SIZE"), "max request size in bytes (0 keeps the gRPC default)")
 	flag.Parse()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 
-	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	creds, err := transportCredentials(tlsCA, tlsCert, tlsKey)
+	if err != nil {
+		panic(err)
+	}
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	var callOpts []grpc.CallOption
+	if maxRecv > 0 {
+		callOpts = append(callOpts, grpc.MaxCallRecvMsgSize(maxRecv))
+	}
+	if maxSend > 0 {
+		callOpts = append(callOpts, grpc.MaxCallSendMsgSize(maxSend))
+	}
+	if len(callOpts) > 0 {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(callOpts...))
+	}
+	conn, err := grpc.NewClient(target, dialOpts...)
 	if err != nil {
 		panic(fmt.Errorf("dial %s: %w", target, err))
 	}
@@ -30,10 +65,16 @@ func main() {
 
 	c := enginev1.NewEngineModuleClient(conn)
 
+	if list {
+		listWorlds(ctx, c)
+		return
+	}
+
 	resp, err := c.GetStateSnapshot(ctx, &enginev1.GetStateSnapshotRequest{
 		WorldId:   worldID,
 		RequestId: fmt.Sprintf("smoke-%d", time.Now().UnixNano()),
 		Selector:  &enginev1.GetStateSnapshotRequest_Latest{Latest: &enginev1.SnapshotLatest{}},
+		Compress:  compress,
 		// Keeping the request minimal for a quick smoke test.
 	})
 	if err != nil {
@@ -43,10 +84,95 @@ func main() {
 
 	switch r := resp.GetResult().(type) {
 	case *enginev1.GetStateSnapshotResponse_Ok:
-		fmt.Printf("GetStateSnapshot ok: tick=%d entities=%d\n", r.Ok.GetWorldState().GetTick(), len(r.Ok.GetWorldState().GetEntities()))
+		ws, err := snapshotWorldState(r.Ok)
+		if err != nil {
+			fmt.Printf("GetStateSnapshot decode error: %v\n", err)
+			return
+		}
+		fmt.Printf("GetStateSnapshot ok: tick=%d entities=%d\n", ws.GetTick(), len(ws.GetEntities()))
 	case *enginev1.GetStateSnapshotResponse_Error:
 		fmt.Printf("GetStateSnapshot error result: code=%s message=%q\n", r.Error.GetCode().String(), r.Error.GetMessage())
 	default:
 		fmt.Printf("GetStateSnapshot unknown result\n")
 	}
 }
+
+// snapshotWorldState returns the snapshot's WorldState, decompressing it if the
+// module answered with compressed_world_state.
+func snapshotWorldState(ok *enginev1.GetStateSnapshotOk) (*enginev1.WorldState, error) {
+	data := ok.GetCompressedWorldState()
+	if len(data) == 0 {
+		return ok.GetWorldState(), nil
+	}
+	if enc := ok.GetMetadata()["content-encoding"]; enc != "gzip" {
+		return nil, fmt.Errorf("unsupported snapshot content-encoding %q", enc)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+	var ws enginev1.WorldState
+	if err := proto.Unmarshal(raw, &ws); err != nil {
+		return nil, err
+	}
+	fmt.Printf("GetStateSnapshot compressed: %d bytes gzip, %d bytes raw\n", len(data), len(raw))
+	return &ws, nil
+}
+
+func listWorlds(ctx context.Context, c enginev1.EngineModuleClient) {
+	resp, err := c.ListWorlds(ctx, &enginev1.ListWorldsRequest{})
+	if err != nil {
+		fmt.Printf("ListWorlds error: %v\n", err)
+		return
+	}
+
+	switch r := resp.GetResult().(type) {
+	case *enginev1.ListWorldsResponse_Ok:
+		fmt.Printf("ListWorlds ok: %d worlds\n", len(r.Ok.GetWorlds()))
+		for _, w := range r.Ok.GetWorlds() {
+			fmt.Printf("  %s tick=%d entities=%d\n", w.GetWorldId(), w.GetTick(), w.GetEntityCount())
+		}
+	case *enginev1.ListWorldsResponse_Error:
+		fmt.Printf("ListWorlds error result: code=%s message=%q\n", r.Error.GetCode().String(), r.Error.GetMessage())
+	default:
+		fmt.Printf("ListWorlds unknown result\n")
+	}
+}
+
+// envInt returns the integer value of name, or 0 when unset or malformed.
+func envInt(name string) int {
+	v, err := strconv.Atoi(os.Getenv(name))
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// transportCredentials returns plaintext credentials unless caFile is set.
+func transportCredentials(caFile, certFile, keyFile string) (credentials.TransportCredentials, error) {
+	if caFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("read CA %s: %w", caFile, err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+	cfg := &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client key pair: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(cfg), nil
+}
@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"net"
 	"os"
-	"path/filepath"
-	"strconv"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
 
 	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+	"github.com/bayleafwalker/bindery-core/internal/moduleserver"
 )
 
 type server struct {
@@ -126,42 +128,50 @@ func (s *server) GetStateSnapshot(ctx context.Context, req *enginev1.GetStateSna
 
 func main() {
 	var listenAddr string
+	var shutdownTimeout time.Duration
 	flag.StringVar(&listenAddr, "listen", ":50051", "address to listen on")
+	flag.DurationVar(&shutdownTimeout, "shutdown-timeout", 20*time.Second, "how long to drain in-flight RPCs on SIGTERM before forcing stop")
 	flag.Parse()
 
 	// gRPC Options
-	var opts []grpc.ServerOption
-	if s := os.Getenv("BINDERY_GRPC_INITIAL_WINDOW_SIZE"); s != "" {
-		if v, err := strconv.Atoi(s); err == nil {
-			opts = append(opts, grpc.InitialWindowSize(int32(v)))
-		}
+	tuning, err := grpcTuningFromEnv()
+	if err != nil {
+		panic(err)
 	}
-	if s := os.Getenv("BINDERY_GRPC_INITIAL_CONN_WINDOW_SIZE"); s != "" {
-		if v, err := strconv.Atoi(s); err == nil {
-			opts = append(opts, grpc.InitialConnWindowSize(int32(v)))
-		}
+	opts := tuning.serverOptions()
+
+	tlsOpts, err := moduleserver.TLSServerOptionsFromEnv()
+	if err != nil {
+		panic(err)
+	}
+	opts = append(opts, tlsOpts...)
+
+	pprofServer, pprofAddr, err := moduleserver.StartPprofFromEnv()
+	if err != nil {
+		panic(err)
+	}
+	if pprofServer != nil {
+		defer pprofServer.Close()
+		fmt.Printf("Serving pprof on http://%s/debug/pprof/\n", pprofAddr)
 	}
 
 	grpcServer := grpc.NewServer(opts...)
 	enginev1.RegisterEngineModuleServer(grpcServer, &server{})
+	healthServer := moduleserver.RegisterStandardServices(grpcServer)
 
 	// UDS Listener
-	udsDir := os.Getenv("BINDERY_UDS_DIR")
-	moduleName := os.Getenv("BINDERY_MODULE_NAME")
-	if udsDir != "" && moduleName != "" {
-		socketPath := filepath.Join(udsDir, moduleName+".sock")
-		_ = os.Remove(socketPath)
-		udsLis, err := net.Listen("unix", socketPath)
+	if socketPath := udsSocketPath(); socketPath != "" {
+		udsLis, cleanup, err := listenUnix(socketPath)
 		if err != nil {
-			fmt.Printf("Failed to listen on UDS %s: %v\n", socketPath, err)
-		} else {
-			fmt.Printf("Listening on UDS %s\n", socketPath)
-			go func() {
-				if err := grpcServer.Serve(udsLis); err != nil {
-					fmt.Printf("UDS serve error: %v\n", err)
-				}
-			}()
+			panic(err)
 		}
+		defer cleanup()
+		fmt.Printf("Listening on UDS %s\n", socketPath)
+		go func() {
+			if err := grpcServer.Serve(udsLis); err != nil {
+				fmt.Printf("UDS serve error: %v\n", err)
+			}
+		}()
 	}
 
 	lis, err := net.Listen("tcp", listenAddr)
@@ -170,7 +180,23 @@ func main() {
 	}
 	fmt.Printf("Listening on TCP %s\n", listenAddr)
 
+	// Stopping the server closes every listener it serves, TCP and UDS alike.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	shutdownDone := make(chan struct{})
+	go func() {
+		defer close(shutdownDone)
+		<-ctx.Done()
+		// Fail health checks first so load balancers stop routing new calls while we drain.
+		healthServer.Shutdown()
+		shutdownGracefully(ctx, grpcServer, shutdownTimeout)
+	}()
+
+	moduleserver.MarkServing(healthServer)
+
 	if err := grpcServer.Serve(lis); err != nil {
 		panic(fmt.Errorf("grpc serve: %w", err))
 	}
+	<-shutdownDone
+	fmt.Println("Server stopped")
 }
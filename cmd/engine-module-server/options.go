@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+// grpcTuning holds the transport knobs read from BINDERY_GRPC_* environment variables.
+// Zero means "keep the gRPC default".
+type grpcTuning struct {
+	InitialWindowSize     int
+	InitialConnWindowSize int
+	MaxRecvMsgSize        int
+	MaxSendMsgSize        int
+}
+
+// grpcTuningFromEnv parses the BINDERY_GRPC_* variables. Unset variables keep the default;
+// values that are not positive integers are rejected so a typo doesn't silently fall back
+// to the 4MB message limit.
+func grpcTuningFromEnv() (grpcTuning, error) {
+	var t grpcTuning
+	for _, f := range []struct {
+		name string
+		dst  *int
+	}{
+		{"BINDERY_GRPC_INITIAL_WINDOW_SIZE", &t.InitialWindowSize},
+		{"BINDERY_GRPC_INITIAL_CONN_WINDOW_SIZE", &t.InitialConnWindowSize},
+		{"BINDERY_GRPC_MAX_RECV_MSG_SIZE", &t.MaxRecvMsgSize},
+		{"BINDERY_GRPC_MAX_SEND_MSG_SIZE", &t.MaxSendMsgSize},
+	} {
+		raw := strings.TrimSpace(os.Getenv(f.name))
+		if raw == "" {
+			continue
+		}
+		v, err := strconv.Atoi(raw)
+		if err != nil || v <= 0 {
+			return grpcTuning{}, fmt.Errorf("%s: want a positive byte count, got %q", f.name, raw)
+		}
+		*f.dst = v
+	}
+	return t, nil
+}
+
+func (t grpcTuning) serverOptions() []grpc.ServerOption {
+	var opts []grpc.ServerOption
+	if t.InitialWindowSize > 0 {
+		opts = append(opts, grpc.InitialWindowSize(int32(t.InitialWindowSize)))
+	}
+	if t.InitialConnWindowSize > 0 {
+		opts = append(opts, grpc.InitialConnWindowSize(int32(t.InitialConnWindowSize)))
+	}
+	if t.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(t.MaxRecvMsgSize))
+	}
+	if t.MaxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(t.MaxSendMsgSize))
+	}
+	return opts
+}
@@ -0,0 +1,40 @@
+package main
+
+import "testing"
+
+func TestGRPCTuningFromEnv(t *testing.T) {
+	t.Setenv("BINDERY_GRPC_INITIAL_WINDOW_SIZE", "")
+	t.Setenv("BINDERY_GRPC_INITIAL_CONN_WINDOW_SIZE", "")
+	t.Setenv("BINDERY_GRPC_MAX_RECV_MSG_SIZE", "")
+	t.Setenv("BINDERY_GRPC_MAX_SEND_MSG_SIZE", "")
+
+	got, err := grpcTuningFromEnv()
+	if err != nil {
+		t.Fatalf("grpcTuningFromEnv: %v", err)
+	}
+	if got != (grpcTuning{}) || len(got.serverOptions()) != 0 {
+		t.Fatalf("expected defaults with nothing set, got %+v", got)
+	}
+
+	t.Setenv("BINDERY_GRPC_INITIAL_WINDOW_SIZE", "1048576")
+	t.Setenv("BINDERY_GRPC_MAX_RECV_MSG_SIZE", " 16777216 ")
+	t.Setenv("BINDERY_GRPC_MAX_SEND_MSG_SIZE", "33554432")
+	got, err = grpcTuningFromEnv()
+	if err != nil {
+		t.Fatalf("grpcTuningFromEnv: %v", err)
+	}
+	want := grpcTuning{InitialWindowSize: 1 << 20, MaxRecvMsgSize: 16 << 20, MaxSendMsgSize: 32 << 20}
+	if got != want {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+	if n := len(got.serverOptions()); n != 3 {
+		t.Fatalf("expected 3 server options, got %d", n)
+	}
+
+	for _, bad := range []string{"16MB", "0", "-1"} {
+		t.Setenv("BINDERY_GRPC_MAX_RECV_MSG_SIZE", bad)
+		if _, err := grpcTuningFromEnv(); err == nil {
+			t.Fatalf("expected error for BINDERY_GRPC_MAX_RECV_MSG_SIZE=%q", bad)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// stopper is the subset of *grpc.Server used during shutdown.
+type stopper interface {
+	GracefulStop()
+	Stop()
+}
+
+// shutdownGracefully drains in-flight RPCs via GracefulStop once ctx is done.
+// If draining takes longer than timeout, it falls back to Stop, which closes
+// all listeners and connections immediately. It reports whether the drain
+// finished in time.
+func shutdownGracefully(ctx context.Context, srv stopper, timeout time.Duration) bool {
+	<-ctx.Done()
+
+	done := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		fmt.Printf("Graceful stop exceeded %v; forcing stop\n", timeout)
+		srv.Stop()
+		<-done
+		return false
+	}
+}
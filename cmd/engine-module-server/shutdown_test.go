@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeStopper struct {
+	release  chan struct{}
+	graceful atomic.Bool
+	stopped  atomic.Bool
+}
+
+func (f *fakeStopper) GracefulStop() {
+	f.graceful.Store(true)
+	<-f.release
+}
+
+func (f *fakeStopper) Stop() {
+	f.stopped.Store(true)
+	close(f.release)
+}
+
+func TestShutdownGracefully_DrainsWithinTimeout(t *testing.T) {
+	f := &fakeStopper{release: make(chan struct{})}
+	close(f.release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if !shutdownGracefully(ctx, f, time.Second) {
+		t.Fatalf("expected graceful drain to finish in time")
+	}
+	if !f.graceful.Load() {
+		t.Fatalf("expected GracefulStop to be called")
+	}
+	if f.stopped.Load() {
+		t.Fatalf("did not expect Stop after a clean drain")
+	}
+}
+
+func TestShutdownGracefully_ForcesStopAfterTimeout(t *testing.T) {
+	f := &fakeStopper{release: make(chan struct{})}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if shutdownGracefully(ctx, f, 10*time.Millisecond) {
+		t.Fatalf("expected drain to time out")
+	}
+	if !f.graceful.Load() || !f.stopped.Load() {
+		t.Fatalf("expected GracefulStop then Stop, got graceful=%t stopped=%t", f.graceful.Load(), f.stopped.Load())
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// udsSocketPath returns the socket path to serve on, or "" if UDS is not configured.
+// BINDERY_UDS_PATH wins; otherwise the path is <BINDERY_UDS_DIR>/<BINDERY_MODULE_NAME>.sock,
+// which is what the orchestrator publishes to pod-colocated consumers.
+func udsSocketPath() string {
+	if p := strings.TrimSpace(os.Getenv("BINDERY_UDS_PATH")); p != "" {
+		return p
+	}
+	udsDir := strings.TrimSpace(os.Getenv("BINDERY_UDS_DIR"))
+	moduleName := strings.TrimSpace(os.Getenv("BINDERY_MODULE_NAME"))
+	if udsDir == "" || moduleName == "" {
+		return ""
+	}
+	return filepath.Join(udsDir, moduleName+".sock")
+}
+
+// listenUnix binds socketPath, replacing a stale socket file left by a crashed process.
+// It refuses to start if another process is still accepting on the socket. The returned
+// cleanup removes the socket file and is safe to call after the listener is closed.
+func listenUnix(socketPath string) (net.Listener, func(), error) {
+	if _, err := os.Lstat(socketPath); err == nil {
+		if conn, dialErr := net.DialTimeout("unix", socketPath, 500*time.Millisecond); dialErr == nil {
+			_ = conn.Close()
+			return nil, nil, fmt.Errorf("socket %s is in use by another process", socketPath)
+		}
+		if err := os.Remove(socketPath); err != nil {
+			return nil, nil, fmt.Errorf("remove stale socket %s: %w", socketPath, err)
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, fmt.Errorf("stat socket %s: %w", socketPath, err)
+	}
+
+	lis, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("listen on UDS %s: %w", socketPath, err)
+	}
+	cleanup := func() {
+		if err := os.Remove(socketPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			fmt.Printf("Failed to remove UDS %s: %v\n", socketPath, err)
+		}
+	}
+	return lis, cleanup, nil
+}
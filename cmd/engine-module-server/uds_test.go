@@ -0,0 +1,71 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUDSSocketPath(t *testing.T) {
+	t.Setenv("BINDERY_UDS_PATH", "")
+	t.Setenv("BINDERY_UDS_DIR", "/var/run/bindery")
+	t.Setenv("BINDERY_MODULE_NAME", "physics")
+	if got := udsSocketPath(); got != "/var/run/bindery/physics.sock" {
+		t.Fatalf("unexpected derived path %q", got)
+	}
+
+	t.Setenv("BINDERY_UDS_PATH", "/tmp/custom.sock")
+	if got := udsSocketPath(); got != "/tmp/custom.sock" {
+		t.Fatalf("expected override, got %q", got)
+	}
+
+	t.Setenv("BINDERY_UDS_PATH", "")
+	t.Setenv("BINDERY_MODULE_NAME", "")
+	if got := udsSocketPath(); got != "" {
+		t.Fatalf("expected UDS disabled, got %q", got)
+	}
+}
+
+func TestListenUnix_CleansUpSocket(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "engine.sock")
+
+	lis, cleanup, err := listenUnix(socketPath)
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	if _, err := os.Stat(socketPath); err != nil {
+		t.Fatalf("expected socket file to exist: %v", err)
+	}
+
+	if _, _, err := listenUnix(socketPath); err == nil {
+		t.Fatalf("expected listen to fail while the socket is actively bound")
+	}
+
+	_ = lis.Close()
+	cleanup()
+	if _, err := os.Stat(socketPath); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected socket file to be removed, got %v", err)
+	}
+}
+
+func TestListenUnix_ReplacesStaleSocket(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "engine.sock")
+
+	// Simulate a crashed process: the socket file remains but nobody is accepting.
+	stale, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	_ = stale.Close()
+
+	lis, cleanup, err := listenUnix(socketPath)
+	if err != nil {
+		t.Fatalf("expected stale socket to be replaced: %v", err)
+	}
+	_ = lis.Close()
+	cleanup()
+}
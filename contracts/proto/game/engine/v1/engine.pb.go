@@ -39,6 +39,9 @@ const (
 	StatusCode_STATUS_CODE_CONFLICT            StatusCode = 5
 	StatusCode_STATUS_CODE_INTERNAL            StatusCode = 6
 	StatusCode_STATUS_CODE_UNAVAILABLE         StatusCode = 7
+	// The caller exceeded a capacity limit (e.g. a full command queue) and
+	// should back off before retrying.
+	StatusCode_STATUS_CODE_RESOURCE_EXHAUSTED StatusCode = 8
 )
 
 // Enum value maps for StatusCode.
@@ -52,6 +55,7 @@ var (
 		5: "STATUS_CODE_CONFLICT",
 		6: "STATUS_CODE_INTERNAL",
 		7: "STATUS_CODE_UNAVAILABLE",
+		8: "STATUS_CODE_RESOURCE_EXHAUSTED",
 	}
 	StatusCode_value = map[string]int32{
 		"STATUS_CODE_UNSPECIFIED":         0,
@@ -62,6 +66,7 @@ var (
 		"STATUS_CODE_CONFLICT":            5,
 		"STATUS_CODE_INTERNAL":            6,
 		"STATUS_CODE_UNAVAILABLE":         7,
+		"STATUS_CODE_RESOURCE_EXHAUSTED":  8,
 	}
 )
 
@@ -92,6 +97,66 @@ func (StatusCode) EnumDescriptor() ([]byte, []int) {
 	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{0}
 }
 
+// CommandRejectionReason classifies why an accepted command failed to apply.
+type CommandRejectionReason int32
+
+const (
+	CommandRejectionReason_COMMAND_REJECTION_REASON_UNSPECIFIED           CommandRejectionReason = 0
+	CommandRejectionReason_COMMAND_REJECTION_REASON_ENTITY_NOT_FOUND      CommandRejectionReason = 1
+	CommandRejectionReason_COMMAND_REJECTION_REASON_ENTITY_ALREADY_EXISTS CommandRejectionReason = 2
+	CommandRejectionReason_COMMAND_REJECTION_REASON_INVALID_PAYLOAD       CommandRejectionReason = 3
+	CommandRejectionReason_COMMAND_REJECTION_REASON_UNKNOWN_COMMAND       CommandRejectionReason = 4
+	// The world already holds its maximum number of entities.
+	CommandRejectionReason_COMMAND_REJECTION_REASON_ENTITY_LIMIT_EXCEEDED CommandRejectionReason = 5
+)
+
+// Enum value maps for CommandRejectionReason.
+var (
+	CommandRejectionReason_name = map[int32]string{
+		0: "COMMAND_REJECTION_REASON_UNSPECIFIED",
+		1: "COMMAND_REJECTION_REASON_ENTITY_NOT_FOUND",
+		2: "COMMAND_REJECTION_REASON_ENTITY_ALREADY_EXISTS",
+		3: "COMMAND_REJECTION_REASON_INVALID_PAYLOAD",
+		4: "COMMAND_REJECTION_REASON_UNKNOWN_COMMAND",
+		5: "COMMAND_REJECTION_REASON_ENTITY_LIMIT_EXCEEDED",
+	}
+	CommandRejectionReason_value = map[string]int32{
+		"COMMAND_REJECTION_REASON_UNSPECIFIED":           0,
+		"COMMAND_REJECTION_REASON_ENTITY_NOT_FOUND":      1,
+		"COMMAND_REJECTION_REASON_ENTITY_ALREADY_EXISTS": 2,
+		"COMMAND_REJECTION_REASON_INVALID_PAYLOAD":       3,
+		"COMMAND_REJECTION_REASON_UNKNOWN_COMMAND":       4,
+		"COMMAND_REJECTION_REASON_ENTITY_LIMIT_EXCEEDED": 5,
+	}
+)
+
+func (x CommandRejectionReason) Enum() *CommandRejectionReason {
+	p := new(CommandRejectionReason)
+	*p = x
+	return p
+}
+
+func (x CommandRejectionReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommandRejectionReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_game_engine_v1_engine_proto_enumTypes[1].Descriptor()
+}
+
+func (CommandRejectionReason) Type() protoreflect.EnumType {
+	return &file_proto_game_engine_v1_engine_proto_enumTypes[1]
+}
+
+func (x CommandRejectionReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommandRejectionReason.Descriptor instead.
+func (CommandRejectionReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{1}
+}
+
 // Error conveys failure information in a forward-compatible way.
 type Error struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -101,7 +166,11 @@ type Error struct {
 	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	// Machine-readable details (e.g. JSON, protobuf Any, etc.).
 	// Kept as bytes to avoid locking this API into a specific details scheme.
-	Details       []byte `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	Details []byte `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	// Key/value diagnostics for programmatic handling, such as the offending
+	// request field ("field": "world_id") or entity id. The message stays the
+	// human-readable summary.
+	Metadata      map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -157,6 +226,13 @@ func (x *Error) GetDetails() []byte {
 	return nil
 }
 
+func (x *Error) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 // InitializeWorldRequest requests engine initialization for a world.
 type InitializeWorldRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -165,7 +241,11 @@ type InitializeWorldRequest struct {
 	// Idempotency key. Repeating the same request_id should be safe.
 	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	// World configuration (game-specific), encoded as structured key/value.
-	Config        *WorldConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Config *WorldConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	// Discard any existing state for world_id and start fresh. When false,
+	// initializing a world that already exists leaves its state untouched and
+	// returns its current tick, so client retries are safe.
+	Force         bool `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -221,6 +301,13 @@ func (x *InitializeWorldRequest) GetConfig() *WorldConfig {
 	return nil
 }
 
+func (x *InitializeWorldRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 // InitializeWorldResponse returns initialization outcome.
 type InitializeWorldResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -309,7 +396,8 @@ func (*InitializeWorldResponse_Error) isInitializeWorldResponse_Result() {}
 // InitializeWorldOk carries a minimal success payload.
 type InitializeWorldOk struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The world tick after initialization (typically 0).
+	// The world tick after initialization: 0 for a new or forced world, the
+	// current tick when an existing world was left in place.
 	InitialTick int64 `protobuf:"varint,1,opt,name=initial_tick,json=initialTick,proto3" json:"initial_tick,omitempty"`
 	// Opaque engine-specific metadata.
 	Metadata      map[string]string `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -361,6 +449,191 @@ func (x *InitializeWorldOk) GetMetadata() map[string]string {
 	return nil
 }
 
+// ResetWorldRequest requests a restart of an existing world.
+type ResetWorldRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the world instance.
+	WorldId string `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// Idempotency key for the reset operation.
+	RequestId     string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetWorldRequest) Reset() {
+	*x = ResetWorldRequest{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetWorldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetWorldRequest) ProtoMessage() {}
+
+func (x *ResetWorldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetWorldRequest.ProtoReflect.Descriptor instead.
+func (*ResetWorldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ResetWorldRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *ResetWorldRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// ResetWorldResponse returns reset outcome. Unknown worlds fail with
+// STATUS_CODE_NOT_FOUND; use InitializeWorld to create one.
+type ResetWorldResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*ResetWorldResponse_Ok
+	//	*ResetWorldResponse_Error
+	Result        isResetWorldResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetWorldResponse) Reset() {
+	*x = ResetWorldResponse{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetWorldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetWorldResponse) ProtoMessage() {}
+
+func (x *ResetWorldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetWorldResponse.ProtoReflect.Descriptor instead.
+func (*ResetWorldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResetWorldResponse) GetResult() isResetWorldResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ResetWorldResponse) GetOk() *ResetWorldOk {
+	if x != nil {
+		if x, ok := x.Result.(*ResetWorldResponse_Ok); ok {
+			return x.Ok
+		}
+	}
+	return nil
+}
+
+func (x *ResetWorldResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*ResetWorldResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isResetWorldResponse_Result interface {
+	isResetWorldResponse_Result()
+}
+
+type ResetWorldResponse_Ok struct {
+	Ok *ResetWorldOk `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type ResetWorldResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ResetWorldResponse_Ok) isResetWorldResponse_Result() {}
+
+func (*ResetWorldResponse_Error) isResetWorldResponse_Result() {}
+
+// ResetWorldOk carries the world's tick after the reset.
+type ResetWorldOk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Always 0: the world restarts from scratch.
+	Tick          int64 `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetWorldOk) Reset() {
+	*x = ResetWorldOk{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetWorldOk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetWorldOk) ProtoMessage() {}
+
+func (x *ResetWorldOk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetWorldOk.ProtoReflect.Descriptor instead.
+func (*ResetWorldOk) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ResetWorldOk) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
 // WorldConfig is an extensible configuration envelope.
 type WorldConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -378,7 +651,7 @@ type WorldConfig struct {
 
 func (x *WorldConfig) Reset() {
 	*x = WorldConfig{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[4]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -390,7 +663,7 @@ func (x *WorldConfig) String() string {
 func (*WorldConfig) ProtoMessage() {}
 
 func (x *WorldConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[4]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,7 +676,7 @@ func (x *WorldConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorldConfig.ProtoReflect.Descriptor instead.
 func (*WorldConfig) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{4}
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *WorldConfig) GetValues() map[string]string {
@@ -450,14 +723,17 @@ type ApplyCommandRequest struct {
 	// The command to apply.
 	Command *Command `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
 	// If true, the engine should validate but not mutate state.
-	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// The tick the client last observed (optional). If set, the engine rejects
+	// the command with STATUS_CODE_CONFLICT once the world has advanced past it.
+	ExpectedCurrentTick int64 `protobuf:"varint,5,opt,name=expected_current_tick,json=expectedCurrentTick,proto3" json:"expected_current_tick,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *ApplyCommandRequest) Reset() {
 	*x = ApplyCommandRequest{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[5]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -469,7 +745,7 @@ func (x *ApplyCommandRequest) String() string {
 func (*ApplyCommandRequest) ProtoMessage() {}
 
 func (x *ApplyCommandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[5]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,7 +758,7 @@ func (x *ApplyCommandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyCommandRequest.ProtoReflect.Descriptor instead.
 func (*ApplyCommandRequest) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{5}
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ApplyCommandRequest) GetWorldId() string {
@@ -513,6 +789,13 @@ func (x *ApplyCommandRequest) GetDryRun() bool {
 	return false
 }
 
+func (x *ApplyCommandRequest) GetExpectedCurrentTick() int64 {
+	if x != nil {
+		return x.ExpectedCurrentTick
+	}
+	return 0
+}
+
 // ApplyCommandResponse returns command application outcome.
 type ApplyCommandResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -527,7 +810,7 @@ type ApplyCommandResponse struct {
 
 func (x *ApplyCommandResponse) Reset() {
 	*x = ApplyCommandResponse{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[6]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -539,7 +822,7 @@ func (x *ApplyCommandResponse) String() string {
 func (*ApplyCommandResponse) ProtoMessage() {}
 
 func (x *ApplyCommandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[6]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +835,7 @@ func (x *ApplyCommandResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyCommandResponse.ProtoReflect.Descriptor instead.
 func (*ApplyCommandResponse) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{6}
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ApplyCommandResponse) GetResult() isApplyCommandResponse_Result {
@@ -611,7 +894,7 @@ type ApplyCommandOk struct {
 
 func (x *ApplyCommandOk) Reset() {
 	*x = ApplyCommandOk{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[7]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -623,7 +906,7 @@ func (x *ApplyCommandOk) String() string {
 func (*ApplyCommandOk) ProtoMessage() {}
 
 func (x *ApplyCommandOk) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[7]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -636,7 +919,7 @@ func (x *ApplyCommandOk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyCommandOk.ProtoReflect.Descriptor instead.
 func (*ApplyCommandOk) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{7}
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ApplyCommandOk) GetAppliedTick() int64 {
@@ -662,6 +945,10 @@ type Command struct {
 	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
 	// When the command was issued (unix epoch millis), for ordering/debugging.
 	IssuedAtUnixMillis int64 `protobuf:"varint,3,opt,name=issued_at_unix_millis,json=issuedAtUnixMillis,proto3" json:"issued_at_unix_millis,omitempty"`
+	// Application priority within a tick; higher values apply first.
+	// When a tick's batch mixes priorities it is ordered by (priority desc,
+	// issued_at_unix_millis, command_id). 0 (default) everywhere keeps FIFO order.
+	Priority int32 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
 	// Extensible command payload.
 	//
 	// Types that are valid to be assigned to Payload:
@@ -669,6 +956,10 @@ type Command struct {
 	//	*Command_Move
 	//	*Command_SpawnEntity
 	//	*Command_DespawnEntity
+	//	*Command_MoveToward
+	//	*Command_TagEntity
+	//	*Command_UntagEntity
+	//	*Command_SetVelocity
 	//	*Command_Opaque
 	Payload       isCommand_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
@@ -677,7 +968,7 @@ type Command struct {
 
 func (x *Command) Reset() {
 	*x = Command{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[8]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -689,7 +980,7 @@ func (x *Command) String() string {
 func (*Command) ProtoMessage() {}
 
 func (x *Command) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[8]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +993,7 @@ func (x *Command) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Command.ProtoReflect.Descriptor instead.
 func (*Command) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{8}
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Command) GetCommandId() string {
@@ -726,6 +1017,13 @@ func (x *Command) GetIssuedAtUnixMillis() int64 {
 	return 0
 }
 
+func (x *Command) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
 func (x *Command) GetPayload() isCommand_Payload {
 	if x != nil {
 		return x.Payload
@@ -760,6 +1058,42 @@ func (x *Command) GetDespawnEntity() *DespawnEntityCommand {
 	return nil
 }
 
+func (x *Command) GetMoveToward() *MoveTowardCommand {
+	if x != nil {
+		if x, ok := x.Payload.(*Command_MoveToward); ok {
+			return x.MoveToward
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetTagEntity() *TagEntityCommand {
+	if x != nil {
+		if x, ok := x.Payload.(*Command_TagEntity); ok {
+			return x.TagEntity
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetUntagEntity() *UntagEntityCommand {
+	if x != nil {
+		if x, ok := x.Payload.(*Command_UntagEntity); ok {
+			return x.UntagEntity
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetSetVelocity() *SetVelocityCommand {
+	if x != nil {
+		if x, ok := x.Payload.(*Command_SetVelocity); ok {
+			return x.SetVelocity
+		}
+	}
+	return nil
+}
+
 func (x *Command) GetOpaque() *OpaqueCommand {
 	if x != nil {
 		if x, ok := x.Payload.(*Command_Opaque); ok {
@@ -785,6 +1119,22 @@ type Command_DespawnEntity struct {
 	DespawnEntity *DespawnEntityCommand `protobuf:"bytes,12,opt,name=despawn_entity,json=despawnEntity,proto3,oneof"`
 }
 
+type Command_MoveToward struct {
+	MoveToward *MoveTowardCommand `protobuf:"bytes,13,opt,name=move_toward,json=moveToward,proto3,oneof"`
+}
+
+type Command_TagEntity struct {
+	TagEntity *TagEntityCommand `protobuf:"bytes,14,opt,name=tag_entity,json=tagEntity,proto3,oneof"`
+}
+
+type Command_UntagEntity struct {
+	UntagEntity *UntagEntityCommand `protobuf:"bytes,15,opt,name=untag_entity,json=untagEntity,proto3,oneof"`
+}
+
+type Command_SetVelocity struct {
+	SetVelocity *SetVelocityCommand `protobuf:"bytes,16,opt,name=set_velocity,json=setVelocity,proto3,oneof"`
+}
+
 type Command_Opaque struct {
 	// Opaque fallback for custom commands.
 	Opaque *OpaqueCommand `protobuf:"bytes,19,opt,name=opaque,proto3,oneof"`
@@ -796,6 +1146,14 @@ func (*Command_SpawnEntity) isCommand_Payload() {}
 
 func (*Command_DespawnEntity) isCommand_Payload() {}
 
+func (*Command_MoveToward) isCommand_Payload() {}
+
+func (*Command_TagEntity) isCommand_Payload() {}
+
+func (*Command_UntagEntity) isCommand_Payload() {}
+
+func (*Command_SetVelocity) isCommand_Payload() {}
+
 func (*Command_Opaque) isCommand_Payload() {}
 
 // MoveCommand requests moving an entity.
@@ -813,7 +1171,7 @@ type MoveCommand struct {
 
 func (x *MoveCommand) Reset() {
 	*x = MoveCommand{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[9]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -825,7 +1183,7 @@ func (x *MoveCommand) String() string {
 func (*MoveCommand) ProtoMessage() {}
 
 func (x *MoveCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[9]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -838,7 +1196,7 @@ func (x *MoveCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveCommand.ProtoReflect.Descriptor instead.
 func (*MoveCommand) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{9}
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *MoveCommand) GetEntityId() string {
@@ -862,20 +1220,89 @@ func (x *MoveCommand) GetVelocity() *Vec3 {
 	return nil
 }
 
+// MoveTowardCommand asks the engine to move an entity toward a target, at most
+// max_speed world units per tick, starting on the tick the command applies. On
+// arrival the engine emits an `arrived` event. A later move, move_toward, or
+// despawn for the same entity replaces the pending movement.
+type MoveTowardCommand struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Target entity.
+	EntityId string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// Position to move toward.
+	Target *Vec3 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// Maximum distance covered per tick; must be positive.
+	MaxSpeed      float64 `protobuf:"fixed64,3,opt,name=max_speed,json=maxSpeed,proto3" json:"max_speed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTowardCommand) Reset() {
+	*x = MoveTowardCommand{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTowardCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTowardCommand) ProtoMessage() {}
+
+func (x *MoveTowardCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTowardCommand.ProtoReflect.Descriptor instead.
+func (*MoveTowardCommand) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MoveTowardCommand) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *MoveTowardCommand) GetTarget() *Vec3 {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *MoveTowardCommand) GetMaxSpeed() float64 {
+	if x != nil {
+		return x.MaxSpeed
+	}
+	return 0
+}
+
 // SpawnEntityCommand requests creating a new entity.
 type SpawnEntityCommand struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Suggested entity id; if empty, the engine may generate one.
 	EntityId string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
 	// Optional initial components.
-	Components    []*Component `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+	Components []*Component `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+	// Optional initial velocity, applied to the entity's transform.
+	Velocity      *Vec3 `protobuf:"bytes,3,opt,name=velocity,proto3" json:"velocity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SpawnEntityCommand) Reset() {
 	*x = SpawnEntityCommand{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[10]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -887,7 +1314,7 @@ func (x *SpawnEntityCommand) String() string {
 func (*SpawnEntityCommand) ProtoMessage() {}
 
 func (x *SpawnEntityCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[10]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -900,7 +1327,7 @@ func (x *SpawnEntityCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpawnEntityCommand.ProtoReflect.Descriptor instead.
 func (*SpawnEntityCommand) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{10}
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SpawnEntityCommand) GetEntityId() string {
@@ -917,6 +1344,13 @@ func (x *SpawnEntityCommand) GetComponents() []*Component {
 	return nil
 }
 
+func (x *SpawnEntityCommand) GetVelocity() *Vec3 {
+	if x != nil {
+		return x.Velocity
+	}
+	return nil
+}
+
 // DespawnEntityCommand requests removing an entity.
 type DespawnEntityCommand struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -928,7 +1362,7 @@ type DespawnEntityCommand struct {
 
 func (x *DespawnEntityCommand) Reset() {
 	*x = DespawnEntityCommand{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[11]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -940,7 +1374,7 @@ func (x *DespawnEntityCommand) String() string {
 func (*DespawnEntityCommand) ProtoMessage() {}
 
 func (x *DespawnEntityCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[11]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -953,7 +1387,7 @@ func (x *DespawnEntityCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DespawnEntityCommand.ProtoReflect.Descriptor instead.
 func (*DespawnEntityCommand) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{11}
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DespawnEntityCommand) GetEntityId() string {
@@ -963,32 +1397,33 @@ func (x *DespawnEntityCommand) GetEntityId() string {
 	return ""
 }
 
-// OpaqueCommand enables forward-compatible custom commands.
-type OpaqueCommand struct {
+// SetVelocityCommand replaces an entity's velocity without touching its
+// position. Engines that integrate velocity then move the entity each tick.
+type SetVelocityCommand struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Application-defined type identifier (e.g. "chat.send").
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	// Opaque payload (e.g. JSON, msgpack, nested protobuf).
-	Data          []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Target entity.
+	EntityId string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// New linear velocity in world units per tick.
+	Velocity      *Vec3 `protobuf:"bytes,2,opt,name=velocity,proto3" json:"velocity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OpaqueCommand) Reset() {
-	*x = OpaqueCommand{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[12]
+func (x *SetVelocityCommand) Reset() {
+	*x = SetVelocityCommand{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OpaqueCommand) String() string {
+func (x *SetVelocityCommand) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpaqueCommand) ProtoMessage() {}
+func (*SetVelocityCommand) ProtoMessage() {}
 
-func (x *OpaqueCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[12]
+func (x *SetVelocityCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -999,65 +1434,52 @@ func (x *OpaqueCommand) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpaqueCommand.ProtoReflect.Descriptor instead.
-func (*OpaqueCommand) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SetVelocityCommand.ProtoReflect.Descriptor instead.
+func (*SetVelocityCommand) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *OpaqueCommand) GetType() string {
+func (x *SetVelocityCommand) GetEntityId() string {
 	if x != nil {
-		return x.Type
+		return x.EntityId
 	}
 	return ""
 }
 
-func (x *OpaqueCommand) GetData() []byte {
+func (x *SetVelocityCommand) GetVelocity() *Vec3 {
 	if x != nil {
-		return x.Data
+		return x.Velocity
 	}
 	return nil
 }
 
-// TickRequest advances simulation for a world.
-type TickRequest struct {
+// TagEntityCommand adds tags to an entity. Tags are non-empty strings without
+// commas; adding a tag the entity already has is a no-op.
+type TagEntityCommand struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique identifier of the world instance.
-	WorldId string `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
-	// Idempotency key for the tick operation.
-	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// The expected current tick (optional). If set, the engine may reject the
-	// request if its current tick differs.
-	ExpectedCurrentTick int64 `protobuf:"varint,3,opt,name=expected_current_tick,json=expectedCurrentTick,proto3" json:"expected_current_tick,omitempty"`
-	// Requested delta time in milliseconds.
-	DeltaMillis int64 `protobuf:"varint,4,opt,name=delta_millis,json=deltaMillis,proto3" json:"delta_millis,omitempty"`
-	// Optional target tick (for catch-up). If set, engine may run multiple
-	// internal steps until reaching target_tick.
-	TargetTick int64 `protobuf:"varint,5,opt,name=target_tick,json=targetTick,proto3" json:"target_tick,omitempty"`
-	// Reserved for clock model extensions.
-	//
-	// Types that are valid to be assigned to Clock:
-	//
-	//	*TickRequest_OpaqueClock
-	Clock         isTickRequest_Clock `protobuf_oneof:"clock"`
+	// Target entity.
+	EntityId string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// Tags to add.
+	Tags          []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TickRequest) Reset() {
-	*x = TickRequest{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[13]
+func (x *TagEntityCommand) Reset() {
+	*x = TagEntityCommand{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TickRequest) String() string {
+func (x *TagEntityCommand) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TickRequest) ProtoMessage() {}
+func (*TagEntityCommand) ProtoMessage() {}
 
-func (x *TickRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[13]
+func (x *TagEntityCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,100 +1490,107 @@ func (x *TickRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TickRequest.ProtoReflect.Descriptor instead.
-func (*TickRequest) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use TagEntityCommand.ProtoReflect.Descriptor instead.
+func (*TagEntityCommand) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *TickRequest) GetWorldId() string {
+func (x *TagEntityCommand) GetEntityId() string {
 	if x != nil {
-		return x.WorldId
+		return x.EntityId
 	}
 	return ""
 }
 
-func (x *TickRequest) GetRequestId() string {
+func (x *TagEntityCommand) GetTags() []string {
 	if x != nil {
-		return x.RequestId
+		return x.Tags
 	}
-	return ""
+	return nil
 }
 
-func (x *TickRequest) GetExpectedCurrentTick() int64 {
-	if x != nil {
-		return x.ExpectedCurrentTick
-	}
-	return 0
+// UntagEntityCommand removes tags from an entity. Removing a tag the entity
+// does not have is a no-op.
+type UntagEntityCommand struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Target entity.
+	EntityId string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// Tags to remove.
+	Tags          []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TickRequest) GetDeltaMillis() int64 {
-	if x != nil {
-		return x.DeltaMillis
-	}
-	return 0
+func (x *UntagEntityCommand) Reset() {
+	*x = UntagEntityCommand{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *TickRequest) GetTargetTick() int64 {
-	if x != nil {
-		return x.TargetTick
-	}
-	return 0
+func (x *UntagEntityCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TickRequest) GetClock() isTickRequest_Clock {
-	if x != nil {
-		return x.Clock
-	}
-	return nil
-}
+func (*UntagEntityCommand) ProtoMessage() {}
 
-func (x *TickRequest) GetOpaqueClock() []byte {
+func (x *UntagEntityCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[18]
 	if x != nil {
-		if x, ok := x.Clock.(*TickRequest_OpaqueClock); ok {
-			return x.OpaqueClock
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type isTickRequest_Clock interface {
-	isTickRequest_Clock()
+// Deprecated: Use UntagEntityCommand.ProtoReflect.Descriptor instead.
+func (*UntagEntityCommand) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{18}
 }
 
-type TickRequest_OpaqueClock struct {
-	// Opaque clock payload for advanced timing schemes.
-	OpaqueClock []byte `protobuf:"bytes,10,opt,name=opaque_clock,json=opaqueClock,proto3,oneof"`
+func (x *UntagEntityCommand) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
 }
 
-func (*TickRequest_OpaqueClock) isTickRequest_Clock() {}
+func (x *UntagEntityCommand) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
 
-// TickResponse returns tick outcome.
-type TickResponse struct {
+// OpaqueCommand enables forward-compatible custom commands.
+type OpaqueCommand struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Result:
-	//
-	//	*TickResponse_Ok
-	//	*TickResponse_Error
-	Result        isTickResponse_Result `protobuf_oneof:"result"`
+	// Application-defined type identifier (e.g. "chat.send").
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Opaque payload (e.g. JSON, msgpack, nested protobuf).
+	Data          []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TickResponse) Reset() {
-	*x = TickResponse{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[14]
+func (x *OpaqueCommand) Reset() {
+	*x = OpaqueCommand{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TickResponse) String() string {
+func (x *OpaqueCommand) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TickResponse) ProtoMessage() {}
+func (*OpaqueCommand) ProtoMessage() {}
 
-func (x *TickResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[14]
+func (x *OpaqueCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1172,78 +1601,99 @@ func (x *TickResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TickResponse.ProtoReflect.Descriptor instead.
-func (*TickResponse) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use OpaqueCommand.ProtoReflect.Descriptor instead.
+func (*OpaqueCommand) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *TickResponse) GetResult() isTickResponse_Result {
+func (x *OpaqueCommand) GetType() string {
 	if x != nil {
-		return x.Result
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-func (x *TickResponse) GetOk() *TickOk {
+func (x *OpaqueCommand) GetData() []byte {
 	if x != nil {
-		if x, ok := x.Result.(*TickResponse_Ok); ok {
-			return x.Ok
-		}
+		return x.Data
 	}
 	return nil
 }
 
-func (x *TickResponse) GetError() *Error {
-	if x != nil {
-		if x, ok := x.Result.(*TickResponse_Error); ok {
-			return x.Error
-		}
-	}
-	return nil
+// ValidateCommandRequest carries a command to validate statelessly.
+type ValidateCommandRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The command to validate.
+	Command       *Command `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type isTickResponse_Result interface {
-	isTickResponse_Result()
+func (x *ValidateCommandRequest) Reset() {
+	*x = ValidateCommandRequest{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type TickResponse_Ok struct {
-	Ok *TickOk `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+func (x *ValidateCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type TickResponse_Error struct {
-	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+func (*ValidateCommandRequest) ProtoMessage() {}
+
+func (x *ValidateCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*TickResponse_Ok) isTickResponse_Result() {}
+// Deprecated: Use ValidateCommandRequest.ProtoReflect.Descriptor instead.
+func (*ValidateCommandRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{20}
+}
 
-func (*TickResponse_Error) isTickResponse_Result() {}
+func (x *ValidateCommandRequest) GetCommand() *Command {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
 
-// TickOk carries tick results.
-type TickOk struct {
+// ValidateCommandResponse reports whether the command is well-formed.
+// Invalid commands fail with STATUS_CODE_INVALID_ARGUMENT.
+type ValidateCommandResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The tick after advancement.
-	NewTick int64 `protobuf:"varint,1,opt,name=new_tick,json=newTick,proto3" json:"new_tick,omitempty"`
-	// Optional engine-generated events.
-	Events        []*Event `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*ValidateCommandResponse_Ok
+	//	*ValidateCommandResponse_Error
+	Result        isValidateCommandResponse_Result `protobuf_oneof:"result"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TickOk) Reset() {
-	*x = TickOk{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[15]
+func (x *ValidateCommandResponse) Reset() {
+	*x = ValidateCommandResponse{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TickOk) String() string {
+func (x *ValidateCommandResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TickOk) ProtoMessage() {}
+func (*ValidateCommandResponse) ProtoMessage() {}
 
-func (x *TickOk) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[15]
+func (x *ValidateCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1254,62 +1704,135 @@ func (x *TickOk) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TickOk.ProtoReflect.Descriptor instead.
-func (*TickOk) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ValidateCommandResponse.ProtoReflect.Descriptor instead.
+func (*ValidateCommandResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *TickOk) GetNewTick() int64 {
+func (x *ValidateCommandResponse) GetResult() isValidateCommandResponse_Result {
 	if x != nil {
-		return x.NewTick
+		return x.Result
 	}
-	return 0
+	return nil
 }
 
-func (x *TickOk) GetEvents() []*Event {
+func (x *ValidateCommandResponse) GetOk() *ValidateCommandOk {
 	if x != nil {
-		return x.Events
+		if x, ok := x.Result.(*ValidateCommandResponse_Ok); ok {
+			return x.Ok
+		}
 	}
 	return nil
 }
 
-// GetStateSnapshotRequest requests a point-in-time state view.
-type GetStateSnapshotRequest struct {
+func (x *ValidateCommandResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*ValidateCommandResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isValidateCommandResponse_Result interface {
+	isValidateCommandResponse_Result()
+}
+
+type ValidateCommandResponse_Ok struct {
+	Ok *ValidateCommandOk `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type ValidateCommandResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ValidateCommandResponse_Ok) isValidateCommandResponse_Result() {}
+
+func (*ValidateCommandResponse_Error) isValidateCommandResponse_Result() {}
+
+// ValidateCommandOk signals a well-formed command. It does not guarantee the
+// command will apply: world-dependent checks (e.g. entity existence) still
+// happen on ApplyCommand.
+type ValidateCommandOk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateCommandOk) Reset() {
+	*x = ValidateCommandOk{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateCommandOk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateCommandOk) ProtoMessage() {}
+
+func (x *ValidateCommandOk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateCommandOk.ProtoReflect.Descriptor instead.
+func (*ValidateCommandOk) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{22}
+}
+
+// TickRequest advances simulation for a world.
+type TickRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Unique identifier of the world instance.
 	WorldId string `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
-	// Idempotency key for the snapshot operation.
+	// Idempotency key for the tick operation.
 	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// Snapshot selector.
+	// The expected current tick (optional). If set, the engine may reject the
+	// request if its current tick differs.
+	ExpectedCurrentTick int64 `protobuf:"varint,3,opt,name=expected_current_tick,json=expectedCurrentTick,proto3" json:"expected_current_tick,omitempty"`
+	// Requested delta time in milliseconds.
+	DeltaMillis int64 `protobuf:"varint,4,opt,name=delta_millis,json=deltaMillis,proto3" json:"delta_millis,omitempty"`
+	// Optional target tick (for catch-up). If set, engine may run multiple
+	// internal steps until reaching target_tick.
+	TargetTick int64 `protobuf:"varint,5,opt,name=target_tick,json=targetTick,proto3" json:"target_tick,omitempty"`
+	// If non-empty, TickOk.events only includes events whose type starts with
+	// one of these prefixes (e.g. "physics.entity."). State advances and events
+	// are retained for GetEvents either way. Empty returns every event.
+	EventTypePrefixes []string `protobuf:"bytes,6,rep,name=event_type_prefixes,json=eventTypePrefixes,proto3" json:"event_type_prefixes,omitempty"`
+	// Reserved for clock model extensions.
 	//
-	// Types that are valid to be assigned to Selector:
+	// Types that are valid to be assigned to Clock:
 	//
-	//	*GetStateSnapshotRequest_Latest
-	//	*GetStateSnapshotRequest_AtTick
-	Selector isGetStateSnapshotRequest_Selector `protobuf_oneof:"selector"`
-	// If non-empty, only include entities in this allowlist.
-	EntityIds []string `protobuf:"bytes,20,rep,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
-	// If true, include component payloads in returned entities.
-	IncludeComponents bool `protobuf:"varint,21,opt,name=include_components,json=includeComponents,proto3" json:"include_components,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	//	*TickRequest_OpaqueClock
+	Clock         isTickRequest_Clock `protobuf_oneof:"clock"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStateSnapshotRequest) Reset() {
-	*x = GetStateSnapshotRequest{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[16]
+func (x *TickRequest) Reset() {
+	*x = TickRequest{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStateSnapshotRequest) String() string {
+func (x *TickRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStateSnapshotRequest) ProtoMessage() {}
+func (*TickRequest) ProtoMessage() {}
 
-func (x *GetStateSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[16]
+func (x *TickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1320,109 +1843,107 @@ func (x *GetStateSnapshotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStateSnapshotRequest.ProtoReflect.Descriptor instead.
-func (*GetStateSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use TickRequest.ProtoReflect.Descriptor instead.
+func (*TickRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetStateSnapshotRequest) GetWorldId() string {
+func (x *TickRequest) GetWorldId() string {
 	if x != nil {
 		return x.WorldId
 	}
 	return ""
 }
 
-func (x *GetStateSnapshotRequest) GetRequestId() string {
+func (x *TickRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *GetStateSnapshotRequest) GetSelector() isGetStateSnapshotRequest_Selector {
+func (x *TickRequest) GetExpectedCurrentTick() int64 {
 	if x != nil {
-		return x.Selector
+		return x.ExpectedCurrentTick
 	}
-	return nil
+	return 0
 }
 
-func (x *GetStateSnapshotRequest) GetLatest() *SnapshotLatest {
+func (x *TickRequest) GetDeltaMillis() int64 {
 	if x != nil {
-		if x, ok := x.Selector.(*GetStateSnapshotRequest_Latest); ok {
-			return x.Latest
-		}
+		return x.DeltaMillis
 	}
-	return nil
+	return 0
 }
 
-func (x *GetStateSnapshotRequest) GetAtTick() *SnapshotAtTick {
+func (x *TickRequest) GetTargetTick() int64 {
 	if x != nil {
-		if x, ok := x.Selector.(*GetStateSnapshotRequest_AtTick); ok {
-			return x.AtTick
-		}
+		return x.TargetTick
 	}
-	return nil
+	return 0
 }
 
-func (x *GetStateSnapshotRequest) GetEntityIds() []string {
+func (x *TickRequest) GetEventTypePrefixes() []string {
 	if x != nil {
-		return x.EntityIds
+		return x.EventTypePrefixes
 	}
 	return nil
 }
 
-func (x *GetStateSnapshotRequest) GetIncludeComponents() bool {
+func (x *TickRequest) GetClock() isTickRequest_Clock {
 	if x != nil {
-		return x.IncludeComponents
+		return x.Clock
 	}
-	return false
+	return nil
 }
 
-type isGetStateSnapshotRequest_Selector interface {
-	isGetStateSnapshotRequest_Selector()
+func (x *TickRequest) GetOpaqueClock() []byte {
+	if x != nil {
+		if x, ok := x.Clock.(*TickRequest_OpaqueClock); ok {
+			return x.OpaqueClock
+		}
+	}
+	return nil
 }
 
-type GetStateSnapshotRequest_Latest struct {
-	// Return the latest committed state.
-	Latest *SnapshotLatest `protobuf:"bytes,10,opt,name=latest,proto3,oneof"`
+type isTickRequest_Clock interface {
+	isTickRequest_Clock()
 }
 
-type GetStateSnapshotRequest_AtTick struct {
-	// Return state at a specific tick.
-	AtTick *SnapshotAtTick `protobuf:"bytes,11,opt,name=at_tick,json=atTick,proto3,oneof"`
+type TickRequest_OpaqueClock struct {
+	// Opaque clock payload for advanced timing schemes.
+	OpaqueClock []byte `protobuf:"bytes,10,opt,name=opaque_clock,json=opaqueClock,proto3,oneof"`
 }
 
-func (*GetStateSnapshotRequest_Latest) isGetStateSnapshotRequest_Selector() {}
-
-func (*GetStateSnapshotRequest_AtTick) isGetStateSnapshotRequest_Selector() {}
+func (*TickRequest_OpaqueClock) isTickRequest_Clock() {}
 
-// GetStateSnapshotResponse returns snapshot outcome.
-type GetStateSnapshotResponse struct {
+// TickResponse returns tick outcome.
+type TickResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Result:
 	//
-	//	*GetStateSnapshotResponse_Ok
-	//	*GetStateSnapshotResponse_Error
-	Result        isGetStateSnapshotResponse_Result `protobuf_oneof:"result"`
+	//	*TickResponse_Ok
+	//	*TickResponse_Error
+	Result        isTickResponse_Result `protobuf_oneof:"result"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStateSnapshotResponse) Reset() {
-	*x = GetStateSnapshotResponse{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[17]
+func (x *TickResponse) Reset() {
+	*x = TickResponse{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStateSnapshotResponse) String() string {
+func (x *TickResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStateSnapshotResponse) ProtoMessage() {}
+func (*TickResponse) ProtoMessage() {}
 
-func (x *GetStateSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[17]
+func (x *TickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1433,78 +1954,1044 @@ func (x *GetStateSnapshotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStateSnapshotResponse.ProtoReflect.Descriptor instead.
-func (*GetStateSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use TickResponse.ProtoReflect.Descriptor instead.
+func (*TickResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetStateSnapshotResponse) GetResult() isGetStateSnapshotResponse_Result {
+func (x *TickResponse) GetResult() isTickResponse_Result {
 	if x != nil {
 		return x.Result
 	}
 	return nil
 }
 
-func (x *GetStateSnapshotResponse) GetOk() *GetStateSnapshotOk {
+func (x *TickResponse) GetOk() *TickOk {
 	if x != nil {
-		if x, ok := x.Result.(*GetStateSnapshotResponse_Ok); ok {
+		if x, ok := x.Result.(*TickResponse_Ok); ok {
 			return x.Ok
 		}
 	}
 	return nil
 }
 
-func (x *GetStateSnapshotResponse) GetError() *Error {
+func (x *TickResponse) GetError() *Error {
 	if x != nil {
-		if x, ok := x.Result.(*GetStateSnapshotResponse_Error); ok {
+		if x, ok := x.Result.(*TickResponse_Error); ok {
 			return x.Error
 		}
 	}
 	return nil
 }
 
-type isGetStateSnapshotResponse_Result interface {
-	isGetStateSnapshotResponse_Result()
+type isTickResponse_Result interface {
+	isTickResponse_Result()
 }
 
-type GetStateSnapshotResponse_Ok struct {
-	Ok *GetStateSnapshotOk `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+type TickResponse_Ok struct {
+	Ok *TickOk `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
 }
 
-type GetStateSnapshotResponse_Error struct {
+type TickResponse_Error struct {
 	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*GetStateSnapshotResponse_Ok) isGetStateSnapshotResponse_Result() {}
+func (*TickResponse_Ok) isTickResponse_Result() {}
 
-func (*GetStateSnapshotResponse_Error) isGetStateSnapshotResponse_Result() {}
+func (*TickResponse_Error) isTickResponse_Result() {}
+
+// TickOk carries tick results.
+type TickOk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tick after advancement.
+	NewTick int64 `protobuf:"varint,1,opt,name=new_tick,json=newTick,proto3" json:"new_tick,omitempty"`
+	// Optional engine-generated events.
+	Events []*Event `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	// Opaque engine-specific metadata, e.g. a note that target_tick was not
+	// reached because the engine caps how far one call may advance.
+	Metadata      map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TickOk) Reset() {
+	*x = TickOk{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TickOk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TickOk) ProtoMessage() {}
+
+func (x *TickOk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TickOk.ProtoReflect.Descriptor instead.
+func (*TickOk) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TickOk) GetNewTick() int64 {
+	if x != nil {
+		return x.NewTick
+	}
+	return 0
+}
+
+func (x *TickOk) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *TickOk) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// GetStateSnapshotRequest requests a point-in-time state view.
+type GetStateSnapshotRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the world instance.
+	WorldId string `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// Idempotency key for the snapshot operation.
+	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Snapshot selector.
+	//
+	// Types that are valid to be assigned to Selector:
+	//
+	//	*GetStateSnapshotRequest_Latest
+	//	*GetStateSnapshotRequest_AtTick
+	Selector isGetStateSnapshotRequest_Selector `protobuf_oneof:"selector"`
+	// If non-empty, only include entities in this allowlist.
+	EntityIds []string `protobuf:"bytes,20,rep,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
+	// If true, include component payloads in returned entities.
+	IncludeComponents bool `protobuf:"varint,21,opt,name=include_components,json=includeComponents,proto3" json:"include_components,omitempty"`
+	// If true, the server may return the snapshot gzip-compressed in
+	// GetStateSnapshotOk.compressed_world_state instead of world_state.
+	Compress bool `protobuf:"varint,22,opt,name=compress,proto3" json:"compress,omitempty"`
+	// If non-empty, only include entities that carry every one of these tags.
+	// Combines with entity_ids.
+	Tags          []string `protobuf:"bytes,23,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStateSnapshotRequest) Reset() {
+	*x = GetStateSnapshotRequest{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStateSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateSnapshotRequest) ProtoMessage() {}
+
+func (x *GetStateSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetStateSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetStateSnapshotRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *GetStateSnapshotRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *GetStateSnapshotRequest) GetSelector() isGetStateSnapshotRequest_Selector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *GetStateSnapshotRequest) GetLatest() *SnapshotLatest {
+	if x != nil {
+		if x, ok := x.Selector.(*GetStateSnapshotRequest_Latest); ok {
+			return x.Latest
+		}
+	}
+	return nil
+}
+
+func (x *GetStateSnapshotRequest) GetAtTick() *SnapshotAtTick {
+	if x != nil {
+		if x, ok := x.Selector.(*GetStateSnapshotRequest_AtTick); ok {
+			return x.AtTick
+		}
+	}
+	return nil
+}
+
+func (x *GetStateSnapshotRequest) GetEntityIds() []string {
+	if x != nil {
+		return x.EntityIds
+	}
+	return nil
+}
+
+func (x *GetStateSnapshotRequest) GetIncludeComponents() bool {
+	if x != nil {
+		return x.IncludeComponents
+	}
+	return false
+}
+
+func (x *GetStateSnapshotRequest) GetCompress() bool {
+	if x != nil {
+		return x.Compress
+	}
+	return false
+}
+
+func (x *GetStateSnapshotRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type isGetStateSnapshotRequest_Selector interface {
+	isGetStateSnapshotRequest_Selector()
+}
+
+type GetStateSnapshotRequest_Latest struct {
+	// Return the latest committed state.
+	Latest *SnapshotLatest `protobuf:"bytes,10,opt,name=latest,proto3,oneof"`
+}
+
+type GetStateSnapshotRequest_AtTick struct {
+	// Return state at a specific tick.
+	AtTick *SnapshotAtTick `protobuf:"bytes,11,opt,name=at_tick,json=atTick,proto3,oneof"`
+}
+
+func (*GetStateSnapshotRequest_Latest) isGetStateSnapshotRequest_Selector() {}
+
+func (*GetStateSnapshotRequest_AtTick) isGetStateSnapshotRequest_Selector() {}
+
+// GetStateSnapshotResponse returns snapshot outcome.
+type GetStateSnapshotResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*GetStateSnapshotResponse_Ok
+	//	*GetStateSnapshotResponse_Error
+	Result        isGetStateSnapshotResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStateSnapshotResponse) Reset() {
+	*x = GetStateSnapshotResponse{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStateSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateSnapshotResponse) ProtoMessage() {}
+
+func (x *GetStateSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*GetStateSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetStateSnapshotResponse) GetResult() isGetStateSnapshotResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetStateSnapshotResponse) GetOk() *GetStateSnapshotOk {
+	if x != nil {
+		if x, ok := x.Result.(*GetStateSnapshotResponse_Ok); ok {
+			return x.Ok
+		}
+	}
+	return nil
+}
+
+func (x *GetStateSnapshotResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*GetStateSnapshotResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isGetStateSnapshotResponse_Result interface {
+	isGetStateSnapshotResponse_Result()
+}
+
+type GetStateSnapshotResponse_Ok struct {
+	Ok *GetStateSnapshotOk `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type GetStateSnapshotResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetStateSnapshotResponse_Ok) isGetStateSnapshotResponse_Result() {}
+
+func (*GetStateSnapshotResponse_Error) isGetStateSnapshotResponse_Result() {}
 
 // GetStateSnapshotOk contains the world snapshot.
 type GetStateSnapshotOk struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Snapshot data.
-	WorldState *WorldState `protobuf:"bytes,1,opt,name=world_state,json=worldState,proto3" json:"world_state,omitempty"`
-	// Opaque metadata (e.g., compression, schema hints).
-	Metadata      map[string]string `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Snapshot data.
+	WorldState *WorldState `protobuf:"bytes,1,opt,name=world_state,json=worldState,proto3" json:"world_state,omitempty"`
+	// Opaque metadata (e.g., compression, schema hints).
+	Metadata map[string]string `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Serialized WorldState, compressed per metadata["content-encoding"] (e.g. "gzip").
+	// Set instead of world_state when the request asked for compression.
+	CompressedWorldState []byte `protobuf:"bytes,3,opt,name=compressed_world_state,json=compressedWorldState,proto3" json:"compressed_world_state,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetStateSnapshotOk) Reset() {
+	*x = GetStateSnapshotOk{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStateSnapshotOk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateSnapshotOk) ProtoMessage() {}
+
+func (x *GetStateSnapshotOk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateSnapshotOk.ProtoReflect.Descriptor instead.
+func (*GetStateSnapshotOk) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetStateSnapshotOk) GetWorldState() *WorldState {
+	if x != nil {
+		return x.WorldState
+	}
+	return nil
+}
+
+func (x *GetStateSnapshotOk) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GetStateSnapshotOk) GetCompressedWorldState() []byte {
+	if x != nil {
+		return x.CompressedWorldState
+	}
+	return nil
+}
+
+// GetStateSnapshotsRequest requests the latest state of several worlds.
+type GetStateSnapshotsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Worlds to snapshot. Results are returned in the same order.
+	WorldIds []string `protobuf:"bytes,1,rep,name=world_ids,json=worldIds,proto3" json:"world_ids,omitempty"`
+	// If true, include component payloads in returned entities.
+	IncludeComponents bool `protobuf:"varint,2,opt,name=include_components,json=includeComponents,proto3" json:"include_components,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetStateSnapshotsRequest) Reset() {
+	*x = GetStateSnapshotsRequest{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStateSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateSnapshotsRequest) ProtoMessage() {}
+
+func (x *GetStateSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*GetStateSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetStateSnapshotsRequest) GetWorldIds() []string {
+	if x != nil {
+		return x.WorldIds
+	}
+	return nil
+}
+
+func (x *GetStateSnapshotsRequest) GetIncludeComponents() bool {
+	if x != nil {
+		return x.IncludeComponents
+	}
+	return false
+}
+
+// GetStateSnapshotsResponse returns per-world snapshot results. A missing or
+// failing world is reported in its own entry rather than failing the request.
+type GetStateSnapshotsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*GetStateSnapshotsResponse_Ok
+	//	*GetStateSnapshotsResponse_Error
+	Result        isGetStateSnapshotsResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStateSnapshotsResponse) Reset() {
+	*x = GetStateSnapshotsResponse{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStateSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateSnapshotsResponse) ProtoMessage() {}
+
+func (x *GetStateSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*GetStateSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetStateSnapshotsResponse) GetResult() isGetStateSnapshotsResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetStateSnapshotsResponse) GetOk() *GetStateSnapshotsOk {
+	if x != nil {
+		if x, ok := x.Result.(*GetStateSnapshotsResponse_Ok); ok {
+			return x.Ok
+		}
+	}
+	return nil
+}
+
+func (x *GetStateSnapshotsResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*GetStateSnapshotsResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isGetStateSnapshotsResponse_Result interface {
+	isGetStateSnapshotsResponse_Result()
+}
+
+type GetStateSnapshotsResponse_Ok struct {
+	Ok *GetStateSnapshotsOk `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type GetStateSnapshotsResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetStateSnapshotsResponse_Ok) isGetStateSnapshotsResponse_Result() {}
+
+func (*GetStateSnapshotsResponse_Error) isGetStateSnapshotsResponse_Result() {}
+
+// GetStateSnapshotsOk contains one result per requested world id.
+type GetStateSnapshotsOk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*WorldSnapshotResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStateSnapshotsOk) Reset() {
+	*x = GetStateSnapshotsOk{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStateSnapshotsOk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateSnapshotsOk) ProtoMessage() {}
+
+func (x *GetStateSnapshotsOk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateSnapshotsOk.ProtoReflect.Descriptor instead.
+func (*GetStateSnapshotsOk) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetStateSnapshotsOk) GetResults() []*WorldSnapshotResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// WorldSnapshotResult is the outcome for a single world.
+type WorldSnapshotResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The requested world id.
+	WorldId string `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*WorldSnapshotResult_WorldState
+	//	*WorldSnapshotResult_Error
+	Result        isWorldSnapshotResult_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldSnapshotResult) Reset() {
+	*x = WorldSnapshotResult{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldSnapshotResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldSnapshotResult) ProtoMessage() {}
+
+func (x *WorldSnapshotResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldSnapshotResult.ProtoReflect.Descriptor instead.
+func (*WorldSnapshotResult) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WorldSnapshotResult) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *WorldSnapshotResult) GetResult() isWorldSnapshotResult_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *WorldSnapshotResult) GetWorldState() *WorldState {
+	if x != nil {
+		if x, ok := x.Result.(*WorldSnapshotResult_WorldState); ok {
+			return x.WorldState
+		}
+	}
+	return nil
+}
+
+func (x *WorldSnapshotResult) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*WorldSnapshotResult_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isWorldSnapshotResult_Result interface {
+	isWorldSnapshotResult_Result()
+}
+
+type WorldSnapshotResult_WorldState struct {
+	// Snapshot of the world's latest committed state.
+	WorldState *WorldState `protobuf:"bytes,2,opt,name=world_state,json=worldState,proto3,oneof"`
+}
+
+type WorldSnapshotResult_Error struct {
+	// Why this world could not be snapshotted (e.g. STATUS_CODE_NOT_FOUND).
+	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*WorldSnapshotResult_WorldState) isWorldSnapshotResult_Result() {}
+
+func (*WorldSnapshotResult_Error) isWorldSnapshotResult_Result() {}
+
+// SnapshotLatest selects the latest committed state.
+type SnapshotLatest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotLatest) Reset() {
+	*x = SnapshotLatest{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotLatest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotLatest) ProtoMessage() {}
+
+func (x *SnapshotLatest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotLatest.ProtoReflect.Descriptor instead.
+func (*SnapshotLatest) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{33}
+}
+
+// SnapshotAtTick selects state at a specific tick.
+type SnapshotAtTick struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tick to snapshot.
+	Tick          int64 `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStateSnapshotOk) Reset() {
-	*x = GetStateSnapshotOk{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[18]
+func (x *SnapshotAtTick) Reset() {
+	*x = SnapshotAtTick{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotAtTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotAtTick) ProtoMessage() {}
+
+func (x *SnapshotAtTick) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotAtTick.ProtoReflect.Descriptor instead.
+func (*SnapshotAtTick) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SnapshotAtTick) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+// GetEventsRequest selects events emitted in an inclusive tick range.
+type GetEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Target world.
+	WorldId string `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// First tick to return events for (inclusive).
+	FromTick int64 `protobuf:"varint,2,opt,name=from_tick,json=fromTick,proto3" json:"from_tick,omitempty"`
+	// Last tick to return events for (inclusive). 0 means the current tick.
+	ToTick        int64 `protobuf:"varint,3,opt,name=to_tick,json=toTick,proto3" json:"to_tick,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventsRequest) Reset() {
+	*x = GetEventsRequest{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsRequest) ProtoMessage() {}
+
+func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetEventsRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetFromTick() int64 {
+	if x != nil {
+		return x.FromTick
+	}
+	return 0
+}
+
+func (x *GetEventsRequest) GetToTick() int64 {
+	if x != nil {
+		return x.ToTick
+	}
+	return 0
+}
+
+// GetEventsResponse returns events or an error. Ranges that start before
+// the engine's retention window fail with STATUS_CODE_FAILED_PRECONDITION.
+type GetEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*GetEventsResponse_Ok
+	//	*GetEventsResponse_Error
+	Result        isGetEventsResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventsResponse) Reset() {
+	*x = GetEventsResponse{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsResponse) ProtoMessage() {}
+
+func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetEventsResponse) GetResult() isGetEventsResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetEventsResponse) GetOk() *GetEventsOk {
+	if x != nil {
+		if x, ok := x.Result.(*GetEventsResponse_Ok); ok {
+			return x.Ok
+		}
+	}
+	return nil
+}
+
+func (x *GetEventsResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*GetEventsResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isGetEventsResponse_Result interface {
+	isGetEventsResponse_Result()
+}
+
+type GetEventsResponse_Ok struct {
+	Ok *GetEventsOk `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type GetEventsResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetEventsResponse_Ok) isGetEventsResponse_Result() {}
+
+func (*GetEventsResponse_Error) isGetEventsResponse_Result() {}
+
+// GetEventsOk contains events in tick order.
+type GetEventsOk struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Events []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// Oldest tick whose events are still retained.
+	OldestRetainedTick int64 `protobuf:"varint,2,opt,name=oldest_retained_tick,json=oldestRetainedTick,proto3" json:"oldest_retained_tick,omitempty"`
+	// The world's current tick.
+	CurrentTick   int64 `protobuf:"varint,3,opt,name=current_tick,json=currentTick,proto3" json:"current_tick,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventsOk) Reset() {
+	*x = GetEventsOk{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventsOk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsOk) ProtoMessage() {}
+
+func (x *GetEventsOk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsOk.ProtoReflect.Descriptor instead.
+func (*GetEventsOk) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetEventsOk) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetEventsOk) GetOldestRetainedTick() int64 {
+	if x != nil {
+		return x.OldestRetainedTick
+	}
+	return 0
+}
+
+func (x *GetEventsOk) GetCurrentTick() int64 {
+	if x != nil {
+		return x.CurrentTick
+	}
+	return 0
+}
+
+// ListWorldsRequest requests a summary of every world held by the module.
+type ListWorldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorldsRequest) Reset() {
+	*x = ListWorldsRequest{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorldsRequest) ProtoMessage() {}
+
+func (x *ListWorldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorldsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorldsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{38}
+}
+
+// ListWorldsResponse returns the module's worlds.
+type ListWorldsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*ListWorldsResponse_Ok
+	//	*ListWorldsResponse_Error
+	Result        isListWorldsResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorldsResponse) Reset() {
+	*x = ListWorldsResponse{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStateSnapshotOk) String() string {
+func (x *ListWorldsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStateSnapshotOk) ProtoMessage() {}
+func (*ListWorldsResponse) ProtoMessage() {}
 
-func (x *GetStateSnapshotOk) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[18]
+func (x *ListWorldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1515,47 +3002,75 @@ func (x *GetStateSnapshotOk) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStateSnapshotOk.ProtoReflect.Descriptor instead.
-func (*GetStateSnapshotOk) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListWorldsResponse.ProtoReflect.Descriptor instead.
+func (*ListWorldsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetStateSnapshotOk) GetWorldState() *WorldState {
+func (x *ListWorldsResponse) GetResult() isListWorldsResponse_Result {
 	if x != nil {
-		return x.WorldState
+		return x.Result
 	}
 	return nil
 }
 
-func (x *GetStateSnapshotOk) GetMetadata() map[string]string {
+func (x *ListWorldsResponse) GetOk() *ListWorldsOk {
 	if x != nil {
-		return x.Metadata
+		if x, ok := x.Result.(*ListWorldsResponse_Ok); ok {
+			return x.Ok
+		}
 	}
 	return nil
 }
 
-// SnapshotLatest selects the latest committed state.
-type SnapshotLatest struct {
+func (x *ListWorldsResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Result.(*ListWorldsResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isListWorldsResponse_Result interface {
+	isListWorldsResponse_Result()
+}
+
+type ListWorldsResponse_Ok struct {
+	Ok *ListWorldsOk `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type ListWorldsResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ListWorldsResponse_Ok) isListWorldsResponse_Result() {}
+
+func (*ListWorldsResponse_Error) isListWorldsResponse_Result() {}
+
+// ListWorldsOk contains one summary per world, sorted by world id.
+type ListWorldsOk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Worlds        []*WorldSummary        `protobuf:"bytes,1,rep,name=worlds,proto3" json:"worlds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SnapshotLatest) Reset() {
-	*x = SnapshotLatest{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[19]
+func (x *ListWorldsOk) Reset() {
+	*x = ListWorldsOk{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SnapshotLatest) String() string {
+func (x *ListWorldsOk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SnapshotLatest) ProtoMessage() {}
+func (*ListWorldsOk) ProtoMessage() {}
 
-func (x *SnapshotLatest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[19]
+func (x *ListWorldsOk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1566,35 +3081,45 @@ func (x *SnapshotLatest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SnapshotLatest.ProtoReflect.Descriptor instead.
-func (*SnapshotLatest) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListWorldsOk.ProtoReflect.Descriptor instead.
+func (*ListWorldsOk) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{40}
 }
 
-// SnapshotAtTick selects state at a specific tick.
-type SnapshotAtTick struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The tick to snapshot.
-	Tick          int64 `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+func (x *ListWorldsOk) GetWorlds() []*WorldSummary {
+	if x != nil {
+		return x.Worlds
+	}
+	return nil
+}
+
+// WorldSummary is a lightweight view of a world for operator tooling.
+type WorldSummary struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	WorldId string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// Current tick of the world.
+	Tick int64 `protobuf:"varint,2,opt,name=tick,proto3" json:"tick,omitempty"`
+	// Number of entities in the world.
+	EntityCount   int64 `protobuf:"varint,3,opt,name=entity_count,json=entityCount,proto3" json:"entity_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SnapshotAtTick) Reset() {
-	*x = SnapshotAtTick{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[20]
+func (x *WorldSummary) Reset() {
+	*x = WorldSummary{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SnapshotAtTick) String() string {
+func (x *WorldSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SnapshotAtTick) ProtoMessage() {}
+func (*WorldSummary) ProtoMessage() {}
 
-func (x *SnapshotAtTick) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[20]
+func (x *WorldSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1605,18 +3130,32 @@ func (x *SnapshotAtTick) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SnapshotAtTick.ProtoReflect.Descriptor instead.
-func (*SnapshotAtTick) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use WorldSummary.ProtoReflect.Descriptor instead.
+func (*WorldSummary) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *SnapshotAtTick) GetTick() int64 {
+func (x *WorldSummary) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *WorldSummary) GetTick() int64 {
 	if x != nil {
 		return x.Tick
 	}
 	return 0
 }
 
+func (x *WorldSummary) GetEntityCount() int64 {
+	if x != nil {
+		return x.EntityCount
+	}
+	return 0
+}
+
 // WorldState is a generic representation of world state at a given tick.
 type WorldState struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1640,7 +3179,7 @@ type WorldState struct {
 
 func (x *WorldState) Reset() {
 	*x = WorldState{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[21]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1652,7 +3191,7 @@ func (x *WorldState) String() string {
 func (*WorldState) ProtoMessage() {}
 
 func (x *WorldState) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[21]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1665,7 +3204,7 @@ func (x *WorldState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorldState.ProtoReflect.Descriptor instead.
 func (*WorldState) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{21}
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *WorldState) GetWorldId() string {
@@ -1740,7 +3279,7 @@ type Entity struct {
 
 func (x *Entity) Reset() {
 	*x = Entity{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[22]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1752,7 +3291,7 @@ func (x *Entity) String() string {
 func (*Entity) ProtoMessage() {}
 
 func (x *Entity) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[22]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1765,7 +3304,7 @@ func (x *Entity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Entity.ProtoReflect.Descriptor instead.
 func (*Entity) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{22}
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *Entity) GetEntityId() string {
@@ -1807,6 +3346,7 @@ type Component struct {
 	//
 	//	*Component_Transform
 	//	*Component_Health
+	//	*Component_Tags
 	//	*Component_Opaque
 	Payload       isComponent_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
@@ -1815,7 +3355,7 @@ type Component struct {
 
 func (x *Component) Reset() {
 	*x = Component{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[23]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1827,7 +3367,7 @@ func (x *Component) String() string {
 func (*Component) ProtoMessage() {}
 
 func (x *Component) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[23]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1840,7 +3380,7 @@ func (x *Component) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Component.ProtoReflect.Descriptor instead.
 func (*Component) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{23}
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *Component) GetType() string {
@@ -1875,6 +3415,15 @@ func (x *Component) GetHealth() *HealthComponent {
 	return nil
 }
 
+func (x *Component) GetTags() *TagsComponent {
+	if x != nil {
+		if x, ok := x.Payload.(*Component_Tags); ok {
+			return x.Tags
+		}
+	}
+	return nil
+}
+
 func (x *Component) GetOpaque() []byte {
 	if x != nil {
 		if x, ok := x.Payload.(*Component_Opaque); ok {
@@ -1896,6 +3445,10 @@ type Component_Health struct {
 	Health *HealthComponent `protobuf:"bytes,11,opt,name=health,proto3,oneof"`
 }
 
+type Component_Tags struct {
+	Tags *TagsComponent `protobuf:"bytes,12,opt,name=tags,proto3,oneof"`
+}
+
 type Component_Opaque struct {
 	// Opaque component payload for custom schemas.
 	Opaque []byte `protobuf:"bytes,19,opt,name=opaque,proto3,oneof"`
@@ -1905,6 +3458,8 @@ func (*Component_Transform) isComponent_Payload() {}
 
 func (*Component_Health) isComponent_Payload() {}
 
+func (*Component_Tags) isComponent_Payload() {}
+
 func (*Component_Opaque) isComponent_Payload() {}
 
 // TransformComponent describes position/orientation.
@@ -1913,13 +3468,15 @@ type TransformComponent struct {
 	Position      *Vec3                  `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
 	RotationEuler *Vec3                  `protobuf:"bytes,2,opt,name=rotation_euler,json=rotationEuler,proto3" json:"rotation_euler,omitempty"`
 	Scale         *Vec3                  `protobuf:"bytes,3,opt,name=scale,proto3" json:"scale,omitempty"`
+	// Linear velocity in world units per tick.
+	Velocity      *Vec3 `protobuf:"bytes,4,opt,name=velocity,proto3" json:"velocity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TransformComponent) Reset() {
 	*x = TransformComponent{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[24]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1931,7 +3488,7 @@ func (x *TransformComponent) String() string {
 func (*TransformComponent) ProtoMessage() {}
 
 func (x *TransformComponent) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[24]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1944,7 +3501,7 @@ func (x *TransformComponent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransformComponent.ProtoReflect.Descriptor instead.
 func (*TransformComponent) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{24}
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *TransformComponent) GetPosition() *Vec3 {
@@ -1968,6 +3525,13 @@ func (x *TransformComponent) GetScale() *Vec3 {
 	return nil
 }
 
+func (x *TransformComponent) GetVelocity() *Vec3 {
+	if x != nil {
+		return x.Velocity
+	}
+	return nil
+}
+
 // HealthComponent describes a health-like value.
 type HealthComponent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1979,7 +3543,7 @@ type HealthComponent struct {
 
 func (x *HealthComponent) Reset() {
 	*x = HealthComponent{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[25]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1991,7 +3555,7 @@ func (x *HealthComponent) String() string {
 func (*HealthComponent) ProtoMessage() {}
 
 func (x *HealthComponent) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[25]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2004,7 +3568,7 @@ func (x *HealthComponent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthComponent.ProtoReflect.Descriptor instead.
 func (*HealthComponent) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{25}
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *HealthComponent) GetCurrent() int32 {
@@ -2021,6 +3585,53 @@ func (x *HealthComponent) GetMax() int32 {
 	return 0
 }
 
+// TagsComponent sets an entity's initial tags on spawn. Engines store tags as
+// entity metadata rather than as a component, so snapshots report them under
+// Entity.metadata["tags"] (sorted, comma-separated).
+type TagsComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagsComponent) Reset() {
+	*x = TagsComponent{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagsComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagsComponent) ProtoMessage() {}
+
+func (x *TagsComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagsComponent.ProtoReflect.Descriptor instead.
+func (*TagsComponent) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TagsComponent) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 // Vec3 is a basic 3D vector.
 type Vec3 struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2033,7 +3644,7 @@ type Vec3 struct {
 
 func (x *Vec3) Reset() {
 	*x = Vec3{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[26]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2045,7 +3656,7 @@ func (x *Vec3) String() string {
 func (*Vec3) ProtoMessage() {}
 
 func (x *Vec3) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[26]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2058,7 +3669,7 @@ func (x *Vec3) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Vec3.ProtoReflect.Descriptor instead.
 func (*Vec3) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{26}
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *Vec3) GetX() float64 {
@@ -2094,6 +3705,7 @@ type Event struct {
 	// Types that are valid to be assigned to Payload:
 	//
 	//	*Event_Opaque
+	//	*Event_CommandRejected
 	Payload       isEvent_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -2101,7 +3713,7 @@ type Event struct {
 
 func (x *Event) Reset() {
 	*x = Event{}
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[27]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2113,7 +3725,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[27]
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2126,7 +3738,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{27}
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *Event) GetType() string {
@@ -2159,6 +3771,15 @@ func (x *Event) GetOpaque() []byte {
 	return nil
 }
 
+func (x *Event) GetCommandRejected() *CommandRejectedEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_CommandRejected); ok {
+			return x.CommandRejected
+		}
+	}
+	return nil
+}
+
 type isEvent_Payload interface {
 	isEvent_Payload()
 }
@@ -2167,214 +3788,490 @@ type Event_Opaque struct {
 	Opaque []byte `protobuf:"bytes,10,opt,name=opaque,proto3,oneof"`
 }
 
+type Event_CommandRejected struct {
+	CommandRejected *CommandRejectedEvent `protobuf:"bytes,11,opt,name=command_rejected,json=commandRejected,proto3,oneof"`
+}
+
 func (*Event_Opaque) isEvent_Payload() {}
 
+func (*Event_CommandRejected) isEvent_Payload() {}
+
+// CommandRejectedEvent reports a queued command that could not be applied.
+type CommandRejectedEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The rejected command's id.
+	CommandId string `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	// Actor that issued the command.
+	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// Machine-readable rejection reason.
+	Reason CommandRejectionReason `protobuf:"varint,3,opt,name=reason,proto3,enum=game.engine.v1.CommandRejectionReason" json:"reason,omitempty"`
+	// Coarse status category, matching what a synchronous call would return.
+	Code StatusCode `protobuf:"varint,4,opt,name=code,proto3,enum=game.engine.v1.StatusCode" json:"code,omitempty"`
+	// Human-readable message suitable for logs and operator tools.
+	Message       string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandRejectedEvent) Reset() {
+	*x = CommandRejectedEvent{}
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandRejectedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandRejectedEvent) ProtoMessage() {}
+
+func (x *CommandRejectedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_engine_v1_engine_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandRejectedEvent.ProtoReflect.Descriptor instead.
+func (*CommandRejectedEvent) Descriptor() ([]byte, []int) {
+	return file_proto_game_engine_v1_engine_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CommandRejectedEvent) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+func (x *CommandRejectedEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *CommandRejectedEvent) GetReason() CommandRejectionReason {
+	if x != nil {
+		return x.Reason
+	}
+	return CommandRejectionReason_COMMAND_REJECTION_REASON_UNSPECIFIED
+}
+
+func (x *CommandRejectedEvent) GetCode() StatusCode {
+	if x != nil {
+		return x.Code
+	}
+	return StatusCode_STATUS_CODE_UNSPECIFIED
+}
+
+func (x *CommandRejectedEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_proto_game_engine_v1_engine_proto protoreflect.FileDescriptor
 
 var file_proto_game_engine_v1_engine_proto_rawDesc = []byte{
 	0x0a, 0x21, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x65, 0x6e, 0x67,
 	0x69, 0x6e, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x2e, 0x76, 0x31, 0x22, 0x71, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x61, 0x6d,
-	0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x4a, 0x04, 0x08, 0x04, 0x10, 0x0a, 0x22, 0x8d, 0x01, 0x0a, 0x16, 0x49, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x61,
-	0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72,
-	0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x8d, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x33, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x2e, 0x76, 0x31, 0x22, 0xef, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x3f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a,
+	0x04, 0x08, 0x05, 0x10, 0x0a, 0x22, 0xa3, 0x01, 0x0a, 0x16, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6c,
+	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x8d, 0x01, 0x0a, 0x17,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x57,
+	0x6f, 0x72, 0x6c, 0x64, 0x4f, 0x6b, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x2d, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0xc6, 0x01, 0x0a, 0x11,
 	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x4f,
+	0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x54, 0x69, 0x63, 0x6b, 0x12, 0x4b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x4f, 0x6b, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04,
+	0x08, 0x0a, 0x10, 0x14, 0x22, 0x53, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x57, 0x6f, 0x72,
+	0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72,
+	0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72,
+	0x6c, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x83, 0x01, 0x0a, 0x12, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x4f, 0x6b, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b,
+	0x12, 0x2d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42,
+	0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22,
+	0x28, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x4f, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74,
+	0x69, 0x63, 0x6b, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0xc0, 0x01, 0x0a, 0x0b, 0x57, 0x6f,
+	0x72, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3f, 0x0a, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6c, 0x64,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0d, 0x6f, 0x70,
+	0x61, 0x71, 0x75, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x0c, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0xd5, 0x01, 0x0a,
+	0x13, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x69, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x4a, 0x04,
+	0x08, 0x0a, 0x10, 0x14, 0x22, 0x87, 0x01, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
+	0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4f, 0x6b, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12,
+	0x2d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x68,
+	0x0a, 0x0e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4f, 0x6b,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x54,
+	0x69, 0x63, 0x6b, 0x12, 0x2d, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0xc8, 0x05, 0x0a, 0x07, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x31,
+	0x0a, 0x15, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78,
+	0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x31, 0x0a,
+	0x04, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65,
+	0x12, 0x47, 0x0a, 0x0c, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x0e, 0x64, 0x65, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x44, 0x0a, 0x0b, 0x6d, 0x6f, 0x76, 0x65,
+	0x5f, 0x74, 0x6f, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x76, 0x65, 0x54, 0x6f, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x48, 0x00, 0x52, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x77, 0x61, 0x72, 0x64, 0x12, 0x41,
+	0x0a, 0x0a, 0x74, 0x61, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x67, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x09, 0x74, 0x61, 0x67, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x47, 0x0a, 0x0c, 0x75, 0x6e, 0x74, 0x61, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x74, 0x61, 0x67, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x75,
+	0x6e, 0x74, 0x61, 0x67, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x0c, 0x73, 0x65,
+	0x74, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x65, 0x74, 0x56, 0x65, 0x6c, 0x6f, 0x63,
+	0x69, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x06, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x42, 0x09, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x0a, 0x4a, 0x04, 0x08,
+	0x14, 0x10, 0x1e, 0x22, 0x94, 0x01, 0x0a, 0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64,
+	0x12, 0x30, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x33, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x33, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f,
+	0x63, 0x69, 0x74, 0x79, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x81, 0x01, 0x0a, 0x11, 0x4d,
+	0x6f, 0x76, 0x65, 0x54, 0x6f, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x2c, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x65, 0x63, 0x33, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0xa4,
+	0x01, 0x0a, 0x12, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a,
+	0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x65, 0x63, 0x33, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x4a,
+	0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x39, 0x0a, 0x14, 0x44, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14,
+	0x22, 0x69, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x33, 0x52, 0x08, 0x76, 0x65, 0x6c,
+	0x6f, 0x63, 0x69, 0x74, 0x79, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x49, 0x0a, 0x10, 0x54,
+	0x61, 0x67, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x4b, 0x0a, 0x12, 0x55, 0x6e, 0x74, 0x61, 0x67, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x4a, 0x04, 0x08,
+	0x0a, 0x10, 0x14, 0x22, 0x3d, 0x0a, 0x0d, 0x4f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x4a, 0x04, 0x08, 0x0a,
+	0x10, 0x14, 0x22, 0x51, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4a,
+	0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x8d, 0x01, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4f, 0x6b,
+	0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4a,
+	0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x19, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4f, 0x6b, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14,
+	0x22, 0xa3, 0x02, 0x0a, 0x0b, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x4d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x69,
+	0x63, 0x6b, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x11, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x65, 0x73, 0x12, 0x23, 0x0a, 0x0c, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x5f, 0x63, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x70, 0x61, 0x71,
+	0x75, 0x65, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x07, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4a, 0x04, 0x08, 0x14, 0x10, 0x1e, 0x22, 0x77, 0x0a, 0x0c, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x4f, 0x6b, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b,
+	0x12, 0x2d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42,
+	0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22,
+	0xd7, 0x01, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x4f, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65,
+	0x77, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x65,
+	0x77, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x2d, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x4f, 0x6b, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0xd8, 0x02, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x38, 0x0a, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x61, 0x74, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x41, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x61, 0x74,
+	0x54, 0x69, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4a, 0x04,
+	0x08, 0x1e, 0x10, 0x28, 0x22, 0x8f, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4f,
 	0x6b, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e,
 	0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
 	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0xc6, 0x01, 0x0a, 0x11, 0x49, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x4f, 0x6b, 0x12, 0x21, 0x0a, 0x0c,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x69, 0x63, 0x6b, 0x12,
-	0x4b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x57, 0x6f, 0x72,
-	0x6c, 0x64, 0x4f, 0x6b, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22,
-	0xc0, 0x01, 0x0a, 0x0b, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x3f, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x12, 0x25, 0x0a, 0x0d, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0c, 0x6f, 0x70, 0x61, 0x71, 0x75,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x04, 0x08, 0x0a,
-	0x10, 0x14, 0x22, 0xa1, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f,
-	0x72, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f,
-	0x72, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67,
-	0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x07,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
-	0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
-	0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x87, 0x01, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x6c, 0x79,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x30, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x61,
-	0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4f, 0x6b, 0x48, 0x00, 0x52, 0x02, 0x6f,
-	0x6b, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x98, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4f, 0x6b, 0x12, 0x3b, 0x0a,
+	0x0b, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a,
+	0x77, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4f, 0x6b,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x3b,
+	0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x0a, 0x10,
+	0x14, 0x22, 0x6c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22,
+	0x91, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x4f, 0x6b, 0x48, 0x00,
+	0x52, 0x02, 0x6f, 0x6b, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4a, 0x04, 0x08,
+	0x0a, 0x10, 0x14, 0x22, 0x5a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x4f, 0x6b, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72,
+	0x6c, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22,
+	0xae, 0x01, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6c, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6c, 0x64,
+	0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76,
 	0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
 	0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14,
-	0x22, 0x68, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x4f, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x74, 0x69,
-	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65,
-	0x64, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x2d, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67,
-	0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x91, 0x03, 0x0a, 0x07, 0x43,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64,
-	0x12, 0x31, 0x0a, 0x15, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x75, 0x6e,
-	0x69, 0x78, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x12, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4d, 0x69, 0x6c,
-	0x6c, 0x69, 0x73, 0x12, 0x31, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x22, 0x16, 0x0a, 0x0e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x0a, 0x22, 0x2a, 0x0a, 0x0e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x41, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x4a, 0x04,
+	0x08, 0x0a, 0x10, 0x14, 0x22, 0x69, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6c,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6c,
+	0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x63, 0x6b,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x74, 0x6f, 0x54, 0x69, 0x63, 0x6b, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22,
+	0x81, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00,
-	0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67,
-	0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70,
-	0x61, 0x77, 0x6e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x48, 0x00, 0x52, 0x0b, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x4d, 0x0a, 0x0e, 0x64, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52,
-	0x0d, 0x64, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x37,
-	0x0a, 0x06, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x6b, 0x48, 0x00,
+	0x52, 0x02, 0x6f, 0x6b, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4a, 0x04, 0x08,
+	0x0a, 0x10, 0x14, 0x22, 0x97, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x4f, 0x6b, 0x12, 0x2d, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x52, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64,
+	0x54, 0x69, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x19, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x83, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x73, 0x4f, 0x6b, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12,
+	0x2d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
 	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52,
-	0x06, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x0a, 0x4a, 0x04, 0x08, 0x14, 0x10, 0x1e, 0x22, 0x94,
-	0x01, 0x0a, 0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56,
-	0x65, 0x63, 0x33, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a,
-	0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x56, 0x65, 0x63, 0x33, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x4a,
-	0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x72, 0x0a, 0x12, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x39, 0x0a, 0x14, 0x44, 0x65, 0x73,
-	0x70, 0x61, 0x77, 0x6e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x4a, 0x04,
-	0x08, 0x0a, 0x10, 0x14, 0x22, 0x3d, 0x0a, 0x0d, 0x4f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x43, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x4a, 0x04, 0x08,
-	0x0a, 0x10, 0x14, 0x22, 0xf3, 0x01, 0x0a, 0x0b, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x32, 0x0a,
-	0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x65, 0x78,
-	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x63,
-	0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x4d, 0x69,
-	0x6c, 0x6c, 0x69, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74,
-	0x69, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x23, 0x0a, 0x0c, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x5f,
-	0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0b, 0x6f,
-	0x70, 0x61, 0x71, 0x75, 0x65, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x07, 0x0a, 0x05, 0x63, 0x6c,
-	0x6f, 0x63, 0x6b, 0x4a, 0x04, 0x08, 0x14, 0x10, 0x1e, 0x22, 0x77, 0x0a, 0x0c, 0x54, 0x69, 0x63,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x02, 0x6f, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67,
-	0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x4f, 0x6b, 0x48, 0x00, 0x52,
-	0x02, 0x6f, 0x6b, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4a, 0x04, 0x08, 0x0a,
-	0x10, 0x14, 0x22, 0x58, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x4f, 0x6b, 0x12, 0x19, 0x0a, 0x08,
-	0x6e, 0x65, 0x77, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x6e, 0x65, 0x77, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x2d, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0xa8, 0x02, 0x0a,
-	0x17, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6c,
-	0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6c,
-	0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x38, 0x0a, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x61, 0x74, 0x65,
-	0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x07,
-	0x61, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x48, 0x00, 0x52,
-	0x06, 0x61, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x15, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x4a, 0x04, 0x08, 0x1e, 0x10, 0x28, 0x22, 0x8f, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x4f, 0x6b, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65,
-	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0xe2, 0x01, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4f, 0x6b,
-	0x12, 0x3b, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67,
-	0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x30, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x4f, 0x6b, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x16,
-	0x0a, 0x0e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74,
-	0x4a, 0x04, 0x08, 0x01, 0x10, 0x0a, 0x22, 0x2a, 0x0a, 0x0e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x41, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x4a, 0x04, 0x08, 0x0a,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x4a,
+	0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x73, 0x4f, 0x6b, 0x12, 0x34,
+	0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x06, 0x77, 0x6f,
+	0x72, 0x6c, 0x64, 0x73, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x66, 0x0a, 0x0c, 0x57, 0x6f,
+	0x72, 0x6c, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f,
+	0x72, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f,
+	0x72, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x0a,
 	0x10, 0x14, 0x22, 0xb2, 0x02, 0x0a, 0x0a, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74,
 	0x65, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
@@ -2410,7 +4307,7 @@ var file_proto_game_engine_v1_engine_proto_rawDesc = []byte{
 	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
 	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08,
-	0x14, 0x10, 0x1e, 0x22, 0xcf, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x14, 0x10, 0x1e, 0x22, 0x84, 0x02, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
 	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
 	0x72, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
@@ -2420,78 +4317,158 @@ var file_proto_game_engine_v1_engine_proto_rawDesc = []byte{
 	0x6c, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x61, 0x6d, 0x65,
 	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74,
 	0x68, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x06, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x42, 0x09,
-	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x0a, 0x4a,
-	0x04, 0x08, 0x14, 0x10, 0x1e, 0x22, 0xb5, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x08,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x56, 0x65, 0x63, 0x33, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b,
-	0x0a, 0x0e, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x75, 0x6c, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e,
-	0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x33, 0x52, 0x0d, 0x72, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x05, 0x73,
-	0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x6d,
-	0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x33,
-	0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x43, 0x0a,
-	0x0f, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x4a, 0x04, 0x08, 0x0a,
-	0x10, 0x14, 0x22, 0x30, 0x0a, 0x04, 0x56, 0x65, 0x63, 0x33, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x01, 0x7a, 0x22, 0x60, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x06, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x42,
-	0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x0a,
-	0x4a, 0x04, 0x08, 0x14, 0x10, 0x1e, 0x2a, 0xf0, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x4f, 0x4b, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52,
-	0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
-	0x44, 0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f,
-	0x44, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x4f, 0x4e,
-	0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54,
-	0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x41, 0x56,
-	0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x07, 0x32, 0xf7, 0x02, 0x0a, 0x0c, 0x45, 0x6e,
-	0x67, 0x69, 0x6e, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x49, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x12, 0x26, 0x2e,
-	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67,
-	0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59,
-	0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x23,
-	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x04, 0x54, 0x69, 0x63,
-	0x6b, 0x12, 0x1b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x61, 0x6c, 0x74, 0x68, 0x12, 0x33, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x06, 0x6f, 0x70, 0x61,
+	0x71, 0x75, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x70, 0x61,
+	0x71, 0x75, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x04,
+	0x08, 0x02, 0x10, 0x0a, 0x4a, 0x04, 0x08, 0x14, 0x10, 0x1e, 0x22, 0xe7, 0x01, 0x0a, 0x12, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x12, 0x30, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x33, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0e, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x65, 0x75, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63,
+	0x33, 0x52, 0x0d, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x75, 0x6c, 0x65, 0x72,
+	0x12, 0x2a, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x65, 0x63, 0x33, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x08,
+	0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
 	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x12, 0x27, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x61, 0x6d, 0x65,
-	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x61, 0x6e, 0x76, 0x69, 0x6c, 0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
-	0x2f, 0x61, 0x6e, 0x76, 0x69, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x61, 0x6d,
-	0x65, 0x2f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x56, 0x65, 0x63, 0x33, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x4a, 0x04,
+	0x08, 0x0a, 0x10, 0x14, 0x22, 0x43, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6d, 0x61, 0x78, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x22, 0x29, 0x0a, 0x0d, 0x54, 0x61, 0x67,
+	0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x4a, 0x04,
+	0x08, 0x0a, 0x10, 0x14, 0x22, 0x30, 0x0a, 0x04, 0x56, 0x65, 0x63, 0x33, 0x12, 0x0c, 0x0a, 0x01,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x01, 0x7a, 0x22, 0xb3, 0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x06, 0x6f, 0x70, 0x61, 0x71,
+	0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x70, 0x61, 0x71,
+	0x75, 0x65, 0x12, 0x51, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x4a, 0x04, 0x08, 0x03, 0x10, 0x0a, 0x4a, 0x04, 0x08, 0x14, 0x10, 0x1e, 0x22, 0xe0, 0x01, 0x0a,
+	0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x3e, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x26, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x14, 0x2a,
+	0x94, 0x02, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b,
+	0x0a, 0x17, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x4b, 0x10, 0x01, 0x12,
+	0x20, 0x0a, 0x1c, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10,
+	0x02, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x04, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x07, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x45, 0x58, 0x48, 0x41, 0x55,
+	0x53, 0x54, 0x45, 0x44, 0x10, 0x08, 0x2a, 0xb5, 0x02, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x28, 0x0a, 0x24, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2d, 0x0a, 0x29, 0x43,
+	0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x32, 0x0a, 0x2e, 0x43, 0x4f,
+	0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x4c,
+	0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x02, 0x12, 0x2c,
+	0x0a, 0x28, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x03, 0x12, 0x2c, 0x0a, 0x28,
+	0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x32, 0x0a, 0x2e, 0x43, 0x4f,
+	0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x49,
+	0x4d, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x05, 0x32, 0xc1,
+	0x06, 0x0a, 0x0c, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x62, 0x0a, 0x0f, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x57, 0x6f, 0x72,
+	0x6c, 0x64, 0x12, 0x26, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x57, 0x6f,
+	0x72, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6c,
+	0x64, 0x12, 0x21, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6c, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x23, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x04, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x1b, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x27, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x12, 0x28, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x6f, 0x72, 0x6c, 0x64, 0x73, 0x12, 0x21, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6c, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f,
+	0x72, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62,
+	0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x12, 0x26, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x4f, 0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x62, 0x61, 0x79, 0x6c, 0x65, 0x61, 0x66, 0x77, 0x61, 0x6c, 0x6b, 0x65, 0x72, 0x2f, 0x62,
+	0x69, 0x6e, 0x64, 0x65, 0x72, 0x79, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x61, 0x6d, 0x65,
+	0x2f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2506,93 +4483,159 @@ func file_proto_game_engine_v1_engine_proto_rawDescGZIP() []byte {
 	return file_proto_game_engine_v1_engine_proto_rawDescData
 }
 
-var file_proto_game_engine_v1_engine_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_game_engine_v1_engine_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_proto_game_engine_v1_engine_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_game_engine_v1_engine_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_proto_game_engine_v1_engine_proto_goTypes = []any{
-	(StatusCode)(0),                  // 0: game.engine.v1.StatusCode
-	(*Error)(nil),                    // 1: game.engine.v1.Error
-	(*InitializeWorldRequest)(nil),   // 2: game.engine.v1.InitializeWorldRequest
-	(*InitializeWorldResponse)(nil),  // 3: game.engine.v1.InitializeWorldResponse
-	(*InitializeWorldOk)(nil),        // 4: game.engine.v1.InitializeWorldOk
-	(*WorldConfig)(nil),              // 5: game.engine.v1.WorldConfig
-	(*ApplyCommandRequest)(nil),      // 6: game.engine.v1.ApplyCommandRequest
-	(*ApplyCommandResponse)(nil),     // 7: game.engine.v1.ApplyCommandResponse
-	(*ApplyCommandOk)(nil),           // 8: game.engine.v1.ApplyCommandOk
-	(*Command)(nil),                  // 9: game.engine.v1.Command
-	(*MoveCommand)(nil),              // 10: game.engine.v1.MoveCommand
-	(*SpawnEntityCommand)(nil),       // 11: game.engine.v1.SpawnEntityCommand
-	(*DespawnEntityCommand)(nil),     // 12: game.engine.v1.DespawnEntityCommand
-	(*OpaqueCommand)(nil),            // 13: game.engine.v1.OpaqueCommand
-	(*TickRequest)(nil),              // 14: game.engine.v1.TickRequest
-	(*TickResponse)(nil),             // 15: game.engine.v1.TickResponse
-	(*TickOk)(nil),                   // 16: game.engine.v1.TickOk
-	(*GetStateSnapshotRequest)(nil),  // 17: game.engine.v1.GetStateSnapshotRequest
-	(*GetStateSnapshotResponse)(nil), // 18: game.engine.v1.GetStateSnapshotResponse
-	(*GetStateSnapshotOk)(nil),       // 19: game.engine.v1.GetStateSnapshotOk
-	(*SnapshotLatest)(nil),           // 20: game.engine.v1.SnapshotLatest
-	(*SnapshotAtTick)(nil),           // 21: game.engine.v1.SnapshotAtTick
-	(*WorldState)(nil),               // 22: game.engine.v1.WorldState
-	(*Entity)(nil),                   // 23: game.engine.v1.Entity
-	(*Component)(nil),                // 24: game.engine.v1.Component
-	(*TransformComponent)(nil),       // 25: game.engine.v1.TransformComponent
-	(*HealthComponent)(nil),          // 26: game.engine.v1.HealthComponent
-	(*Vec3)(nil),                     // 27: game.engine.v1.Vec3
-	(*Event)(nil),                    // 28: game.engine.v1.Event
-	nil,                              // 29: game.engine.v1.InitializeWorldOk.MetadataEntry
-	nil,                              // 30: game.engine.v1.WorldConfig.ValuesEntry
-	nil,                              // 31: game.engine.v1.GetStateSnapshotOk.MetadataEntry
-	nil,                              // 32: game.engine.v1.WorldState.MetadataEntry
-	nil,                              // 33: game.engine.v1.Entity.MetadataEntry
+	(StatusCode)(0),                   // 0: game.engine.v1.StatusCode
+	(CommandRejectionReason)(0),       // 1: game.engine.v1.CommandRejectionReason
+	(*Error)(nil),                     // 2: game.engine.v1.Error
+	(*InitializeWorldRequest)(nil),    // 3: game.engine.v1.InitializeWorldRequest
+	(*InitializeWorldResponse)(nil),   // 4: game.engine.v1.InitializeWorldResponse
+	(*InitializeWorldOk)(nil),         // 5: game.engine.v1.InitializeWorldOk
+	(*ResetWorldRequest)(nil),         // 6: game.engine.v1.ResetWorldRequest
+	(*ResetWorldResponse)(nil),        // 7: game.engine.v1.ResetWorldResponse
+	(*ResetWorldOk)(nil),              // 8: game.engine.v1.ResetWorldOk
+	(*WorldConfig)(nil),               // 9: game.engine.v1.WorldConfig
+	(*ApplyCommandRequest)(nil),       // 10: game.engine.v1.ApplyCommandRequest
+	(*ApplyCommandResponse)(nil),      // 11: game.engine.v1.ApplyCommandResponse
+	(*ApplyCommandOk)(nil),            // 12: game.engine.v1.ApplyCommandOk
+	(*Command)(nil),                   // 13: game.engine.v1.Command
+	(*MoveCommand)(nil),               // 14: game.engine.v1.MoveCommand
+	(*MoveTowardCommand)(nil),         // 15: game.engine.v1.MoveTowardCommand
+	(*SpawnEntityCommand)(nil),        // 16: game.engine.v1.SpawnEntityCommand
+	(*DespawnEntityCommand)(nil),      // 17: game.engine.v1.DespawnEntityCommand
+	(*SetVelocityCommand)(nil),        // 18: game.engine.v1.SetVelocityCommand
+	(*TagEntityCommand)(nil),          // 19: game.engine.v1.TagEntityCommand
+	(*UntagEntityCommand)(nil),        // 20: game.engine.v1.UntagEntityCommand
+	(*OpaqueCommand)(nil),             // 21: game.engine.v1.OpaqueCommand
+	(*ValidateCommandRequest)(nil),    // 22: game.engine.v1.ValidateCommandRequest
+	(*ValidateCommandResponse)(nil),   // 23: game.engine.v1.ValidateCommandResponse
+	(*ValidateCommandOk)(nil),         // 24: game.engine.v1.ValidateCommandOk
+	(*TickRequest)(nil),               // 25: game.engine.v1.TickRequest
+	(*TickResponse)(nil),              // 26: game.engine.v1.TickResponse
+	(*TickOk)(nil),                    // 27: game.engine.v1.TickOk
+	(*GetStateSnapshotRequest)(nil),   // 28: game.engine.v1.GetStateSnapshotRequest
+	(*GetStateSnapshotResponse)(nil),  // 29: game.engine.v1.GetStateSnapshotResponse
+	(*GetStateSnapshotOk)(nil),        // 30: game.engine.v1.GetStateSnapshotOk
+	(*GetStateSnapshotsRequest)(nil),  // 31: game.engine.v1.GetStateSnapshotsRequest
+	(*GetStateSnapshotsResponse)(nil), // 32: game.engine.v1.GetStateSnapshotsResponse
+	(*GetStateSnapshotsOk)(nil),       // 33: game.engine.v1.GetStateSnapshotsOk
+	(*WorldSnapshotResult)(nil),       // 34: game.engine.v1.WorldSnapshotResult
+	(*SnapshotLatest)(nil),            // 35: game.engine.v1.SnapshotLatest
+	(*SnapshotAtTick)(nil),            // 36: game.engine.v1.SnapshotAtTick
+	(*GetEventsRequest)(nil),          // 37: game.engine.v1.GetEventsRequest
+	(*GetEventsResponse)(nil),         // 38: game.engine.v1.GetEventsResponse
+	(*GetEventsOk)(nil),               // 39: game.engine.v1.GetEventsOk
+	(*ListWorldsRequest)(nil),         // 40: game.engine.v1.ListWorldsRequest
+	(*ListWorldsResponse)(nil),        // 41: game.engine.v1.ListWorldsResponse
+	(*ListWorldsOk)(nil),              // 42: game.engine.v1.ListWorldsOk
+	(*WorldSummary)(nil),              // 43: game.engine.v1.WorldSummary
+	(*WorldState)(nil),                // 44: game.engine.v1.WorldState
+	(*Entity)(nil),                    // 45: game.engine.v1.Entity
+	(*Component)(nil),                 // 46: game.engine.v1.Component
+	(*TransformComponent)(nil),        // 47: game.engine.v1.TransformComponent
+	(*HealthComponent)(nil),           // 48: game.engine.v1.HealthComponent
+	(*TagsComponent)(nil),             // 49: game.engine.v1.TagsComponent
+	(*Vec3)(nil),                      // 50: game.engine.v1.Vec3
+	(*Event)(nil),                     // 51: game.engine.v1.Event
+	(*CommandRejectedEvent)(nil),      // 52: game.engine.v1.CommandRejectedEvent
+	nil,                               // 53: game.engine.v1.Error.MetadataEntry
+	nil,                               // 54: game.engine.v1.InitializeWorldOk.MetadataEntry
+	nil,                               // 55: game.engine.v1.WorldConfig.ValuesEntry
+	nil,                               // 56: game.engine.v1.TickOk.MetadataEntry
+	nil,                               // 57: game.engine.v1.GetStateSnapshotOk.MetadataEntry
+	nil,                               // 58: game.engine.v1.WorldState.MetadataEntry
+	nil,                               // 59: game.engine.v1.Entity.MetadataEntry
 }
 var file_proto_game_engine_v1_engine_proto_depIdxs = []int32{
 	0,  // 0: game.engine.v1.Error.code:type_name -> game.engine.v1.StatusCode
-	5,  // 1: game.engine.v1.InitializeWorldRequest.config:type_name -> game.engine.v1.WorldConfig
-	4,  // 2: game.engine.v1.InitializeWorldResponse.ok:type_name -> game.engine.v1.InitializeWorldOk
-	1,  // 3: game.engine.v1.InitializeWorldResponse.error:type_name -> game.engine.v1.Error
-	29, // 4: game.engine.v1.InitializeWorldOk.metadata:type_name -> game.engine.v1.InitializeWorldOk.MetadataEntry
-	30, // 5: game.engine.v1.WorldConfig.values:type_name -> game.engine.v1.WorldConfig.ValuesEntry
-	9,  // 6: game.engine.v1.ApplyCommandRequest.command:type_name -> game.engine.v1.Command
-	8,  // 7: game.engine.v1.ApplyCommandResponse.ok:type_name -> game.engine.v1.ApplyCommandOk
-	1,  // 8: game.engine.v1.ApplyCommandResponse.error:type_name -> game.engine.v1.Error
-	28, // 9: game.engine.v1.ApplyCommandOk.events:type_name -> game.engine.v1.Event
-	10, // 10: game.engine.v1.Command.move:type_name -> game.engine.v1.MoveCommand
-	11, // 11: game.engine.v1.Command.spawn_entity:type_name -> game.engine.v1.SpawnEntityCommand
-	12, // 12: game.engine.v1.Command.despawn_entity:type_name -> game.engine.v1.DespawnEntityCommand
-	13, // 13: game.engine.v1.Command.opaque:type_name -> game.engine.v1.OpaqueCommand
-	27, // 14: game.engine.v1.MoveCommand.position:type_name -> game.engine.v1.Vec3
-	27, // 15: game.engine.v1.MoveCommand.velocity:type_name -> game.engine.v1.Vec3
-	24, // 16: game.engine.v1.SpawnEntityCommand.components:type_name -> game.engine.v1.Component
-	16, // 17: game.engine.v1.TickResponse.ok:type_name -> game.engine.v1.TickOk
-	1,  // 18: game.engine.v1.TickResponse.error:type_name -> game.engine.v1.Error
-	28, // 19: game.engine.v1.TickOk.events:type_name -> game.engine.v1.Event
-	20, // 20: game.engine.v1.GetStateSnapshotRequest.latest:type_name -> game.engine.v1.SnapshotLatest
-	21, // 21: game.engine.v1.GetStateSnapshotRequest.at_tick:type_name -> game.engine.v1.SnapshotAtTick
-	19, // 22: game.engine.v1.GetStateSnapshotResponse.ok:type_name -> game.engine.v1.GetStateSnapshotOk
-	1,  // 23: game.engine.v1.GetStateSnapshotResponse.error:type_name -> game.engine.v1.Error
-	22, // 24: game.engine.v1.GetStateSnapshotOk.world_state:type_name -> game.engine.v1.WorldState
-	31, // 25: game.engine.v1.GetStateSnapshotOk.metadata:type_name -> game.engine.v1.GetStateSnapshotOk.MetadataEntry
-	23, // 26: game.engine.v1.WorldState.entities:type_name -> game.engine.v1.Entity
-	32, // 27: game.engine.v1.WorldState.metadata:type_name -> game.engine.v1.WorldState.MetadataEntry
-	24, // 28: game.engine.v1.Entity.components:type_name -> game.engine.v1.Component
-	33, // 29: game.engine.v1.Entity.metadata:type_name -> game.engine.v1.Entity.MetadataEntry
-	25, // 30: game.engine.v1.Component.transform:type_name -> game.engine.v1.TransformComponent
-	26, // 31: game.engine.v1.Component.health:type_name -> game.engine.v1.HealthComponent
-	27, // 32: game.engine.v1.TransformComponent.position:type_name -> game.engine.v1.Vec3
-	27, // 33: game.engine.v1.TransformComponent.rotation_euler:type_name -> game.engine.v1.Vec3
-	27, // 34: game.engine.v1.TransformComponent.scale:type_name -> game.engine.v1.Vec3
-	2,  // 35: game.engine.v1.EngineModule.InitializeWorld:input_type -> game.engine.v1.InitializeWorldRequest
-	6,  // 36: game.engine.v1.EngineModule.ApplyCommand:input_type -> game.engine.v1.ApplyCommandRequest
-	14, // 37: game.engine.v1.EngineModule.Tick:input_type -> game.engine.v1.TickRequest
-	17, // 38: game.engine.v1.EngineModule.GetStateSnapshot:input_type -> game.engine.v1.GetStateSnapshotRequest
-	3,  // 39: game.engine.v1.EngineModule.InitializeWorld:output_type -> game.engine.v1.InitializeWorldResponse
-	7,  // 40: game.engine.v1.EngineModule.ApplyCommand:output_type -> game.engine.v1.ApplyCommandResponse
-	15, // 41: game.engine.v1.EngineModule.Tick:output_type -> game.engine.v1.TickResponse
-	18, // 42: game.engine.v1.EngineModule.GetStateSnapshot:output_type -> game.engine.v1.GetStateSnapshotResponse
-	39, // [39:43] is the sub-list for method output_type
-	35, // [35:39] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+	53, // 1: game.engine.v1.Error.metadata:type_name -> game.engine.v1.Error.MetadataEntry
+	9,  // 2: game.engine.v1.InitializeWorldRequest.config:type_name -> game.engine.v1.WorldConfig
+	5,  // 3: game.engine.v1.InitializeWorldResponse.ok:type_name -> game.engine.v1.InitializeWorldOk
+	2,  // 4: game.engine.v1.InitializeWorldResponse.error:type_name -> game.engine.v1.Error
+	54, // 5: game.engine.v1.InitializeWorldOk.metadata:type_name -> game.engine.v1.InitializeWorldOk.MetadataEntry
+	8,  // 6: game.engine.v1.ResetWorldResponse.ok:type_name -> game.engine.v1.ResetWorldOk
+	2,  // 7: game.engine.v1.ResetWorldResponse.error:type_name -> game.engine.v1.Error
+	55, // 8: game.engine.v1.WorldConfig.values:type_name -> game.engine.v1.WorldConfig.ValuesEntry
+	13, // 9: game.engine.v1.ApplyCommandRequest.command:type_name -> game.engine.v1.Command
+	12, // 10: game.engine.v1.ApplyCommandResponse.ok:type_name -> game.engine.v1.ApplyCommandOk
+	2,  // 11: game.engine.v1.ApplyCommandResponse.error:type_name -> game.engine.v1.Error
+	51, // 12: game.engine.v1.ApplyCommandOk.events:type_name -> game.engine.v1.Event
+	14, // 13: game.engine.v1.Command.move:type_name -> game.engine.v1.MoveCommand
+	16, // 14: game.engine.v1.Command.spawn_entity:type_name -> game.engine.v1.SpawnEntityCommand
+	17, // 15: game.engine.v1.Command.despawn_entity:type_name -> game.engine.v1.DespawnEntityCommand
+	15, // 16: game.engine.v1.Command.move_toward:type_name -> game.engine.v1.MoveTowardCommand
+	19, // 17: game.engine.v1.Command.tag_entity:type_name -> game.engine.v1.TagEntityCommand
+	20, // 18: game.engine.v1.Command.untag_entity:type_name -> game.engine.v1.UntagEntityCommand
+	18, // 19: game.engine.v1.Command.set_velocity:type_name -> game.engine.v1.SetVelocityCommand
+	21, // 20: game.engine.v1.Command.opaque:type_name -> game.engine.v1.OpaqueCommand
+	50, // 21: game.engine.v1.MoveCommand.position:type_name -> game.engine.v1.Vec3
+	50, // 22: game.engine.v1.MoveCommand.velocity:type_name -> game.engine.v1.Vec3
+	50, // 23: game.engine.v1.MoveTowardCommand.target:type_name -> game.engine.v1.Vec3
+	46, // 24: game.engine.v1.SpawnEntityCommand.components:type_name -> game.engine.v1.Component
+	50, // 25: game.engine.v1.SpawnEntityCommand.velocity:type_name -> game.engine.v1.Vec3
+	50, // 26: game.engine.v1.SetVelocityCommand.velocity:type_name -> game.engine.v1.Vec3
+	13, // 27: game.engine.v1.ValidateCommandRequest.command:type_name -> game.engine.v1.Command
+	24, // 28: game.engine.v1.ValidateCommandResponse.ok:type_name -> game.engine.v1.ValidateCommandOk
+	2,  // 29: game.engine.v1.ValidateCommandResponse.error:type_name -> game.engine.v1.Error
+	27, // 30: game.engine.v1.TickResponse.ok:type_name -> game.engine.v1.TickOk
+	2,  // 31: game.engine.v1.TickResponse.error:type_name -> game.engine.v1.Error
+	51, // 32: game.engine.v1.TickOk.events:type_name -> game.engine.v1.Event
+	56, // 33: game.engine.v1.TickOk.metadata:type_name -> game.engine.v1.TickOk.MetadataEntry
+	35, // 34: game.engine.v1.GetStateSnapshotRequest.latest:type_name -> game.engine.v1.SnapshotLatest
+	36, // 35: game.engine.v1.GetStateSnapshotRequest.at_tick:type_name -> game.engine.v1.SnapshotAtTick
+	30, // 36: game.engine.v1.GetStateSnapshotResponse.ok:type_name -> game.engine.v1.GetStateSnapshotOk
+	2,  // 37: game.engine.v1.GetStateSnapshotResponse.error:type_name -> game.engine.v1.Error
+	44, // 38: game.engine.v1.GetStateSnapshotOk.world_state:type_name -> game.engine.v1.WorldState
+	57, // 39: game.engine.v1.GetStateSnapshotOk.metadata:type_name -> game.engine.v1.GetStateSnapshotOk.MetadataEntry
+	33, // 40: game.engine.v1.GetStateSnapshotsResponse.ok:type_name -> game.engine.v1.GetStateSnapshotsOk
+	2,  // 41: game.engine.v1.GetStateSnapshotsResponse.error:type_name -> game.engine.v1.Error
+	34, // 42: game.engine.v1.GetStateSnapshotsOk.results:type_name -> game.engine.v1.WorldSnapshotResult
+	44, // 43: game.engine.v1.WorldSnapshotResult.world_state:type_name -> game.engine.v1.WorldState
+	2,  // 44: game.engine.v1.WorldSnapshotResult.error:type_name -> game.engine.v1.Error
+	39, // 45: game.engine.v1.GetEventsResponse.ok:type_name -> game.engine.v1.GetEventsOk
+	2,  // 46: game.engine.v1.GetEventsResponse.error:type_name -> game.engine.v1.Error
+	51, // 47: game.engine.v1.GetEventsOk.events:type_name -> game.engine.v1.Event
+	42, // 48: game.engine.v1.ListWorldsResponse.ok:type_name -> game.engine.v1.ListWorldsOk
+	2,  // 49: game.engine.v1.ListWorldsResponse.error:type_name -> game.engine.v1.Error
+	43, // 50: game.engine.v1.ListWorldsOk.worlds:type_name -> game.engine.v1.WorldSummary
+	45, // 51: game.engine.v1.WorldState.entities:type_name -> game.engine.v1.Entity
+	58, // 52: game.engine.v1.WorldState.metadata:type_name -> game.engine.v1.WorldState.MetadataEntry
+	46, // 53: game.engine.v1.Entity.components:type_name -> game.engine.v1.Component
+	59, // 54: game.engine.v1.Entity.metadata:type_name -> game.engine.v1.Entity.MetadataEntry
+	47, // 55: game.engine.v1.Component.transform:type_name -> game.engine.v1.TransformComponent
+	48, // 56: game.engine.v1.Component.health:type_name -> game.engine.v1.HealthComponent
+	49, // 57: game.engine.v1.Component.tags:type_name -> game.engine.v1.TagsComponent
+	50, // 58: game.engine.v1.TransformComponent.position:type_name -> game.engine.v1.Vec3
+	50, // 59: game.engine.v1.TransformComponent.rotation_euler:type_name -> game.engine.v1.Vec3
+	50, // 60: game.engine.v1.TransformComponent.scale:type_name -> game.engine.v1.Vec3
+	50, // 61: game.engine.v1.TransformComponent.velocity:type_name -> game.engine.v1.Vec3
+	52, // 62: game.engine.v1.Event.command_rejected:type_name -> game.engine.v1.CommandRejectedEvent
+	1,  // 63: game.engine.v1.CommandRejectedEvent.reason:type_name -> game.engine.v1.CommandRejectionReason
+	0,  // 64: game.engine.v1.CommandRejectedEvent.code:type_name -> game.engine.v1.StatusCode
+	3,  // 65: game.engine.v1.EngineModule.InitializeWorld:input_type -> game.engine.v1.InitializeWorldRequest
+	6,  // 66: game.engine.v1.EngineModule.ResetWorld:input_type -> game.engine.v1.ResetWorldRequest
+	10, // 67: game.engine.v1.EngineModule.ApplyCommand:input_type -> game.engine.v1.ApplyCommandRequest
+	25, // 68: game.engine.v1.EngineModule.Tick:input_type -> game.engine.v1.TickRequest
+	28, // 69: game.engine.v1.EngineModule.GetStateSnapshot:input_type -> game.engine.v1.GetStateSnapshotRequest
+	31, // 70: game.engine.v1.EngineModule.GetStateSnapshots:input_type -> game.engine.v1.GetStateSnapshotsRequest
+	40, // 71: game.engine.v1.EngineModule.ListWorlds:input_type -> game.engine.v1.ListWorldsRequest
+	37, // 72: game.engine.v1.EngineModule.GetEvents:input_type -> game.engine.v1.GetEventsRequest
+	22, // 73: game.engine.v1.EngineModule.ValidateCommand:input_type -> game.engine.v1.ValidateCommandRequest
+	4,  // 74: game.engine.v1.EngineModule.InitializeWorld:output_type -> game.engine.v1.InitializeWorldResponse
+	7,  // 75: game.engine.v1.EngineModule.ResetWorld:output_type -> game.engine.v1.ResetWorldResponse
+	11, // 76: game.engine.v1.EngineModule.ApplyCommand:output_type -> game.engine.v1.ApplyCommandResponse
+	26, // 77: game.engine.v1.EngineModule.Tick:output_type -> game.engine.v1.TickResponse
+	29, // 78: game.engine.v1.EngineModule.GetStateSnapshot:output_type -> game.engine.v1.GetStateSnapshotResponse
+	32, // 79: game.engine.v1.EngineModule.GetStateSnapshots:output_type -> game.engine.v1.GetStateSnapshotsResponse
+	41, // 80: game.engine.v1.EngineModule.ListWorlds:output_type -> game.engine.v1.ListWorldsResponse
+	38, // 81: game.engine.v1.EngineModule.GetEvents:output_type -> game.engine.v1.GetEventsResponse
+	23, // 82: game.engine.v1.EngineModule.ValidateCommand:output_type -> game.engine.v1.ValidateCommandResponse
+	74, // [74:83] is the sub-list for method output_type
+	65, // [65:74] is the sub-list for method input_type
+	65, // [65:65] is the sub-list for extension type_name
+	65, // [65:65] is the sub-list for extension extendee
+	0,  // [0:65] is the sub-list for field type_name
 }
 
 func init() { file_proto_game_engine_v1_engine_proto_init() }
@@ -2604,52 +4647,82 @@ func file_proto_game_engine_v1_engine_proto_init() {
 		(*InitializeWorldResponse_Ok)(nil),
 		(*InitializeWorldResponse_Error)(nil),
 	}
-	file_proto_game_engine_v1_engine_proto_msgTypes[4].OneofWrappers = []any{
+	file_proto_game_engine_v1_engine_proto_msgTypes[5].OneofWrappers = []any{
+		(*ResetWorldResponse_Ok)(nil),
+		(*ResetWorldResponse_Error)(nil),
+	}
+	file_proto_game_engine_v1_engine_proto_msgTypes[7].OneofWrappers = []any{
 		(*WorldConfig_OpaqueConfig)(nil),
 	}
-	file_proto_game_engine_v1_engine_proto_msgTypes[6].OneofWrappers = []any{
+	file_proto_game_engine_v1_engine_proto_msgTypes[9].OneofWrappers = []any{
 		(*ApplyCommandResponse_Ok)(nil),
 		(*ApplyCommandResponse_Error)(nil),
 	}
-	file_proto_game_engine_v1_engine_proto_msgTypes[8].OneofWrappers = []any{
+	file_proto_game_engine_v1_engine_proto_msgTypes[11].OneofWrappers = []any{
 		(*Command_Move)(nil),
 		(*Command_SpawnEntity)(nil),
 		(*Command_DespawnEntity)(nil),
+		(*Command_MoveToward)(nil),
+		(*Command_TagEntity)(nil),
+		(*Command_UntagEntity)(nil),
+		(*Command_SetVelocity)(nil),
 		(*Command_Opaque)(nil),
 	}
-	file_proto_game_engine_v1_engine_proto_msgTypes[13].OneofWrappers = []any{
+	file_proto_game_engine_v1_engine_proto_msgTypes[21].OneofWrappers = []any{
+		(*ValidateCommandResponse_Ok)(nil),
+		(*ValidateCommandResponse_Error)(nil),
+	}
+	file_proto_game_engine_v1_engine_proto_msgTypes[23].OneofWrappers = []any{
 		(*TickRequest_OpaqueClock)(nil),
 	}
-	file_proto_game_engine_v1_engine_proto_msgTypes[14].OneofWrappers = []any{
+	file_proto_game_engine_v1_engine_proto_msgTypes[24].OneofWrappers = []any{
 		(*TickResponse_Ok)(nil),
 		(*TickResponse_Error)(nil),
 	}
-	file_proto_game_engine_v1_engine_proto_msgTypes[16].OneofWrappers = []any{
+	file_proto_game_engine_v1_engine_proto_msgTypes[26].OneofWrappers = []any{
 		(*GetStateSnapshotRequest_Latest)(nil),
 		(*GetStateSnapshotRequest_AtTick)(nil),
 	}
-	file_proto_game_engine_v1_engine_proto_msgTypes[17].OneofWrappers = []any{
+	file_proto_game_engine_v1_engine_proto_msgTypes[27].OneofWrappers = []any{
 		(*GetStateSnapshotResponse_Ok)(nil),
 		(*GetStateSnapshotResponse_Error)(nil),
 	}
-	file_proto_game_engine_v1_engine_proto_msgTypes[21].OneofWrappers = []any{
+	file_proto_game_engine_v1_engine_proto_msgTypes[30].OneofWrappers = []any{
+		(*GetStateSnapshotsResponse_Ok)(nil),
+		(*GetStateSnapshotsResponse_Error)(nil),
+	}
+	file_proto_game_engine_v1_engine_proto_msgTypes[32].OneofWrappers = []any{
+		(*WorldSnapshotResult_WorldState)(nil),
+		(*WorldSnapshotResult_Error)(nil),
+	}
+	file_proto_game_engine_v1_engine_proto_msgTypes[36].OneofWrappers = []any{
+		(*GetEventsResponse_Ok)(nil),
+		(*GetEventsResponse_Error)(nil),
+	}
+	file_proto_game_engine_v1_engine_proto_msgTypes[39].OneofWrappers = []any{
+		(*ListWorldsResponse_Ok)(nil),
+		(*ListWorldsResponse_Error)(nil),
+	}
+	file_proto_game_engine_v1_engine_proto_msgTypes[42].OneofWrappers = []any{
 		(*WorldState_OpaqueExtension)(nil),
 	}
-	file_proto_game_engine_v1_engine_proto_msgTypes[23].OneofWrappers = []any{
+	file_proto_game_engine_v1_engine_proto_msgTypes[44].OneofWrappers = []any{
 		(*Component_Transform)(nil),
 		(*Component_Health)(nil),
+		(*Component_Tags)(nil),
 		(*Component_Opaque)(nil),
 	}
-	file_proto_game_engine_v1_engine_proto_msgTypes[27].OneofWrappers = []any{
+	file_proto_game_engine_v1_engine_proto_msgTypes[49].OneofWrappers = []any{
 		(*Event_Opaque)(nil),
+		(*Event_CommandRejected)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_game_engine_v1_engine_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   33,
+			NumEnums:      2,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
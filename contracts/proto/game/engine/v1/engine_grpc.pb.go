@@ -26,10 +26,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EngineModule_InitializeWorld_FullMethodName  = "/game.engine.v1.EngineModule/InitializeWorld"
-	EngineModule_ApplyCommand_FullMethodName     = "/game.engine.v1.EngineModule/ApplyCommand"
-	EngineModule_Tick_FullMethodName             = "/game.engine.v1.EngineModule/Tick"
-	EngineModule_GetStateSnapshot_FullMethodName = "/game.engine.v1.EngineModule/GetStateSnapshot"
+	EngineModule_InitializeWorld_FullMethodName   = "/game.engine.v1.EngineModule/InitializeWorld"
+	EngineModule_ResetWorld_FullMethodName        = "/game.engine.v1.EngineModule/ResetWorld"
+	EngineModule_ApplyCommand_FullMethodName      = "/game.engine.v1.EngineModule/ApplyCommand"
+	EngineModule_Tick_FullMethodName              = "/game.engine.v1.EngineModule/Tick"
+	EngineModule_GetStateSnapshot_FullMethodName  = "/game.engine.v1.EngineModule/GetStateSnapshot"
+	EngineModule_GetStateSnapshots_FullMethodName = "/game.engine.v1.EngineModule/GetStateSnapshots"
+	EngineModule_ListWorlds_FullMethodName        = "/game.engine.v1.EngineModule/ListWorlds"
+	EngineModule_GetEvents_FullMethodName         = "/game.engine.v1.EngineModule/GetEvents"
+	EngineModule_ValidateCommand_FullMethodName   = "/game.engine.v1.EngineModule/ValidateCommand"
 )
 
 // EngineModuleClient is the client API for EngineModule service.
@@ -41,6 +46,9 @@ const (
 type EngineModuleClient interface {
 	// InitializeWorld creates or resets engine-managed state for a world.
 	InitializeWorld(ctx context.Context, in *InitializeWorldRequest, opts ...grpc.CallOption) (*InitializeWorldResponse, error)
+	// ResetWorld discards an existing world's state and restarts it at tick 0
+	// with the settings it was initialized with.
+	ResetWorld(ctx context.Context, in *ResetWorldRequest, opts ...grpc.CallOption) (*ResetWorldResponse, error)
 	// ApplyCommand applies a player/system command. Commands are generic and
 	// extensible via oneof.
 	ApplyCommand(ctx context.Context, in *ApplyCommandRequest, opts ...grpc.CallOption) (*ApplyCommandResponse, error)
@@ -48,6 +56,17 @@ type EngineModuleClient interface {
 	Tick(ctx context.Context, in *TickRequest, opts ...grpc.CallOption) (*TickResponse, error)
 	// GetStateSnapshot returns a point-in-time snapshot of world state.
 	GetStateSnapshot(ctx context.Context, in *GetStateSnapshotRequest, opts ...grpc.CallOption) (*GetStateSnapshotResponse, error)
+	// GetStateSnapshots returns the latest snapshot of several worlds in one
+	// call. Each world succeeds or fails independently.
+	GetStateSnapshots(ctx context.Context, in *GetStateSnapshotsRequest, opts ...grpc.CallOption) (*GetStateSnapshotsResponse, error)
+	// ListWorlds enumerates the worlds currently held by the module.
+	ListWorlds(ctx context.Context, in *ListWorldsRequest, opts ...grpc.CallOption) (*ListWorldsResponse, error)
+	// GetEvents returns retained events for a tick range, so consumers that
+	// missed a Tick response can catch up without re-ticking.
+	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
+	// ValidateCommand checks a command's shape without reading or creating any
+	// world state, so gateways can pre-filter client input.
+	ValidateCommand(ctx context.Context, in *ValidateCommandRequest, opts ...grpc.CallOption) (*ValidateCommandResponse, error)
 }
 
 type engineModuleClient struct {
@@ -68,6 +87,16 @@ func (c *engineModuleClient) InitializeWorld(ctx context.Context, in *Initialize
 	return out, nil
 }
 
+func (c *engineModuleClient) ResetWorld(ctx context.Context, in *ResetWorldRequest, opts ...grpc.CallOption) (*ResetWorldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetWorldResponse)
+	err := c.cc.Invoke(ctx, EngineModule_ResetWorld_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *engineModuleClient) ApplyCommand(ctx context.Context, in *ApplyCommandRequest, opts ...grpc.CallOption) (*ApplyCommandResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApplyCommandResponse)
@@ -98,6 +127,46 @@ func (c *engineModuleClient) GetStateSnapshot(ctx context.Context, in *GetStateS
 	return out, nil
 }
 
+func (c *engineModuleClient) GetStateSnapshots(ctx context.Context, in *GetStateSnapshotsRequest, opts ...grpc.CallOption) (*GetStateSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStateSnapshotsResponse)
+	err := c.cc.Invoke(ctx, EngineModule_GetStateSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineModuleClient) ListWorlds(ctx context.Context, in *ListWorldsRequest, opts ...grpc.CallOption) (*ListWorldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWorldsResponse)
+	err := c.cc.Invoke(ctx, EngineModule_ListWorlds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineModuleClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEventsResponse)
+	err := c.cc.Invoke(ctx, EngineModule_GetEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineModuleClient) ValidateCommand(ctx context.Context, in *ValidateCommandRequest, opts ...grpc.CallOption) (*ValidateCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateCommandResponse)
+	err := c.cc.Invoke(ctx, EngineModule_ValidateCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EngineModuleServer is the server API for EngineModule service.
 // All implementations must embed UnimplementedEngineModuleServer
 // for forward compatibility.
@@ -107,6 +176,9 @@ func (c *engineModuleClient) GetStateSnapshot(ctx context.Context, in *GetStateS
 type EngineModuleServer interface {
 	// InitializeWorld creates or resets engine-managed state for a world.
 	InitializeWorld(context.Context, *InitializeWorldRequest) (*InitializeWorldResponse, error)
+	// ResetWorld discards an existing world's state and restarts it at tick 0
+	// with the settings it was initialized with.
+	ResetWorld(context.Context, *ResetWorldRequest) (*ResetWorldResponse, error)
 	// ApplyCommand applies a player/system command. Commands are generic and
 	// extensible via oneof.
 	ApplyCommand(context.Context, *ApplyCommandRequest) (*ApplyCommandResponse, error)
@@ -114,6 +186,17 @@ type EngineModuleServer interface {
 	Tick(context.Context, *TickRequest) (*TickResponse, error)
 	// GetStateSnapshot returns a point-in-time snapshot of world state.
 	GetStateSnapshot(context.Context, *GetStateSnapshotRequest) (*GetStateSnapshotResponse, error)
+	// GetStateSnapshots returns the latest snapshot of several worlds in one
+	// call. Each world succeeds or fails independently.
+	GetStateSnapshots(context.Context, *GetStateSnapshotsRequest) (*GetStateSnapshotsResponse, error)
+	// ListWorlds enumerates the worlds currently held by the module.
+	ListWorlds(context.Context, *ListWorldsRequest) (*ListWorldsResponse, error)
+	// GetEvents returns retained events for a tick range, so consumers that
+	// missed a Tick response can catch up without re-ticking.
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	// ValidateCommand checks a command's shape without reading or creating any
+	// world state, so gateways can pre-filter client input.
+	ValidateCommand(context.Context, *ValidateCommandRequest) (*ValidateCommandResponse, error)
 	mustEmbedUnimplementedEngineModuleServer()
 }
 
@@ -127,6 +210,9 @@ type UnimplementedEngineModuleServer struct{}
 func (UnimplementedEngineModuleServer) InitializeWorld(context.Context, *InitializeWorldRequest) (*InitializeWorldResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitializeWorld not implemented")
 }
+func (UnimplementedEngineModuleServer) ResetWorld(context.Context, *ResetWorldRequest) (*ResetWorldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetWorld not implemented")
+}
 func (UnimplementedEngineModuleServer) ApplyCommand(context.Context, *ApplyCommandRequest) (*ApplyCommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyCommand not implemented")
 }
@@ -136,6 +222,18 @@ func (UnimplementedEngineModuleServer) Tick(context.Context, *TickRequest) (*Tic
 func (UnimplementedEngineModuleServer) GetStateSnapshot(context.Context, *GetStateSnapshotRequest) (*GetStateSnapshotResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStateSnapshot not implemented")
 }
+func (UnimplementedEngineModuleServer) GetStateSnapshots(context.Context, *GetStateSnapshotsRequest) (*GetStateSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateSnapshots not implemented")
+}
+func (UnimplementedEngineModuleServer) ListWorlds(context.Context, *ListWorldsRequest) (*ListWorldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorlds not implemented")
+}
+func (UnimplementedEngineModuleServer) GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+}
+func (UnimplementedEngineModuleServer) ValidateCommand(context.Context, *ValidateCommandRequest) (*ValidateCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCommand not implemented")
+}
 func (UnimplementedEngineModuleServer) mustEmbedUnimplementedEngineModuleServer() {}
 func (UnimplementedEngineModuleServer) testEmbeddedByValue()                      {}
 
@@ -175,6 +273,24 @@ func _EngineModule_InitializeWorld_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineModule_ResetWorld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetWorldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineModuleServer).ResetWorld(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineModule_ResetWorld_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineModuleServer).ResetWorld(ctx, req.(*ResetWorldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EngineModule_ApplyCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplyCommandRequest)
 	if err := dec(in); err != nil {
@@ -229,6 +345,78 @@ func _EngineModule_GetStateSnapshot_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineModule_GetStateSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineModuleServer).GetStateSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineModule_GetStateSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineModuleServer).GetStateSnapshots(ctx, req.(*GetStateSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineModule_ListWorlds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineModuleServer).ListWorlds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineModule_ListWorlds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineModuleServer).ListWorlds(ctx, req.(*ListWorldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineModule_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineModuleServer).GetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineModule_GetEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineModuleServer).GetEvents(ctx, req.(*GetEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineModule_ValidateCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineModuleServer).ValidateCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineModule_ValidateCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineModuleServer).ValidateCommand(ctx, req.(*ValidateCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EngineModule_ServiceDesc is the grpc.ServiceDesc for EngineModule service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -240,6 +428,10 @@ var EngineModule_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InitializeWorld",
 			Handler:    _EngineModule_InitializeWorld_Handler,
 		},
+		{
+			MethodName: "ResetWorld",
+			Handler:    _EngineModule_ResetWorld_Handler,
+		},
 		{
 			MethodName: "ApplyCommand",
 			Handler:    _EngineModule_ApplyCommand_Handler,
@@ -252,6 +444,22 @@ var EngineModule_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStateSnapshot",
 			Handler:    _EngineModule_GetStateSnapshot_Handler,
 		},
+		{
+			MethodName: "GetStateSnapshots",
+			Handler:    _EngineModule_GetStateSnapshots_Handler,
+		},
+		{
+			MethodName: "ListWorlds",
+			Handler:    _EngineModule_ListWorlds_Handler,
+		},
+		{
+			MethodName: "GetEvents",
+			Handler:    _EngineModule_GetEvents_Handler,
+		},
+		{
+			MethodName: "ValidateCommand",
+			Handler:    _EngineModule_ValidateCommand_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/game/engine/v1/engine.proto",
@@ -6,10 +6,12 @@ import (
 	"encoding/hex"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -31,8 +33,16 @@ const (
 	labelGameName  = "bindery.platform/game"
 
 	managedByCapabilityResolver = "capabilityresolver"
+
+	// worldTeardownFinalizer holds a deleted WorldInstance until modules have been asked to checkpoint.
+	worldTeardownFinalizer = "bindery.platform/world-teardown"
 )
 
+// worldCheckpointGracePeriod is how long a deleted world stays around after
+// CheckpointRequested is raised, giving modules time to flush state before
+// owner-reference GC removes shards, bindings, and workloads.
+const worldCheckpointGracePeriod = 10 * time.Second
+
 var (
 	reNonDNS = regexp.MustCompile(`[^a-z0-9-]+`)
 )
@@ -42,7 +52,8 @@ var (
 // RBAC:
 // +kubebuilder:rbac:groups=bindery.platform,resources=modulemanifests,verbs=get;list;watch
 // +kubebuilder:rbac:groups=bindery.platform,resources=booklets,verbs=get;list;watch
-// +kubebuilder:rbac:groups=bindery.platform,resources=worldinstances,verbs=get;list;watch
+// +kubebuilder:rbac:groups=bindery.platform,resources=worldinstances,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=bindery.platform,resources=worldinstances/finalizers,verbs=update
 // +kubebuilder:rbac:groups=bindery.platform,resources=capabilitybindings,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=bindery.platform,resources=capabilitybindings/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=bindery.platform,resources=worldinstances/status,verbs=get;update;patch
@@ -54,9 +65,18 @@ type CapabilityResolverReconciler struct {
 	Scheme   *runtime.Scheme
 	Resolver resolver.Resolver
 	Recorder record.EventRecorder
+	// Options tunes worker concurrency and requeue backoff.
+	Options ControllerOptions
+	// CapabilityAliases declares capability ids matched as equivalent; see
+	// resolver.Input.CapabilityAliases.
+	CapabilityAliases map[string]string
+	// PreferExisting keeps single-provider requirements on their currently-bound
+	// provider; see resolver.Input.PreferExisting.
+	PreferExisting bool
 }
 
-func (r *CapabilityResolverReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *CapabilityResolverReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, err error) {
+	defer observeReconcile("CapabilityResolver", time.Now(), &res, &err)
 	binderyControllerReconcileTotal.WithLabelValues("CapabilityResolver").Inc()
 	capabilityResolverUnresolvedRequired.Set(0)
 
@@ -81,6 +101,19 @@ func (r *CapabilityResolverReconciler) Reconcile(ctx context.Context, req ctrl.R
 		"worldId", world.Spec.WorldID,
 		"game", world.Spec.GameRef.Name,
 	)
+
+	if !world.DeletionTimestamp.IsZero() {
+		return r.finalizeWorld(ctx, &world)
+	}
+	if !controllerutil.ContainsFinalizer(&world, worldTeardownFinalizer) {
+		controllerutil.AddFinalizer(&world, worldTeardownFinalizer)
+		if err := r.Update(ctx, &world); err != nil {
+			logger.Error(err, "failed to add teardown finalizer")
+			binderyControllerReconcileErrorTotal.WithLabelValues("CapabilityResolver").Inc()
+			return ctrl.Result{}, err
+		}
+	}
+
 	logger.Info("reconciling world")
 
 	if r.Resolver == nil {
@@ -121,10 +154,12 @@ func (r *CapabilityResolverReconciler) Reconcile(ctx context.Context, req ctrl.R
 	// 3) Load participating ModuleManifests from Booklet.spec.modules
 	modules := make([]binderyv1alpha1.ModuleManifest, 0, len(game.Spec.Modules))
 	missingRequired := make([]string, 0)
+	var missingModules []binderyv1alpha1.MissingModuleRef
 	for _, ref := range game.Spec.Modules {
 		var mm binderyv1alpha1.ModuleManifest
 		if err := r.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: ref.Name}, &mm); err != nil {
 			if apierrors.IsNotFound(err) {
+				missingModules = append(missingModules, binderyv1alpha1.MissingModuleRef{Name: ref.Name, Required: ref.Required, Source: binderyv1alpha1.ModuleRefSourceBooklet})
 				if ref.Required {
 					missingRequired = append(missingRequired, ref.Name)
 				}
@@ -137,6 +172,9 @@ func (r *CapabilityResolverReconciler) Reconcile(ctx context.Context, req ctrl.R
 	}
 
 	if len(missingRequired) > 0 {
+		if _, err := r.patchMissingModules(ctx, &world, missingModules); err != nil {
+			logger.Error(err, "failed to patch world missing modules")
+		}
 		msg := fmt.Sprintf("ModuleManifestNotFound: %s", strings.Join(missingRequired, ", "))
 		if perr := r.patchWorldStatus(ctx, &world, "Error", msg,
 			metav1.Condition{
@@ -159,36 +197,58 @@ func (r *CapabilityResolverReconciler) Reconcile(ctx context.Context, req ctrl.R
 		return ctrl.Result{}, nil
 	}
 
-	// 3b) Load Realm modules (External Modules)
-	var externalModules []binderyv1alpha1.ModuleManifest
-	if world.Spec.RealmRef != nil && world.Spec.RealmRef.Name != "" {
-		var realm binderyv1alpha1.Realm
-		if err := r.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: world.Spec.RealmRef.Name}, &realm); err != nil {
-			if apierrors.IsNotFound(err) {
-				logger.V(1).Info("realm not found; proceeding without realm modules", "realm", world.Spec.RealmRef.Name)
-			} else {
-				logger.Error(err, "failed to load realm")
-				return ctrl.Result{}, err
-			}
-		} else {
-			for _, mod := range realm.Spec.Modules {
-				var mm binderyv1alpha1.ModuleManifest
-				if err := r.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: mod.Name}, &mm); err != nil {
-					if apierrors.IsNotFound(err) {
-						logger.V(1).Info("realm module not found; skipping", "module", mod.Name)
-						continue
-					}
-					logger.Error(err, "failed to load realm module", "module", mod.Name)
-					return ctrl.Result{}, err
-				}
-				externalModules = append(externalModules, mm)
+	// 3b) Load Realm modules. The world's own Realm serves every requirement; a Realm
+	// named only by a requirement's realmRef serves just the requirements naming it.
+	worldRealm := ""
+	if world.Spec.RealmRef != nil {
+		worldRealm = strings.TrimSpace(world.Spec.RealmRef.Name)
+	}
+	realmModules := map[string][]binderyv1alpha1.ModuleManifest{}
+	for _, realmName := range realmsForWorld(&world, modules) {
+		mods, missing, err := r.loadRealmModules(ctx, req.Namespace, realmName)
+		if err != nil {
+			logger.Error(err, "failed to load realm modules", "realm", realmName)
+			return ctrl.Result{}, err
+		}
+		if realmName == worldRealm {
+			// Other Realms are not part of the world, so only its own Realm's gaps are reported.
+			for _, name := range missing {
+				missingModules = append(missingModules, binderyv1alpha1.MissingModuleRef{Name: name, Source: binderyv1alpha1.ModuleRefSourceRealm})
 			}
 		}
+		realmModules[realmName] = mods
 	}
 
-	// 4) Resolve bindings
+	if changed, err := r.patchMissingModules(ctx, &world, missingModules); err != nil {
+		logger.Error(err, "failed to patch world missing modules")
+	} else if changed && len(missingModules) > 0 {
+		r.recordEventf(&world, "Warning", "OptionalModuleManifestNotFound", "Optional ModuleManifest(s) missing: %s", missingModuleNames(missingModules))
+	}
+
+	// 4) Resolve bindings. The bindings managed for this world feed PreferExisting and
+	// are garbage-collected below.
+	var existing binderyv1alpha1.CapabilityBindingList
+	if err := r.List(ctx, &existing,
+		client.InNamespace(req.Namespace),
+		client.MatchingLabels{
+			labelManagedBy: managedByCapabilityResolver,
+			labelWorldName: world.Name,
+		},
+	); err != nil {
+		logger.Error(err, "failed to list existing capabilitybindings")
+		return ctrl.Result{}, err
+	}
 	start := time.Now()
-	plan, err := r.Resolver.Resolve(ctx, resolver.Input{World: world, Game: game, Modules: modules, ExternalModules: externalModules})
+	plan, err := r.Resolver.Resolve(ctx, resolver.Input{
+		World:             world,
+		Game:              game,
+		Modules:           modules,
+		ExternalModules:   realmModules[worldRealm],
+		RealmModules:      realmModules,
+		CapabilityAliases: r.CapabilityAliases,
+		ExistingBindings:  existing.Items,
+		PreferExisting:    r.PreferExisting,
+	})
 	capabilityResolverResolutionDuration.Observe(time.Since(start).Seconds())
 	if err != nil {
 		// Resolver errors are treated as config errors (schema-valid but semantically invalid).
@@ -198,7 +258,7 @@ func (r *CapabilityResolverReconciler) Reconcile(ctx context.Context, req ctrl.R
 				Type:    WorldConditionModulesResolved,
 				Status:  metav1.ConditionTrue,
 				Reason:  "ModulesLoaded",
-				Message: "All required modules loaded",
+				Message: modulesLoadedMessage(missingModules),
 			},
 			metav1.Condition{
 				Type:    WorldConditionBindingsResolved,
@@ -222,6 +282,9 @@ func (r *CapabilityResolverReconciler) Reconcile(ctx context.Context, req ctrl.R
 		"unresolvedRequiredCount", len(plan.Diagnostics.UnresolvedRequired),
 		"unresolvedOptionalCount", len(plan.Diagnostics.UnresolvedOptional),
 	)
+	for _, d := range plan.Diagnostics.DeprecatedSelected {
+		r.recordEventf(&world, "Warning", "DeprecatedProviderSelected", "%s: %s bound to deprecated provider %s@%s", d.ConsumerModuleManifestName, d.CapabilityID, d.ProviderModuleManifestName, d.ProviderVersion)
+	}
 
 	// 5) Apply desired bindings
 	createdCount := 0
@@ -263,7 +326,7 @@ func (r *CapabilityResolverReconciler) Reconcile(ctx context.Context, req ctrl.R
 						Type:    WorldConditionModulesResolved,
 						Status:  metav1.ConditionTrue,
 						Reason:  "ModulesLoaded",
-						Message: "All required modules loaded",
+						Message: modulesLoadedMessage(missingModules),
 					},
 					metav1.Condition{
 						Type:    WorldConditionBindingsResolved,
@@ -325,17 +388,6 @@ func (r *CapabilityResolverReconciler) Reconcile(ctx context.Context, req ctrl.R
 	}
 
 	// 6) Garbage-collect stale bindings that we manage for this world.
-	var existing binderyv1alpha1.CapabilityBindingList
-	if err := r.List(ctx, &existing,
-		client.InNamespace(req.Namespace),
-		client.MatchingLabels{
-			labelManagedBy: managedByCapabilityResolver,
-			labelWorldName: world.Name,
-		},
-	); err != nil {
-		logger.Error(err, "failed to list existing capabilitybindings")
-		return ctrl.Result{}, err
-	}
 	deletedCount := 0
 	for i := range existing.Items {
 		b := &existing.Items[i]
@@ -365,7 +417,7 @@ func (r *CapabilityResolverReconciler) Reconcile(ctx context.Context, req ctrl.R
 				Type:    WorldConditionModulesResolved,
 				Status:  metav1.ConditionTrue,
 				Reason:  "ModulesLoaded",
-				Message: "All required modules loaded",
+				Message: modulesLoadedMessage(missingModules),
 			},
 			metav1.Condition{
 				Type:    WorldConditionBindingsResolved,
@@ -384,12 +436,28 @@ func (r *CapabilityResolverReconciler) Reconcile(ctx context.Context, req ctrl.R
 	if len(plan.Diagnostics.UnresolvedOptional) > 0 {
 		message = fmt.Sprintf("All required bindings resolved (%d optional unresolved)", len(plan.Diagnostics.UnresolvedOptional))
 	}
-	if perr := r.patchWorldStatus(ctx, &world, "Running", message,
+	phase := "Running"
+	phaseMessage := message
+	pausedCond := metav1.Condition{
+		Type:    WorldConditionPaused,
+		Status:  metav1.ConditionFalse,
+		Reason:  "DesiredStateRunning",
+		Message: "World workloads are running",
+	}
+	if worldPaused(&world) {
+		// Bindings stay resolved so resuming only has to scale workloads back up.
+		phase = "Paused"
+		phaseMessage = "World paused; workloads scaled to zero"
+		pausedCond.Status = metav1.ConditionTrue
+		pausedCond.Reason = "DesiredStatePaused"
+		pausedCond.Message = phaseMessage
+	}
+	if perr := r.patchWorldStatus(ctx, &world, phase, phaseMessage,
 		metav1.Condition{
 			Type:    WorldConditionModulesResolved,
 			Status:  metav1.ConditionTrue,
 			Reason:  "ModulesLoaded",
-			Message: "All required modules loaded",
+			Message: modulesLoadedMessage(missingModules),
 		},
 		metav1.Condition{
 			Type:    WorldConditionBindingsResolved,
@@ -397,18 +465,70 @@ func (r *CapabilityResolverReconciler) Reconcile(ctx context.Context, req ctrl.R
 			Reason:  "Resolved",
 			Message: message,
 		},
+		pausedCond,
 	); perr != nil {
 		logger.Error(perr, "failed to patch world status")
 	}
-	logger.Info("world resolved", "phase", "Running")
-	if prevPhase != "Running" {
+	logger.Info("world resolved", "phase", phase)
+	if prevPhase != phase {
 		// Avoid spamming; emit only on transitions.
-		r.recordEventf(&world, "Normal", "BindingsResolved", "%s", message)
+		switch {
+		case phase == "Paused":
+			r.recordEventf(&world, "Normal", "WorldPaused", "%s", phaseMessage)
+		case prevPhase == "Paused":
+			r.recordEventf(&world, "Normal", "WorldResumed", "%s", message)
+		default:
+			r.recordEventf(&world, "Normal", "BindingsResolved", "%s", message)
+		}
 	}
 
 	return ctrl.Result{}, nil
 }
 
+// finalizeWorld runs ordered teardown for a deleted world: it first raises CheckpointRequested
+// so modules can flush state, waits worldCheckpointGracePeriod, then releases the finalizer and
+// lets owner-reference GC remove shards, bindings, and workloads.
+func (r *CapabilityResolverReconciler) finalizeWorld(ctx context.Context, world *binderyv1alpha1.WorldInstance) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	if !controllerutil.ContainsFinalizer(world, worldTeardownFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	cond := meta.FindStatusCondition(world.Status.Conditions, WorldConditionCheckpointRequested)
+	if cond == nil || cond.Status != metav1.ConditionTrue {
+		if err := r.patchWorldStatus(ctx, world, "Terminating", "Waiting for modules to checkpoint before teardown",
+			metav1.Condition{
+				Type:    WorldConditionCheckpointRequested,
+				Status:  metav1.ConditionTrue,
+				Reason:  "WorldDeleting",
+				Message: fmt.Sprintf("World is being deleted; modules have %s to checkpoint", worldCheckpointGracePeriod),
+			},
+		); err != nil {
+			logger.Error(err, "failed to request checkpoint")
+			binderyControllerReconcileErrorTotal.WithLabelValues("CapabilityResolver").Inc()
+			return ctrl.Result{}, err
+		}
+		r.recordEventf(world, "Normal", "CheckpointRequested", "World deleting; waiting %s for modules to checkpoint", worldCheckpointGracePeriod)
+		return ctrl.Result{RequeueAfter: worldCheckpointGracePeriod}, nil
+	}
+
+	if remaining := worldCheckpointGracePeriod - time.Since(cond.LastTransitionTime.Time); remaining > 0 {
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	controllerutil.RemoveFinalizer(world, worldTeardownFinalizer)
+	if err := r.Update(ctx, world); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "failed to remove teardown finalizer")
+		binderyControllerReconcileErrorTotal.WithLabelValues("CapabilityResolver").Inc()
+		return ctrl.Result{}, err
+	}
+	logger.Info("released world for teardown")
+	return ctrl.Result{}, nil
+}
+
 func (r *CapabilityResolverReconciler) recordEventf(obj client.Object, eventType, reason, messageFmt string, args ...any) {
 	if r.Recorder == nil || obj == nil {
 		return
@@ -479,7 +599,7 @@ func (r *CapabilityResolverReconciler) SetupWithManager(mgr ctrl.Manager) error
 		enqueueWorldsForModule(mgr.GetClient()),
 	)
 
-	return b.Complete(r)
+	return b.WithOptions(r.Options.controllerOptions()).Complete(r)
 }
 
 // enqueueWorldsForGame returns an event handler that enqueues WorldInstances impacted by a Booklet.
@@ -558,6 +678,44 @@ func (r *CapabilityResolverReconciler) patchWorldStatus(ctx context.Context, wor
 	return r.Status().Patch(ctx, world, client.MergeFrom(before))
 }
 
+// patchMissingModules records referenced-but-missing ModuleManifests on the world status.
+// It is a separate patch so the phase/condition patches after it stay unchanged.
+func (r *CapabilityResolverReconciler) patchMissingModules(ctx context.Context, world *binderyv1alpha1.WorldInstance, missing []binderyv1alpha1.MissingModuleRef) (bool, error) {
+	if missingModulesEqual(world.Status.MissingModules, missing) {
+		return false, nil
+	}
+	before := world.DeepCopy()
+	world.Status.MissingModules = missing
+	return true, r.Status().Patch(ctx, world, client.MergeFrom(before))
+}
+
+func missingModulesEqual(a, b []binderyv1alpha1.MissingModuleRef) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func modulesLoadedMessage(missing []binderyv1alpha1.MissingModuleRef) string {
+	if len(missing) == 0 {
+		return "All required modules loaded"
+	}
+	return fmt.Sprintf("All required modules loaded (%d optional missing: %s)", len(missing), missingModuleNames(missing))
+}
+
+func missingModuleNames(refs []binderyv1alpha1.MissingModuleRef) string {
+	names := make([]string, 0, len(refs))
+	for _, m := range refs {
+		names = append(names, m.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
 func summarizeUnresolved(reqs []resolver.UnresolvedRequirement) string {
 	// Keep this human-readable and bounded.
 	if len(reqs) == 0 {
@@ -718,3 +876,63 @@ func min(a, b int) int {
 	}
 	return b
 }
+
+// loadRealmModules loads the ModuleManifests the named Realm lists, returning the names of
+// those that do not exist. A missing Realm contributes no modules.
+func (r *CapabilityResolverReconciler) loadRealmModules(ctx context.Context, namespace, realmName string) ([]binderyv1alpha1.ModuleManifest, []string, error) {
+	logger := log.FromContext(ctx)
+	var realm binderyv1alpha1.Realm
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: realmName}, &realm); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(1).Info("realm not found; proceeding without realm modules", "realm", realmName)
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	var mods []binderyv1alpha1.ModuleManifest
+	var missing []string
+	for _, mod := range realm.Spec.Modules {
+		var mm binderyv1alpha1.ModuleManifest
+		if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: mod.Name}, &mm); err != nil {
+			if apierrors.IsNotFound(err) {
+				logger.V(1).Info("realm module not found; skipping", "realm", realmName, "module", mod.Name)
+				missing = append(missing, mod.Name)
+				continue
+			}
+			return nil, nil, err
+		}
+		mods = append(mods, mm)
+	}
+	return mods, missing, nil
+}
+
+// realmsForWorld returns, in a stable order, the Realm the world belongs to followed by
+// any other Realm named by a module requirement's realmRef.
+func realmsForWorld(world *binderyv1alpha1.WorldInstance, modules []binderyv1alpha1.ModuleManifest) []string {
+	var out []string
+	seen := map[string]bool{}
+	add := func(name string) {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			return
+		}
+		seen[name] = true
+		out = append(out, name)
+	}
+	if world.Spec.RealmRef != nil {
+		add(world.Spec.RealmRef.Name)
+	}
+	var selected []string
+	for _, m := range modules {
+		for _, req := range m.Spec.Requires {
+			if req.RealmRef != nil {
+				selected = append(selected, req.RealmRef.Name)
+			}
+		}
+	}
+	sort.Strings(selected)
+	for _, name := range selected {
+		add(name)
+	}
+	return out
+}
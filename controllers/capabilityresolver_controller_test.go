@@ -3,14 +3,18 @@ package controllers
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/bayleafwalker/bindery-core/api/v1alpha1"
 	"github.com/bayleafwalker/bindery-core/internal/resolver"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 func TestStableBindingName_DeterministicAndSafe(t *testing.T) {
@@ -135,3 +139,435 @@ func TestCapabilityResolverReconcile_CreatesManagedBinding(t *testing.T) {
 		}
 	}
 }
+
+func TestCapabilityResolverReconcile_TeardownFinalizer(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+
+	world := &v1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"},
+		Spec:       v1alpha1.WorldInstanceSpec{GameRef: v1alpha1.ObjectRef{Name: "missing-game"}, WorldID: "w1", ShardCount: 1},
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world).WithStatusSubresource(world).Build()
+	r := &CapabilityResolverReconciler{Client: cl, Scheme: scheme, Resolver: resolver.NewDefault()}
+	key := types.NamespacedName{Namespace: "default", Name: "world-1"}
+
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	var got v1alpha1.WorldInstance
+	if err := cl.Get(ctx, key, &got); err != nil {
+		t.Fatalf("get world: %v", err)
+	}
+	if !controllerutil.ContainsFinalizer(&got, worldTeardownFinalizer) {
+		t.Fatalf("expected teardown finalizer on world, got %v", got.Finalizers)
+	}
+
+	if err := cl.Delete(ctx, &got); err != nil {
+		t.Fatalf("delete world: %v", err)
+	}
+	res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	if err != nil {
+		t.Fatalf("Reconcile (deleting): %v", err)
+	}
+	if res.RequeueAfter <= 0 {
+		t.Fatalf("expected requeue while waiting for checkpoint, got %#v", res)
+	}
+	if err := cl.Get(ctx, key, &got); err != nil {
+		t.Fatalf("expected world to be held by finalizer: %v", err)
+	}
+	cond := meta.FindStatusCondition(got.Status.Conditions, WorldConditionCheckpointRequested)
+	if cond == nil || cond.Status != metav1.ConditionTrue {
+		t.Fatalf("expected CheckpointRequested=True, got %#v", cond)
+	}
+
+	// Simulate the grace period elapsing.
+	cond.LastTransitionTime = metav1.NewTime(time.Now().Add(-2 * worldCheckpointGracePeriod))
+	if err := cl.Status().Update(ctx, &got); err != nil {
+		t.Fatalf("backdate condition: %v", err)
+	}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile (release): %v", err)
+	}
+	if err := cl.Get(ctx, key, &got); !apierrors.IsNotFound(err) {
+		t.Fatalf("expected world to be gone after finalizer removal, got err=%v finalizers=%v", err, got.Finalizers)
+	}
+}
+
+func TestCapabilityResolverReconcile_RecordsMissingOptionalModule(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+
+	world := &v1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"},
+		Spec:       v1alpha1.WorldInstanceSpec{GameRef: v1alpha1.ObjectRef{Name: "game"}, WorldID: "w1", ShardCount: 1},
+	}
+	game := &v1alpha1.Booklet{
+		ObjectMeta: metav1.ObjectMeta{Name: "game", Namespace: "default"},
+		Spec: v1alpha1.BookletSpec{
+			GameID:  "g",
+			Version: "0.1.0",
+			Modules: []v1alpha1.BookletModuleRef{
+				{Name: "core-physics-engine", Required: true},
+				{Name: "optional-analytics"},
+			},
+		},
+	}
+	physics := &v1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "core-physics-engine", Namespace: "default"},
+		Spec: v1alpha1.ModuleManifestSpec{
+			Module: v1alpha1.ModuleIdentity{ID: "core.physics", Version: "1.3.0"},
+			Provides: []v1alpha1.ProvidedCapability{
+				{CapabilityID: "physics.engine", Version: "1.2.0", Scope: v1alpha1.CapabilityScopeWorld, Multiplicity: v1alpha1.MultiplicityOne},
+			},
+			Scaling: v1alpha1.ModuleScaling{DefaultScope: v1alpha1.CapabilityScopeWorld, Statefulness: "stateless"},
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, game, physics).WithStatusSubresource(world).Build()
+	r := &CapabilityResolverReconciler{Client: cl, Scheme: scheme, Resolver: resolver.NewDefault()}
+	key := types.NamespacedName{Namespace: "default", Name: "world-1"}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	var got v1alpha1.WorldInstance
+	if err := cl.Get(ctx, key, &got); err != nil {
+		t.Fatalf("get world: %v", err)
+	}
+	if got.Status.Phase == "Error" {
+		t.Fatalf("a missing optional module must not fail the world: %s", got.Status.Message)
+	}
+	want := []v1alpha1.MissingModuleRef{{Name: "optional-analytics", Required: false, Source: v1alpha1.ModuleRefSourceBooklet}}
+	if !missingModulesEqual(got.Status.MissingModules, want) {
+		t.Fatalf("expected missing modules %v, got %v", want, got.Status.MissingModules)
+	}
+	if !meta.IsStatusConditionTrue(got.Status.Conditions, WorldConditionModulesResolved) {
+		t.Fatalf("expected ModulesResolved=True, got %v", got.Status.Conditions)
+	}
+}
+
+func TestCapabilityResolverReconcile_BindsOptionalProviderAddedLater(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+
+	world := &v1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default", UID: types.UID("world-uid")},
+		Spec:       v1alpha1.WorldInstanceSpec{GameRef: v1alpha1.ObjectRef{Name: "game"}, WorldID: "w1", ShardCount: 1},
+	}
+	game := &v1alpha1.Booklet{
+		ObjectMeta: metav1.ObjectMeta{Name: "game", Namespace: "default"},
+		Spec: v1alpha1.BookletSpec{
+			GameID:  "g",
+			Version: "0.1.0",
+			Modules: []v1alpha1.BookletModuleRef{
+				{Name: "core-interaction-engine", Required: true},
+				{Name: "optional-analytics"},
+			},
+		},
+	}
+	interaction := &v1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "core-interaction-engine", Namespace: "default"},
+		Spec: v1alpha1.ModuleManifestSpec{
+			Module: v1alpha1.ModuleIdentity{ID: "core.interaction", Version: "0.9.0"},
+			Requires: []v1alpha1.RequiredCapability{
+				{CapabilityID: "analytics.sink", VersionConstraint: "^1.0.0", Scope: v1alpha1.CapabilityScopeWorld, Multiplicity: v1alpha1.MultiplicityOne, DependencyMode: v1alpha1.DependencyModeOptional},
+			},
+			Scaling: v1alpha1.ModuleScaling{DefaultScope: v1alpha1.CapabilityScopeWorld, Statefulness: "stateless"},
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, game, interaction).WithStatusSubresource(world).Build()
+	r := &CapabilityResolverReconciler{Client: cl, Scheme: scheme, Resolver: resolver.NewDefault()}
+	key := types.NamespacedName{Namespace: "default", Name: "world-1"}
+	bindingKey := types.NamespacedName{
+		Namespace: "default",
+		Name:      stableBindingName("world-1", "core-interaction-engine", "analytics.sink", v1alpha1.CapabilityScopeWorld, v1alpha1.MultiplicityOne),
+	}
+
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	var got v1alpha1.WorldInstance
+	if err := cl.Get(ctx, key, &got); err != nil {
+		t.Fatalf("get world: %v", err)
+	}
+	if got.Status.Phase != "Running" || got.Status.Message != "All required bindings resolved (1 optional unresolved)" {
+		t.Fatalf("expected Running with 1 optional unresolved, got %s: %s", got.Status.Phase, got.Status.Message)
+	}
+	var binding v1alpha1.CapabilityBinding
+	if err := cl.Get(ctx, bindingKey, &binding); !apierrors.IsNotFound(err) {
+		t.Fatalf("expected no optional binding before the provider exists, got err=%v", err)
+	}
+
+	// The optional provider shows up; the next reconcile (driven by the ModuleManifest watch) binds it.
+	analytics := &v1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "optional-analytics", Namespace: "default"},
+		Spec: v1alpha1.ModuleManifestSpec{
+			Module: v1alpha1.ModuleIdentity{ID: "optional.analytics", Version: "1.0.0"},
+			Provides: []v1alpha1.ProvidedCapability{
+				{CapabilityID: "analytics.sink", Version: "1.1.0", Scope: v1alpha1.CapabilityScopeWorld, Multiplicity: v1alpha1.MultiplicityOne},
+			},
+			Scaling: v1alpha1.ModuleScaling{DefaultScope: v1alpha1.CapabilityScopeWorld, Statefulness: "stateless"},
+		},
+	}
+	if err := cl.Create(ctx, analytics); err != nil {
+		t.Fatalf("create optional module: %v", err)
+	}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	if err := cl.Get(ctx, bindingKey, &binding); err != nil {
+		t.Fatalf("expected optional binding after provider was added: %v", err)
+	}
+	if binding.Spec.Provider.ModuleManifestName != "optional-analytics" || binding.Spec.Provider.CapabilityVersion != "1.1.0" {
+		t.Fatalf("unexpected provider: %+v", binding.Spec.Provider)
+	}
+	if binding.Spec.Consumer.Requirement == nil || binding.Spec.Consumer.Requirement.DependencyMode != v1alpha1.DependencyModeOptional {
+		t.Fatalf("expected optional requirement hint, got %+v", binding.Spec.Consumer.Requirement)
+	}
+	if err := cl.Get(ctx, key, &got); err != nil {
+		t.Fatalf("get world: %v", err)
+	}
+	if got.Status.Message != "All required bindings resolved" {
+		t.Fatalf("expected optional diagnostic to clear, got %q", got.Status.Message)
+	}
+	if len(got.Status.MissingModules) != 0 {
+		t.Fatalf("expected no missing modules, got %v", got.Status.MissingModules)
+	}
+}
+
+func TestCapabilityResolverReconcile_RealmRefOnlyRealmServesOnlyItsRequirements(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+
+	world := &v1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default", UID: types.UID("world-uid")},
+		Spec: v1alpha1.WorldInstanceSpec{
+			GameRef:    v1alpha1.ObjectRef{Name: "game"},
+			RealmRef:   &v1alpha1.ObjectRef{Name: "home"},
+			WorldID:    "w1",
+			ShardCount: 1,
+		},
+	}
+	game := &v1alpha1.Booklet{
+		ObjectMeta: metav1.ObjectMeta{Name: "game", Namespace: "default"},
+		Spec: v1alpha1.BookletSpec{
+			GameID:  "g",
+			Version: "0.1.0",
+			Modules: []v1alpha1.BookletModuleRef{{Name: "lobby", Required: true}},
+		},
+	}
+	lobby := &v1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "lobby", Namespace: "default"},
+		Spec: v1alpha1.ModuleManifestSpec{
+			Module: v1alpha1.ModuleIdentity{ID: "lobby", Version: "1.0.0"},
+			Requires: []v1alpha1.RequiredCapability{
+				{CapabilityID: "chat", VersionConstraint: "^1.0.0", Scope: v1alpha1.CapabilityScopeRealm, Multiplicity: v1alpha1.MultiplicityOne, DependencyMode: v1alpha1.DependencyModeRequired, RealmRef: &v1alpha1.ObjectRef{Name: "foreign"}},
+				{CapabilityID: "analytics.sink", VersionConstraint: "^1.0.0", Scope: v1alpha1.CapabilityScopeRealm, Multiplicity: v1alpha1.MultiplicityOne, DependencyMode: v1alpha1.DependencyModeOptional},
+			},
+			Scaling: v1alpha1.ModuleScaling{DefaultScope: v1alpha1.CapabilityScopeWorld, Statefulness: "stateless"},
+		},
+	}
+	home := &v1alpha1.Realm{
+		ObjectMeta: metav1.ObjectMeta{Name: "home", Namespace: "default"},
+		Spec:       v1alpha1.RealmSpec{Modules: []v1alpha1.RealmModule{{Name: "home-missing"}}},
+	}
+	foreign := &v1alpha1.Realm{
+		ObjectMeta: metav1.ObjectMeta{Name: "foreign", Namespace: "default"},
+		Spec:       v1alpha1.RealmSpec{Modules: []v1alpha1.RealmModule{{Name: "foreign-services"}, {Name: "foreign-missing"}}},
+	}
+	// foreign-services also offers analytics.sink, which lobby requires without a realmRef.
+	foreignServices := &v1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "foreign-services", Namespace: "default"},
+		Spec: v1alpha1.ModuleManifestSpec{
+			Module: v1alpha1.ModuleIdentity{ID: "foreign.services", Version: "1.0.0"},
+			Provides: []v1alpha1.ProvidedCapability{
+				{CapabilityID: "chat", Version: "1.2.0", Scope: v1alpha1.CapabilityScopeRealm, Multiplicity: v1alpha1.MultiplicityOne},
+				{CapabilityID: "analytics.sink", Version: "1.2.0", Scope: v1alpha1.CapabilityScopeRealm, Multiplicity: v1alpha1.MultiplicityOne},
+			},
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, game, lobby, home, foreign, foreignServices).WithStatusSubresource(world).Build()
+	r := &CapabilityResolverReconciler{Client: cl, Scheme: scheme, Resolver: resolver.NewDefault()}
+	key := types.NamespacedName{Namespace: "default", Name: "world-1"}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	var chat v1alpha1.CapabilityBinding
+	chatKey := types.NamespacedName{Namespace: "default", Name: stableBindingName("world-1", "lobby", "chat", v1alpha1.CapabilityScopeRealm, v1alpha1.MultiplicityOne)}
+	if err := cl.Get(ctx, chatKey, &chat); err != nil {
+		t.Fatalf("expected realmRef binding: %v", err)
+	}
+	if chat.Spec.Provider.ModuleManifestName != "foreign-services" {
+		t.Fatalf("expected chat bound to foreign-services, got %+v", chat.Spec.Provider)
+	}
+	var analytics v1alpha1.CapabilityBinding
+	analyticsKey := types.NamespacedName{Namespace: "default", Name: stableBindingName("world-1", "lobby", "analytics.sink", v1alpha1.CapabilityScopeRealm, v1alpha1.MultiplicityOne)}
+	if err := cl.Get(ctx, analyticsKey, &analytics); !apierrors.IsNotFound(err) {
+		t.Fatalf("expected no binding to a foreign realm without realmRef, got err=%v provider=%+v", err, analytics.Spec.Provider)
+	}
+
+	var got v1alpha1.WorldInstance
+	if err := cl.Get(ctx, key, &got); err != nil {
+		t.Fatalf("get world: %v", err)
+	}
+	want := []v1alpha1.MissingModuleRef{{Name: "home-missing", Source: v1alpha1.ModuleRefSourceRealm}}
+	if !missingModulesEqual(got.Status.MissingModules, want) {
+		t.Fatalf("expected only the world realm's missing modules %v, got %v", want, got.Status.MissingModules)
+	}
+}
+
+func TestCapabilityResolverReconcile_MatchesCapabilityAliases(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+
+	world := &v1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default", UID: types.UID("world-uid")},
+		Spec:       v1alpha1.WorldInstanceSpec{GameRef: v1alpha1.ObjectRef{Name: "game"}, WorldID: "w1", ShardCount: 1},
+	}
+	game := &v1alpha1.Booklet{
+		ObjectMeta: metav1.ObjectMeta{Name: "game", Namespace: "default"},
+		Spec: v1alpha1.BookletSpec{
+			GameID:  "g",
+			Version: "0.1.0",
+			Modules: []v1alpha1.BookletModuleRef{{Name: "interaction", Required: true}, {Name: "physics", Required: true}},
+		},
+	}
+	// interaction still requires the capability under its old id.
+	interaction := &v1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "interaction", Namespace: "default"},
+		Spec: v1alpha1.ModuleManifestSpec{
+			Module: v1alpha1.ModuleIdentity{ID: "interaction", Version: "1.0.0"},
+			Requires: []v1alpha1.RequiredCapability{
+				{CapabilityID: "physics.core", VersionConstraint: "^1.0.0", Scope: v1alpha1.CapabilityScopeWorld, Multiplicity: v1alpha1.MultiplicityOne, DependencyMode: v1alpha1.DependencyModeRequired},
+			},
+		},
+	}
+	physics := &v1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "physics", Namespace: "default"},
+		Spec: v1alpha1.ModuleManifestSpec{
+			Module: v1alpha1.ModuleIdentity{ID: "physics", Version: "1.0.0"},
+			Provides: []v1alpha1.ProvidedCapability{
+				{CapabilityID: "physics.engine", Version: "1.2.0", Scope: v1alpha1.CapabilityScopeWorld, Multiplicity: v1alpha1.MultiplicityOne},
+			},
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, game, interaction, physics).WithStatusSubresource(world).Build()
+	r := &CapabilityResolverReconciler{
+		Client:            cl,
+		Scheme:            scheme,
+		Resolver:          resolver.NewDefault(),
+		CapabilityAliases: map[string]string{"physics.core": "physics.engine"},
+	}
+	key := types.NamespacedName{Namespace: "default", Name: "world-1"}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	var binding v1alpha1.CapabilityBinding
+	bindingKey := types.NamespacedName{Namespace: "default", Name: stableBindingName("world-1", "interaction", "physics.core", v1alpha1.CapabilityScopeWorld, v1alpha1.MultiplicityOne)}
+	if err := cl.Get(ctx, bindingKey, &binding); err != nil {
+		t.Fatalf("expected aliased binding: %v", err)
+	}
+	if binding.Spec.Provider.ModuleManifestName != "physics" || binding.Spec.CapabilityID != "physics.core" {
+		t.Fatalf("expected physics.core bound to physics, got %s -> %+v", binding.Spec.CapabilityID, binding.Spec.Provider)
+	}
+}
+
+func TestCapabilityResolverReconcile_PreferExistingKeepsBoundProvider(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+
+	physics := func(name, version string) *v1alpha1.ModuleManifest {
+		return &v1alpha1.ModuleManifest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: v1alpha1.ModuleManifestSpec{
+				Module: v1alpha1.ModuleIdentity{ID: name, Version: version},
+				Provides: []v1alpha1.ProvidedCapability{
+					{CapabilityID: "physics.engine", Version: version, Scope: v1alpha1.CapabilityScopeWorld, Multiplicity: v1alpha1.MultiplicityOne},
+				},
+			},
+		}
+	}
+	world := &v1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default", UID: types.UID("world-uid")},
+		Spec:       v1alpha1.WorldInstanceSpec{GameRef: v1alpha1.ObjectRef{Name: "game"}, WorldID: "w1", ShardCount: 1},
+	}
+	game := &v1alpha1.Booklet{
+		ObjectMeta: metav1.ObjectMeta{Name: "game", Namespace: "default"},
+		Spec: v1alpha1.BookletSpec{
+			GameID:  "g",
+			Version: "0.1.0",
+			Modules: []v1alpha1.BookletModuleRef{{Name: "interaction", Required: true}, {Name: "physics-a", Required: true}, {Name: "physics-b"}},
+		},
+	}
+	interaction := &v1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "interaction", Namespace: "default"},
+		Spec: v1alpha1.ModuleManifestSpec{
+			Module: v1alpha1.ModuleIdentity{ID: "interaction", Version: "1.0.0"},
+			Requires: []v1alpha1.RequiredCapability{
+				{CapabilityID: "physics.engine", VersionConstraint: "^1.0.0", Scope: v1alpha1.CapabilityScopeWorld, Multiplicity: v1alpha1.MultiplicityOne, DependencyMode: v1alpha1.DependencyModeRequired},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		preferExisting bool
+		wantProvider   string
+	}{
+		{preferExisting: true, wantProvider: "physics-a"},
+		{preferExisting: false, wantProvider: "physics-b"},
+	} {
+		cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world.DeepCopy(), game, interaction, physics("physics-a", "1.0.0")).WithStatusSubresource(world).Build()
+		r := &CapabilityResolverReconciler{Client: cl, Scheme: scheme, Resolver: resolver.NewDefault(), PreferExisting: tc.preferExisting}
+		key := types.NamespacedName{Namespace: "default", Name: "world-1"}
+		bindingKey := types.NamespacedName{Namespace: "default", Name: stableBindingName("world-1", "interaction", "physics.engine", v1alpha1.CapabilityScopeWorld, v1alpha1.MultiplicityOne)}
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("Reconcile: %v", err)
+		}
+
+		// A newer provider joins the booklet after physics-a was bound.
+		if err := cl.Create(ctx, physics("physics-b", "1.5.0")); err != nil {
+			t.Fatalf("create physics-b: %v", err)
+		}
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("Reconcile: %v", err)
+		}
+		var binding v1alpha1.CapabilityBinding
+		if err := cl.Get(ctx, bindingKey, &binding); err != nil {
+			t.Fatalf("get binding: %v", err)
+		}
+		if binding.Spec.Provider.ModuleManifestName != tc.wantProvider {
+			t.Fatalf("preferExisting=%v: expected provider %s, got %+v", tc.preferExisting, tc.wantProvider, binding.Spec.Provider)
+		}
+	}
+}
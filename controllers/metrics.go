@@ -1,7 +1,10 @@
 package controllers
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
@@ -20,6 +23,21 @@ var (
 		},
 		[]string{"controller"},
 	)
+	binderyControllerReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "bindery_controller_reconcile_duration_seconds",
+			Help:    "Time taken by a single reconciliation, by controller.",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"controller"},
+	)
+	binderyControllerRequeueTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "bindery_controller_requeue_total",
+			Help: "Number of reconciliations that asked to be requeued (excluding errors), by controller.",
+		},
+		[]string{"controller"},
+	)
 
 	capabilityResolverUnresolvedRequired = prometheus.NewGauge(
 		prometheus.GaugeOpts{
@@ -55,6 +73,14 @@ var (
 		},
 	)
 
+	runtimeOrchestratorWorldStartupDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "bindery_runtimeorchestrator_world_startup_duration_seconds",
+			Help:    "Time from WorldInstance creation to RuntimeReady first becoming True, observed once per world.",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+		},
+	)
+
 	runtimeOrchestratorDeploymentDuration = prometheus.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "bindery_runtimeorchestrator_deployment_duration_seconds",
@@ -68,11 +94,28 @@ func init() {
 	metrics.Registry.MustRegister(
 		binderyControllerReconcileTotal,
 		binderyControllerReconcileErrorTotal,
+		binderyControllerReconcileDuration,
+		binderyControllerRequeueTotal,
 		capabilityResolverUnresolvedRequired,
 		capabilityResolverBindingsCreatedTotal,
 		capabilityResolverBindingsUpdatedTotal,
 		capabilityResolverBindingsDeletedTotal,
 		capabilityResolverResolutionDuration,
 		runtimeOrchestratorDeploymentDuration,
+		runtimeOrchestratorWorldStartupDuration,
 	)
 }
+
+// observeReconcile records the reconcile duration and, when the result asks
+// for it, a requeue. Call it deferred with the named results of Reconcile:
+//
+//	defer observeReconcile("Foo", time.Now(), &res, &err)
+func observeReconcile(controller string, start time.Time, res *ctrl.Result, err *error) {
+	binderyControllerReconcileDuration.WithLabelValues(controller).Observe(time.Since(start).Seconds())
+	if err != nil && *err != nil {
+		return
+	}
+	if res != nil && (res.Requeue || res.RequeueAfter > 0) {
+		binderyControllerRequeueTotal.WithLabelValues(controller).Inc()
+	}
+}
@@ -0,0 +1,114 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	binderyv1alpha1 "github.com/bayleafwalker/bindery-core/api/v1alpha1"
+)
+
+func reconcileDurationSampleCount(t *testing.T, controller string) uint64 {
+	t.Helper()
+	var m dto.Metric
+	if err := binderyControllerReconcileDuration.WithLabelValues(controller).(prometheus.Metric).Write(&m); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestReconcileDurationObservedPerReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	if err := binderyv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme(game): %v", err)
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := &WorldShardReconciler{Client: cl, Scheme: scheme}
+
+	before := reconcileDurationSampleCount(t, "WorldShard")
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "missing"}}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if got := reconcileDurationSampleCount(t, "WorldShard"); got != before+1 {
+		t.Fatalf("expected %d duration samples, got %d", before+1, got)
+	}
+}
+
+func TestObserveReconcileCountsRequeues(t *testing.T) {
+	counter := func() float64 {
+		var m dto.Metric
+		if err := binderyControllerRequeueTotal.WithLabelValues("test").Write(&m); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+		return m.GetCounter().GetValue()
+	}
+
+	before := counter()
+	res := ctrl.Result{Requeue: true}
+	var err error
+	observeReconcile("test", time.Now(), &res, &err)
+	res = ctrl.Result{}
+	observeReconcile("test", time.Now(), &res, &err)
+	if got := counter(); got != before+1 {
+		t.Fatalf("expected requeue counter %v, got %v", before+1, got)
+	}
+}
+
+func TestWorldStartupDurationObservedOnceOnRuntimeReady(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default", CreationTimestamp: metav1.NewTime(time.Now().Add(-30 * time.Second))},
+		Spec:       binderyv1alpha1.WorldInstanceSpec{WorldID: "w1", ShardCount: 1},
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world).WithStatusSubresource(world).Build()
+	r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme}
+
+	samples := func() (uint64, float64) {
+		var m dto.Metric
+		if err := runtimeOrchestratorWorldStartupDuration.Write(&m); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+		return m.GetHistogram().GetSampleCount(), m.GetHistogram().GetSampleSum()
+	}
+
+	beforeCount, beforeSum := samples()
+	// A world without server workloads is RuntimeReady straight away.
+	for i := 0; i < 2; i++ {
+		if err := r.updateWorldRuntimeReadyCondition(ctx, "default", world); err != nil {
+			t.Fatalf("updateWorldRuntimeReadyCondition: %v", err)
+		}
+	}
+	count, sum := samples()
+	if count != beforeCount+1 {
+		t.Fatalf("expected exactly one startup observation, got %d", count-beforeCount)
+	}
+	if d := sum - beforeSum; d < 29 || d > 60 {
+		t.Fatalf("expected an observation of about 30s, got %v", d)
+	}
+
+	var got binderyv1alpha1.WorldInstance
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "world-1"}, &got); err != nil {
+		t.Fatalf("get world: %v", err)
+	}
+	if got.Status.RuntimeReadyTime == nil {
+		t.Fatalf("expected status.runtimeReadyTime to be recorded")
+	}
+}
@@ -0,0 +1,202 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	binderyv1alpha1 "github.com/bayleafwalker/bindery-core/api/v1alpha1"
+	"github.com/bayleafwalker/bindery-core/internal/resolver"
+)
+
+// ModuleManifestReconciler reports whether each ModuleManifest's requirements can be
+// satisfied by the manifests installed in its namespace.
+//
+// It never creates bindings; it only projects compatibility into status so operators
+// can spot missing dependencies before a world is created. Installing, changing, or
+// removing a provider can change every consumer alongside it, so each reconcile
+// refreshes all manifests in the request's namespace.
+//
+// RBAC:
+// +kubebuilder:rbac:groups=bindery.platform,resources=modulemanifests,verbs=get;list;watch
+// +kubebuilder:rbac:groups=bindery.platform,resources=modulemanifests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=bindery.platform,resources=realms,verbs=get;list;watch
+type ModuleManifestReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	// CapabilityAliases declares equivalent capability ids, as for the CapabilityResolver.
+	CapabilityAliases map[string]string
+	// Options tunes worker concurrency and requeue backoff.
+	Options ControllerOptions
+}
+
+func (r *ModuleManifestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, err error) {
+	defer observeReconcile("ModuleManifest", time.Now(), &res, &err)
+
+	var installed binderyv1alpha1.ModuleManifestList
+	if err := r.List(ctx, &installed, client.InNamespace(req.Namespace)); err != nil {
+		return ctrl.Result{}, err
+	}
+	realmModules, err := r.loadRealmModules(ctx, req.Namespace, installed.Items)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	in := resolver.Input{
+		Modules:           installed.Items,
+		RealmModules:      realmModules,
+		CapabilityAliases: r.CapabilityAliases,
+	}
+
+	for i := range installed.Items {
+		if err := r.updateStatus(ctx, &installed.Items[i], in); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+// updateStatus checks mm's requirements against in and records the result.
+func (r *ModuleManifestReconciler) updateStatus(ctx context.Context, mm *binderyv1alpha1.ModuleManifest, in resolver.Input) error {
+	logger := log.FromContext(ctx).WithValues("controller", "ModuleManifest", "modulemanifest", client.ObjectKeyFromObject(mm).String())
+
+	checks := resolver.CheckRequirements(*mm, in)
+	phase, message := moduleManifestPhase(checks)
+	var requirements []binderyv1alpha1.RequirementStatus
+	for _, c := range checks {
+		requirements = append(requirements, c.RequirementStatus)
+	}
+
+	prevPhase := mm.Status.Phase
+	next := mm.Status.DeepCopy()
+	next.ObservedGeneration = mm.Generation
+	next.Phase = phase
+	next.Message = message
+	next.Requirements = requirements
+	if equality.Semantic.DeepEqual(&mm.Status, next) {
+		return nil
+	}
+
+	mm.Status = *next
+	if err := r.Status().Update(ctx, mm); err != nil {
+		return err
+	}
+	logger.V(1).Info("updated requirement status", "phase", phase)
+
+	if prevPhase != phase && phase != binderyv1alpha1.ModuleManifestPhaseResolved {
+		r.event(mm, corev1.EventTypeWarning, "RequirementsUnsatisfied", "%s", message)
+	}
+	return nil
+}
+
+// loadRealmModules returns, for every Realm named by a requirement's realmRef, the
+// installed manifests that Realm lists. A missing Realm offers no modules.
+func (r *ModuleManifestReconciler) loadRealmModules(ctx context.Context, namespace string, installed []binderyv1alpha1.ModuleManifest) (map[string][]binderyv1alpha1.ModuleManifest, error) {
+	byName := make(map[string]binderyv1alpha1.ModuleManifest, len(installed))
+	for _, mm := range installed {
+		byName[mm.Name] = mm
+	}
+
+	out := map[string][]binderyv1alpha1.ModuleManifest{}
+	for _, mm := range installed {
+		for _, req := range mm.Spec.Requires {
+			if req.RealmRef == nil || req.RealmRef.Name == "" {
+				continue
+			}
+			name := req.RealmRef.Name
+			if _, ok := out[name]; ok {
+				continue
+			}
+			var realm binderyv1alpha1.Realm
+			if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &realm); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return nil, err
+				}
+			}
+			mods := []binderyv1alpha1.ModuleManifest{}
+			for _, mod := range realm.Spec.Modules {
+				if m, ok := byName[mod.Name]; ok {
+					mods = append(mods, m)
+				}
+			}
+			out[name] = mods
+		}
+	}
+	return out, nil
+}
+
+// moduleManifestPhase summarizes requirement checks. Only required dependencies
+// that cannot be satisfied keep a manifest out of Resolved.
+func moduleManifestPhase(checks []resolver.RequirementCheck) (string, string) {
+	var invalid, missing []string
+	for _, c := range checks {
+		if c.Satisfied || c.DependencyMode == binderyv1alpha1.DependencyModeOptional {
+			continue
+		}
+		if errors.Is(c.Err, resolver.ErrInvalidVersionConstraint) {
+			invalid = append(invalid, c.CapabilityID)
+			continue
+		}
+		missing = append(missing, c.CapabilityID)
+	}
+
+	switch {
+	case len(invalid) > 0:
+		return binderyv1alpha1.ModuleManifestPhaseError, fmt.Sprintf("invalid versionConstraint for: %s", strings.Join(invalid, ", "))
+	case len(missing) > 0:
+		return binderyv1alpha1.ModuleManifestPhasePending, fmt.Sprintf("no compatible provider installed for: %s", strings.Join(missing, ", "))
+	default:
+		return binderyv1alpha1.ModuleManifestPhaseResolved, "all required capabilities have a compatible provider"
+	}
+}
+
+func (r *ModuleManifestReconciler) event(obj client.Object, eventType, reason, messageFmt string, args ...any) {
+	if r.Recorder == nil || obj == nil {
+		return
+	}
+	r.Recorder.Eventf(obj, eventType, reason, messageFmt, args...)
+}
+
+func (r *ModuleManifestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Reconcile refreshes the whole namespace, so a manifest change needs no fan-out.
+	// A Realm change enqueues the manifests it lists (before and after the change).
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("modulemanifest").
+		For(&binderyv1alpha1.ModuleManifest{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(
+			&binderyv1alpha1.Realm{},
+			handler.EnqueueRequestsFromMapFunc(realmModuleRequests),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		WithOptions(r.Options.controllerOptions()).
+		Complete(r)
+}
+
+// realmModuleRequests maps a Realm to the ModuleManifests it lists.
+func realmModuleRequests(_ context.Context, obj client.Object) []reconcile.Request {
+	realm, ok := obj.(*binderyv1alpha1.Realm)
+	if !ok {
+		return nil
+	}
+	out := make([]reconcile.Request, 0, len(realm.Spec.Modules))
+	for _, mod := range realm.Spec.Modules {
+		out = append(out, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: realm.Namespace, Name: mod.Name}})
+	}
+	return out
+}
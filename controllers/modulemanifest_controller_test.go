@@ -0,0 +1,192 @@
+package controllers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bayleafwalker/bindery-core/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestModuleManifestReconcile_ReportsUnsatisfiableRequirement(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+
+	physics := &v1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "physics", Namespace: "default"},
+		Spec: v1alpha1.ModuleManifestSpec{
+			Module: v1alpha1.ModuleIdentity{ID: "physics", Version: "1.0.0"},
+			Provides: []v1alpha1.ProvidedCapability{{
+				CapabilityID: "physics.engine",
+				Version:      "1.0.0",
+				Scope:        v1alpha1.CapabilityScopeWorld,
+				Multiplicity: v1alpha1.MultiplicityOne,
+			}},
+		},
+	}
+	consumer := &v1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "interaction", Namespace: "default", Generation: 3},
+		Spec: v1alpha1.ModuleManifestSpec{
+			Module: v1alpha1.ModuleIdentity{ID: "interaction", Version: "0.1.0"},
+			Requires: []v1alpha1.RequiredCapability{
+				{
+					CapabilityID:      "physics.engine",
+					VersionConstraint: "^1.0.0",
+					Scope:             v1alpha1.CapabilityScopeWorld,
+					DependencyMode:    v1alpha1.DependencyModeRequired,
+				},
+				{
+					CapabilityID:      "audio.mixer",
+					VersionConstraint: ">=0.1.0",
+					Scope:             v1alpha1.CapabilityScopeWorld,
+					DependencyMode:    v1alpha1.DependencyModeRequired,
+				},
+			},
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(physics, consumer).WithStatusSubresource(physics, consumer).Build()
+	rec := record.NewFakeRecorder(10)
+	r := &ModuleManifestReconciler{Client: cl, Scheme: scheme, Recorder: rec}
+
+	key := types.NamespacedName{Namespace: "default", Name: "interaction"}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	var got v1alpha1.ModuleManifest
+	if err := cl.Get(ctx, key, &got); err != nil {
+		t.Fatalf("get manifest: %v", err)
+	}
+	if got.Status.Phase != v1alpha1.ModuleManifestPhasePending {
+		t.Fatalf("expected phase Pending, got %q (%s)", got.Status.Phase, got.Status.Message)
+	}
+	if got.Status.ObservedGeneration != 3 {
+		t.Fatalf("expected observedGeneration 3, got %d", got.Status.ObservedGeneration)
+	}
+	if len(got.Status.Requirements) != 2 {
+		t.Fatalf("expected 2 requirement statuses, got %+v", got.Status.Requirements)
+	}
+	if rs := got.Status.Requirements[0]; !rs.Satisfied || rs.Provider != "physics" {
+		t.Fatalf("expected physics.engine satisfied by physics, got %+v", rs)
+	}
+	if rs := got.Status.Requirements[1]; rs.Satisfied || rs.CapabilityID != "audio.mixer" || rs.Reason == "" {
+		t.Fatalf("expected audio.mixer listed as unsatisfied with a reason, got %+v", rs)
+	}
+
+	select {
+	case e := <-rec.Events:
+		if !strings.HasPrefix(e, "Warning RequirementsUnsatisfied") {
+			t.Fatalf("unexpected event %q", e)
+		}
+	default:
+		t.Fatalf("expected RequirementsUnsatisfied event")
+	}
+
+	// A manifest with no requirements resolves trivially.
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "physics"}}); err != nil {
+		t.Fatalf("Reconcile physics: %v", err)
+	}
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "physics"}, &got); err != nil {
+		t.Fatalf("get physics: %v", err)
+	}
+	if got.Status.Phase != v1alpha1.ModuleManifestPhaseResolved {
+		t.Fatalf("expected physics Resolved, got %q", got.Status.Phase)
+	}
+}
+
+func TestModuleManifestReconcile_MatchesRealmRefAndAliasesAcrossNamespace(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+
+	physics := &v1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "physics", Namespace: "default"},
+		Spec: v1alpha1.ModuleManifestSpec{
+			Module: v1alpha1.ModuleIdentity{ID: "physics", Version: "1.0.0"},
+			Provides: []v1alpha1.ProvidedCapability{{
+				CapabilityID: "physics.engine",
+				Version:      "1.0.0",
+				Scope:        v1alpha1.CapabilityScopeWorld,
+				Multiplicity: v1alpha1.MultiplicityOne,
+			}},
+		},
+	}
+	consumer := &v1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "interaction", Namespace: "default"},
+		Spec: v1alpha1.ModuleManifestSpec{
+			Module: v1alpha1.ModuleIdentity{ID: "interaction", Version: "0.1.0"},
+			Requires: []v1alpha1.RequiredCapability{
+				{
+					CapabilityID:      "physics.core",
+					VersionConstraint: "^1.0.0",
+					Scope:             v1alpha1.CapabilityScopeWorld,
+					DependencyMode:    v1alpha1.DependencyModeRequired,
+				},
+				{
+					CapabilityID:      "physics.engine",
+					VersionConstraint: "^1.0.0",
+					Scope:             v1alpha1.CapabilityScopeWorld,
+					DependencyMode:    v1alpha1.DependencyModeRequired,
+					RealmRef:          &v1alpha1.ObjectRef{Name: "shared"},
+				},
+			},
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(physics, consumer).WithStatusSubresource(physics, consumer).Build()
+	r := &ModuleManifestReconciler{
+		Client:            cl,
+		Scheme:            scheme,
+		CapabilityAliases: map[string]string{"physics.core": "physics.engine"},
+	}
+
+	// Reconciling the provider refreshes its consumer too.
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "physics"}}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	key := types.NamespacedName{Namespace: "default", Name: "interaction"}
+	var got v1alpha1.ModuleManifest
+	if err := cl.Get(ctx, key, &got); err != nil {
+		t.Fatalf("get manifest: %v", err)
+	}
+	if got.Status.Phase != v1alpha1.ModuleManifestPhasePending || len(got.Status.Requirements) != 2 {
+		t.Fatalf("expected Pending with 2 requirement statuses, got %q %+v", got.Status.Phase, got.Status.Requirements)
+	}
+	if rs := got.Status.Requirements[0]; !rs.Satisfied || rs.Provider != "physics" {
+		t.Fatalf("expected aliased physics.core satisfied by physics, got %+v", rs)
+	}
+	if rs := got.Status.Requirements[1]; rs.Satisfied {
+		t.Fatalf("expected realmRef requirement unsatisfied without the Realm, got %+v", rs)
+	}
+
+	realm := &v1alpha1.Realm{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "default"},
+		Spec:       v1alpha1.RealmSpec{Modules: []v1alpha1.RealmModule{{Name: "physics"}}},
+	}
+	if err := cl.Create(ctx, realm); err != nil {
+		t.Fatalf("create realm: %v", err)
+	}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if err := cl.Get(ctx, key, &got); err != nil {
+		t.Fatalf("get manifest: %v", err)
+	}
+	if got.Status.Phase != v1alpha1.ModuleManifestPhaseResolved {
+		t.Fatalf("expected Resolved once the Realm lists physics, got %q (%s)", got.Status.Phase, got.Status.Message)
+	}
+}
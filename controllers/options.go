@@ -0,0 +1,108 @@
+package controllers
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Defaults for ControllerOptions. The per-item backoff starts at the workqueue
+// default but is capped at five minutes rather than ~16 minutes, so a world that
+// hot-loops on errors backs off quickly without being parked for too long.
+const (
+	defaultBackoffBase = 5 * time.Millisecond
+	defaultBackoffMax  = 5 * time.Minute
+
+	// Overall queue limits, matching the controller-runtime default.
+	defaultQueueQPS   = 10
+	defaultQueueBurst = 100
+)
+
+// ControllerOptions tunes concurrency and requeue backoff for a single controller.
+//
+// The zero value keeps the controller-runtime defaults (one worker, default rate limiter).
+type ControllerOptions struct {
+	// MaxConcurrentReconciles is the number of workers. Zero uses the manager default (1).
+	MaxConcurrentReconciles int
+	// BackoffBase is the first per-item retry delay; it doubles on each failure.
+	BackoffBase time.Duration
+	// BackoffMax caps the per-item retry delay.
+	BackoffMax time.Duration
+}
+
+// DefaultControllerOptions returns options with the exponential backoff defaults set.
+func DefaultControllerOptions() ControllerOptions {
+	return ControllerOptions{
+		BackoffBase: defaultBackoffBase,
+		BackoffMax:  defaultBackoffMax,
+	}
+}
+
+// BindFlags registers -<prefix>-max-concurrent-reconciles, -<prefix>-backoff-base, and
+// -<prefix>-backoff-max on fs, using the current values as defaults.
+func (o *ControllerOptions) BindFlags(fs *flag.FlagSet, prefix string) {
+	fs.IntVar(&o.MaxConcurrentReconciles, prefix+"-max-concurrent-reconciles", o.MaxConcurrentReconciles,
+		"Maximum concurrent reconciles for the "+prefix+" controller (0 uses the manager default).")
+	fs.DurationVar(&o.BackoffBase, prefix+"-backoff-base", o.BackoffBase,
+		"Initial per-item requeue backoff for the "+prefix+" controller.")
+	fs.DurationVar(&o.BackoffMax, prefix+"-backoff-max", o.BackoffMax,
+		"Maximum per-item requeue backoff for the "+prefix+" controller.")
+}
+
+// controllerOptions converts o into controller-runtime options for builder.WithOptions.
+func (o ControllerOptions) controllerOptions() controller.Options {
+	opts := controller.Options{MaxConcurrentReconciles: o.MaxConcurrentReconciles}
+	if o.BackoffBase <= 0 && o.BackoffMax <= 0 {
+		return opts
+	}
+	base, limit := o.BackoffBase, o.BackoffMax
+	if base <= 0 {
+		base = defaultBackoffBase
+	}
+	if limit <= 0 {
+		limit = defaultBackoffMax
+	}
+	if limit < base {
+		limit = base
+	}
+	opts.RateLimiter = workqueue.NewTypedMaxOfRateLimiter(
+		workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](base, limit),
+		&workqueue.TypedBucketRateLimiter[reconcile.Request]{Limiter: rate.NewLimiter(rate.Limit(defaultQueueQPS), defaultQueueBurst)},
+	)
+	return opts
+}
+
+// CapabilityAliasFlag is a flag.Value collecting capability aliases as "old=new" pairs,
+// comma-separated or given by repeating the flag. See resolver.Input.CapabilityAliases.
+type CapabilityAliasFlag map[string]string
+
+func (f CapabilityAliasFlag) String() string {
+	pairs := make([]string, 0, len(f))
+	for from, to := range f {
+		pairs = append(pairs, from+"="+to)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func (f CapabilityAliasFlag) Set(value string) error {
+	for _, pair := range strings.Split(value, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		from, to, ok := strings.Cut(pair, "=")
+		from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+		if !ok || from == "" || to == "" {
+			return fmt.Errorf("capability alias %q is not of the form old=new", pair)
+		}
+		f[from] = to
+	}
+	return nil
+}
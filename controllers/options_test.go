@@ -0,0 +1,81 @@
+package controllers
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestControllerOptions_ZeroValueKeepsDefaults(t *testing.T) {
+	opts := ControllerOptions{}.controllerOptions()
+	if opts.MaxConcurrentReconciles != 0 {
+		t.Fatalf("expected manager default concurrency, got %d", opts.MaxConcurrentReconciles)
+	}
+	if opts.RateLimiter != nil {
+		t.Fatalf("expected controller-runtime default rate limiter")
+	}
+}
+
+func TestControllerOptions_ExponentialBackoffIsCapped(t *testing.T) {
+	o := ControllerOptions{MaxConcurrentReconciles: 4, BackoffBase: 100 * time.Millisecond, BackoffMax: time.Second}
+	opts := o.controllerOptions()
+	if opts.MaxConcurrentReconciles != 4 {
+		t.Fatalf("expected 4 workers, got %d", opts.MaxConcurrentReconciles)
+	}
+	if opts.RateLimiter == nil {
+		t.Fatalf("expected a rate limiter")
+	}
+
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "world-1"}}
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second}
+	for i, w := range want {
+		if got := opts.RateLimiter.When(req); got != w {
+			t.Fatalf("retry %d: expected %v, got %v", i, w, got)
+		}
+	}
+
+	opts.RateLimiter.Forget(req)
+	if got := opts.RateLimiter.When(req); got != 100*time.Millisecond {
+		t.Fatalf("expected backoff to reset after Forget, got %v", got)
+	}
+}
+
+func TestControllerOptions_BindFlags(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	o := DefaultControllerOptions()
+	o.BindFlags(fs, "capabilityresolver")
+	if err := fs.Parse([]string{
+		"-capabilityresolver-max-concurrent-reconciles=8",
+		"-capabilityresolver-backoff-base=50ms",
+		"-capabilityresolver-backoff-max=30s",
+	}); err != nil {
+		t.Fatalf("parse flags: %v", err)
+	}
+	if o.MaxConcurrentReconciles != 8 || o.BackoffBase != 50*time.Millisecond || o.BackoffMax != 30*time.Second {
+		t.Fatalf("flags not applied: %+v", o)
+	}
+
+	unset := DefaultControllerOptions()
+	unset.BindFlags(flag.NewFlagSet("test", flag.ContinueOnError), "realm")
+	if unset.BackoffBase != defaultBackoffBase || unset.BackoffMax != defaultBackoffMax {
+		t.Fatalf("expected defaults to survive flag registration, got %+v", unset)
+	}
+}
+
+func TestCapabilityAliasFlag_ParsesPairs(t *testing.T) {
+	aliases := CapabilityAliasFlag{}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(aliases, "capability-alias", "")
+	if err := fs.Parse([]string{"-capability-alias", "physics.core=physics.engine, chat.v1=chat", "-capability-alias", "a=b"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if got := aliases.String(); got != "a=b,chat.v1=chat,physics.core=physics.engine" {
+		t.Fatalf("unexpected aliases %q", got)
+	}
+	if err := aliases.Set("physics.core"); err == nil {
+		t.Fatalf("expected an error for a pair without '='")
+	}
+}
@@ -0,0 +1,23 @@
+package controllers
+
+import (
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WorldResourceSelector returns the labels that select what the controllers
+// create for world: shards, bindings, Services, workloads, and storage. A
+// non-empty shard (WorldShard spec.shardId) or module (ModuleManifest name)
+// narrows the selection. Bindings and shards carry no module label, so pass
+// an empty module when listing them. See cmd/bindery-inspect.
+func WorldResourceSelector(world, shard, module string) client.MatchingLabels {
+	sel := client.MatchingLabels{rtLabelWorldName: strings.TrimSpace(world)}
+	if shard = strings.TrimSpace(shard); shard != "" {
+		sel[labelShardID] = shard
+	}
+	if module = strings.TrimSpace(module); module != "" {
+		sel[rtLabelModule] = module
+	}
+	return sel
+}
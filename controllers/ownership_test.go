@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"reflect"
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestWorldResourceSelector(t *testing.T) {
+	cases := []struct {
+		name                 string
+		world, shard, module string
+		want                 client.MatchingLabels
+	}{
+		{"world only", "world-1", "", "", client.MatchingLabels{rtLabelWorldName: "world-1"}},
+		{"shard", "world-1", "2", "", client.MatchingLabels{rtLabelWorldName: "world-1", labelShardID: "2"}},
+		{"module", "world-1", " ", "physics", client.MatchingLabels{rtLabelWorldName: "world-1", rtLabelModule: "physics"}},
+		{"all", " world-1 ", "0", "physics", client.MatchingLabels{rtLabelWorldName: "world-1", labelShardID: "0", rtLabelModule: "physics"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := WorldResourceSelector(tc.world, tc.shard, tc.module); !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+
+	// The selector must match the labels the controllers actually stamp.
+	if labelWorldName != rtLabelWorldName {
+		t.Fatalf("world label drifted: %q vs %q", labelWorldName, rtLabelWorldName)
+	}
+}
@@ -0,0 +1,56 @@
+package controllers
+
+import (
+	"os"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// envPropagateMetadataPrefixes lists label/annotation key prefixes (comma-separated,
+// e.g. "example.com/,team") that the RuntimeOrchestrator copies from a ModuleManifest
+// onto the Deployments, StatefulSets, Services, and storage it generates for the
+// module. Unset disables propagation.
+const envPropagateMetadataPrefixes = "BINDERY_PROPAGATE_METADATA_PREFIXES"
+
+// reservedMetadataPrefix marks the controllers' own labels, which are never
+// copied so a manifest cannot impersonate or override management labels.
+const reservedMetadataPrefix = "bindery.platform/"
+
+func propagatedMetadataPrefixes() []string {
+	return parseCSV(os.Getenv(envPropagateMetadataPrefixes))
+}
+
+// propagatedMetadata returns the entries of src whose key matches one of prefixes.
+func propagatedMetadata(src map[string]string, prefixes []string) map[string]string {
+	if len(src) == 0 || len(prefixes) == 0 {
+		return nil
+	}
+	var out map[string]string
+	for k, v := range src {
+		if strings.HasPrefix(k, reservedMetadataPrefix) {
+			continue
+		}
+		for _, p := range prefixes {
+			if strings.HasPrefix(k, p) {
+				if out == nil {
+					out = map[string]string{}
+				}
+				out[k] = v
+				break
+			}
+		}
+	}
+	return out
+}
+
+// applyPropagatedMetadata copies the matching labels and annotations of src onto
+// dst. Callers apply their own management labels afterwards, so those always win.
+func applyPropagatedMetadata(dst, src metav1.Object, prefixes []string) {
+	if labels := propagatedMetadata(src.GetLabels(), prefixes); len(labels) > 0 {
+		dst.SetLabels(mergeLabels(dst.GetLabels(), labels))
+	}
+	if annotations := propagatedMetadata(src.GetAnnotations(), prefixes); len(annotations) > 0 {
+		dst.SetAnnotations(mergeLabels(dst.GetAnnotations(), annotations))
+	}
+}
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"strings"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -26,10 +29,13 @@ const (
 // +kubebuilder:rbac:groups=bindery.platform,resources=realms,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=bindery.platform,resources=realms/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=bindery.platform,resources=capabilitybindings,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=bindery.platform,resources=modulemanifests,verbs=get;list;watch
 type RealmReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+	// Options tunes worker concurrency and requeue backoff.
+	Options ControllerOptions
 }
 
 func (r *RealmReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -42,9 +48,10 @@ func (r *RealmReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 
 	// For each module in the Realm spec, ensure a CapabilityBinding exists.
 	// These bindings are "root" bindings for the Realm scope.
+	desiredNames := make(map[string]struct{}, len(realm.Spec.Modules))
 	for _, mod := range realm.Spec.Modules {
-		bindingName := fmt.Sprintf("realm-%s-%s", realm.Name, mod.Name)
-		bindingName = strings.ToLower(bindingName)
+		bindingName := realmBindingName(realm.Name, mod.Name)
+		desiredNames[bindingName] = struct{}{}
 
 		// Ensure binding
 		binding := &binderyv1alpha1.CapabilityBinding{
@@ -84,14 +91,113 @@ func (r *RealmReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		}
 	}
 
-	// TODO: Garbage collect bindings for modules removed from Realm spec.
+	// Garbage-collect bindings for modules removed from the Realm spec. Realm-scoped
+	// workloads and Services are owned by their binding, so owner-reference GC
+	// removes them with it.
+	var existing binderyv1alpha1.CapabilityBindingList
+	if err := r.List(ctx, &existing,
+		client.InNamespace(realm.Namespace),
+		client.MatchingLabels{
+			rtLabelManagedBy:         realmManagedBy,
+			"bindery.platform/realm": realm.Name,
+		},
+	); err != nil {
+		logger.Error(err, "failed to list realm bindings")
+		return ctrl.Result{}, err
+	}
+	deletedCount := 0
+	for i := range existing.Items {
+		b := &existing.Items[i]
+		if _, ok := desiredNames[b.Name]; ok {
+			continue
+		}
+		if err := r.Delete(ctx, b); err != nil && !apierrors.IsNotFound(err) {
+			logger.Error(err, "failed to delete stale realm binding", "binding", b.Name)
+			return ctrl.Result{}, err
+		}
+		deletedCount++
+	}
+	if deletedCount > 0 {
+		logger.Info("garbage-collected stale realm bindings", "deleted", deletedCount)
+	}
+
+	if err := r.updateRealmReadyCondition(ctx, &realm); err != nil {
+		logger.Error(err, "failed to update RealmReady condition")
+		return ctrl.Result{}, err
+	}
 
 	return ctrl.Result{}, nil
 }
 
+func realmBindingName(realmName, moduleName string) string {
+	return strings.ToLower(fmt.Sprintf("realm-%s-%s", realmName, moduleName))
+}
+
+// updateRealmReadyCondition sets RealmReady True once every server-orchestrated module in
+// the realm has a published endpoint with at least one serving replica behind it.
+func (r *RealmReconciler) updateRealmReadyCondition(ctx context.Context, realm *binderyv1alpha1.Realm) error {
+	total := 0
+	serving := 0
+	missingProviders := 0
+	for _, mod := range realm.Spec.Modules {
+		var mm binderyv1alpha1.ModuleManifest
+		if err := r.Get(ctx, types.NamespacedName{Namespace: realm.Namespace, Name: mod.Name}, &mm); err != nil {
+			if apierrors.IsNotFound(err) {
+				missingProviders++
+				total++
+				continue
+			}
+			return err
+		}
+		if !isServerOrchestrated(&mm) {
+			continue
+		}
+		total++
+
+		var b binderyv1alpha1.CapabilityBinding
+		if err := r.Get(ctx, types.NamespacedName{Namespace: realm.Namespace, Name: realmBindingName(realm.Name, mod.Name)}, &b); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if b.Status.Provider != nil && b.Status.Provider.Endpoint != nil &&
+			meta.IsStatusConditionTrue(b.Status.Conditions, BindingConditionEndpointServing) {
+			serving++
+		}
+	}
+
+	status := metav1.ConditionTrue
+	reason := "NoServerWorkloads"
+	message := "No server workloads required"
+	if total > 0 {
+		message = fmt.Sprintf("%d/%d realm modules serving", serving, total)
+		switch {
+		case missingProviders > 0:
+			status = metav1.ConditionFalse
+			reason = "ProviderNotFound"
+			message = fmt.Sprintf("%d provider ModuleManifest(s) missing; %s", missingProviders, message)
+		case serving < total:
+			status = metav1.ConditionFalse
+			reason = "WaitingForEndpoints"
+		default:
+			reason = "EndpointsServing"
+		}
+	}
+
+	prev := meta.FindStatusCondition(realm.Status.Conditions, RealmConditionReady)
+	if prev != nil && prev.Status == status && prev.Reason == reason && prev.Message == message && prev.ObservedGeneration == realm.Generation {
+		return nil
+	}
+	before := realm.DeepCopy()
+	setRealmCondition(realm, metav1.Condition{Type: RealmConditionReady, Status: status, Reason: reason, Message: message})
+	return r.Status().Patch(ctx, realm, client.MergeFrom(before))
+}
+
 func (r *RealmReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&binderyv1alpha1.Realm{}).
 		Owns(&binderyv1alpha1.CapabilityBinding{}).
+		WithOptions(r.Options.controllerOptions()).
 		Complete(r)
 }
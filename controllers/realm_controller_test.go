@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	binderyv1alpha1 "github.com/bayleafwalker/bindery-core/api/v1alpha1"
+)
+
+func TestRealmReconcile_RealmReadyWaitsForAllModules(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	realm := &binderyv1alpha1.Realm{
+		ObjectMeta: metav1.ObjectMeta{Name: "eu", Namespace: "default"},
+		Spec: binderyv1alpha1.RealmSpec{Modules: []binderyv1alpha1.RealmModule{
+			{Name: "chat", Version: "1.0.0"},
+			{Name: "matchmaker", Version: "1.0.0"},
+		}},
+	}
+	manifest := func(name string) *binderyv1alpha1.ModuleManifest {
+		return &binderyv1alpha1.ModuleManifest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       binderyv1alpha1.ModuleManifestSpec{Runtime: &binderyv1alpha1.ModuleRuntimeSpec{Image: "img"}},
+		}
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(realm, manifest("chat"), manifest("matchmaker")).
+		WithStatusSubresource(realm, &binderyv1alpha1.CapabilityBinding{}).
+		Build()
+	r := &RealmReconciler{Client: cl, Scheme: scheme}
+	reconcile := func() *metav1.Condition {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "eu"}}); err != nil {
+			t.Fatalf("Reconcile: %v", err)
+		}
+		var got binderyv1alpha1.Realm
+		if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "eu"}, &got); err != nil {
+			t.Fatalf("get realm: %v", err)
+		}
+		cond := meta.FindStatusCondition(got.Status.Conditions, RealmConditionReady)
+		if cond == nil {
+			t.Fatalf("expected %s condition", RealmConditionReady)
+		}
+		return cond
+	}
+	markServing := func(module string) {
+		t.Helper()
+		var b binderyv1alpha1.CapabilityBinding
+		if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: realmBindingName("eu", module)}, &b); err != nil {
+			t.Fatalf("get binding: %v", err)
+		}
+		b.Status.Provider = &binderyv1alpha1.ProviderStatus{Endpoint: &binderyv1alpha1.EndpointRef{Type: "kubernetesService", Value: module, Port: 50051}}
+		setBindingCondition(&b, metav1.Condition{Type: BindingConditionEndpointServing, Status: metav1.ConditionTrue, Reason: "ReplicasAvailable"})
+		if err := cl.Status().Update(ctx, &b); err != nil {
+			t.Fatalf("update binding status: %v", err)
+		}
+	}
+
+	if cond := reconcile(); cond.Status != metav1.ConditionFalse || !strings.Contains(cond.Message, "0/2") {
+		t.Fatalf("expected RealmReady=False with 0/2 serving, got %s %q", cond.Status, cond.Message)
+	}
+
+	markServing("chat")
+	cond := reconcile()
+	if cond.Status != metav1.ConditionFalse || cond.Reason != "WaitingForEndpoints" || !strings.Contains(cond.Message, "1/2") {
+		t.Fatalf("expected RealmReady=False/WaitingForEndpoints with 1/2 serving, got %s/%s %q", cond.Status, cond.Reason, cond.Message)
+	}
+
+	markServing("matchmaker")
+	if cond := reconcile(); cond.Status != metav1.ConditionTrue || !strings.Contains(cond.Message, "2/2") {
+		t.Fatalf("expected RealmReady=True with 2/2 serving, got %s %q", cond.Status, cond.Message)
+	}
+}
+
+func TestRealmReconcile_RemovedModuleBindingIsDeleted(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	realm := &binderyv1alpha1.Realm{
+		ObjectMeta: metav1.ObjectMeta{Name: "eu", Namespace: "default"},
+		Spec: binderyv1alpha1.RealmSpec{Modules: []binderyv1alpha1.RealmModule{
+			{Name: "chat", Version: "1.0.0"},
+			{Name: "matchmaker", Version: "1.0.0"},
+		}},
+	}
+	// A binding the realm controller does not manage must survive GC.
+	unmanaged := &binderyv1alpha1.CapabilityBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "realm-eu-legacy", Namespace: "default"},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(realm, unmanaged).
+		WithStatusSubresource(realm, &binderyv1alpha1.CapabilityBinding{}).
+		Build()
+	r := &RealmReconciler{Client: cl, Scheme: scheme}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "eu"}}
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	var got binderyv1alpha1.Realm
+	if err := cl.Get(ctx, req.NamespacedName, &got); err != nil {
+		t.Fatalf("get realm: %v", err)
+	}
+	got.Spec.Modules = got.Spec.Modules[:1]
+	if err := cl.Update(ctx, &got); err != nil {
+		t.Fatalf("update realm: %v", err)
+	}
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile after removal: %v", err)
+	}
+
+	var b binderyv1alpha1.CapabilityBinding
+	err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: realmBindingName("eu", "matchmaker")}, &b)
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected matchmaker binding to be deleted, got err=%v", err)
+	}
+	for _, name := range []string{realmBindingName("eu", "chat"), "realm-eu-legacy"} {
+		if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: name}, &b); err != nil {
+			t.Fatalf("expected binding %s to remain: %v", name, err)
+		}
+	}
+}
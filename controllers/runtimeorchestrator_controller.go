@@ -5,6 +5,9 @@ import (
 	"crypto/sha1"
 	"encoding/hex"
 	"fmt"
+	"net"
+	"net/url"
+	"os"
 	"regexp"
 	"sort"
 	"strconv"
@@ -21,10 +24,12 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	binderyv1alpha1 "github.com/bayleafwalker/bindery-core/api/v1alpha1"
@@ -55,6 +60,10 @@ const (
 
 var rtNonDNS = regexp.MustCompile(`[^a-z0-9-]+`)
 
+// dependencyServingRequeueInterval is how often a consumer is re-checked while one of
+// its dependency endpoints is published but not yet serving.
+const dependencyServingRequeueInterval = 5 * time.Second
+
 // RuntimeOrchestratorReconciler materializes runnable Kubernetes workloads for server-owned modules.
 //
 // MVP behavior:
@@ -73,6 +82,7 @@ var rtNonDNS = regexp.MustCompile(`[^a-z0-9-]+`)
 // +kubebuilder:rbac:groups=bindery.platform,resources=worldstorageclaims,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=bindery.platform,resources=worldstorageclaims/status,verbs=get
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch;update
 type RuntimeOrchestratorReconciler struct {
@@ -81,9 +91,12 @@ type RuntimeOrchestratorReconciler struct {
 	Recorder record.EventRecorder
 	// Name allows overriding the controller name (useful for tests to avoid global collisions).
 	Name string
+	// Options tunes worker concurrency and requeue backoff.
+	Options ControllerOptions
 }
 
-func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, err error) {
+	defer observeReconcile("RuntimeOrchestrator", time.Now(), &res, &err)
 	binderyControllerReconcileTotal.WithLabelValues("RuntimeOrchestrator").Inc()
 
 	logger := log.FromContext(ctx).WithValues(
@@ -189,7 +202,23 @@ func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 		return ctrl.Result{}, err
 	}
 
+	if providerMM.Spec.External != nil {
+		if err := r.publishExternalEndpoint(ctx, &binding, &providerMM); err != nil {
+			logger.Error(err, "failed to publish external endpoint")
+			binderyControllerReconcileErrorTotal.WithLabelValues("RuntimeOrchestrator").Inc()
+			return ctrl.Result{}, err
+		}
+		if !isGlobal {
+			if err := r.updateWorldRuntimeReadyCondition(ctx, req.Namespace, &world); err != nil {
+				logger.Error(err, "failed to update world RuntimeReady condition")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
 	runtimeSpec := providerMM.Spec.Runtime
+	metadataPrefixes := propagatedMetadataPrefixes()
 
 	image := ""
 	if runtimeSpec != nil {
@@ -228,9 +257,15 @@ func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 	// Determine colocation
 	var colocGroup *binderyv1alpha1.ColocationGroup
 	if !isGlobal {
-		colocGroup = getColocationGroup(&booklet, providerName)
+		colocGroup = r.colocationGroupFor(&booklet, providerName)
 	}
-	isColocPod := colocGroup != nil && colocGroup.Strategy == "Pod"
+	isColocPod := colocGroup != nil && colocGroup.Strategy == binderyv1alpha1.ColocationStrategyPod
+	// A consumer in the same udsOnly Pod group reaches the provider over the shared
+	// socket volume, so this binding needs no Service.
+	udsOnly := isColocPod && colocGroup.UDSOnly && inColocationGroup(&booklet, binding.Spec.Consumer.ModuleManifestName, colocGroup)
+	// Stateful modules get a StatefulSet behind a headless Service for stable identity.
+	// Pod-colocated groups share one Deployment regardless of member statefulness.
+	isStateful := !isColocPod && providerMM.Spec.Scaling.Statefulness == binderyv1alpha1.StatefulnessStateful
 
 	// Stable names shared by Service and Deployment.
 	// For Service, we always use the module-specific name.
@@ -273,6 +308,9 @@ func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 
 	// Optional persistent storage request driven by ModuleManifest annotations.
 	storageTierRaw := strings.TrimSpace(providerMM.Annotations[annStorageTier])
+	if storageTierRaw == "" {
+		storageTierRaw = string(defaultStorageTierForStatefulness(providerMM.Spec.Scaling.Statefulness))
+	}
 	storageSize := strings.TrimSpace(providerMM.Annotations[annStorageSize])
 	if storageSize == "" {
 		storageSize = "1Gi"
@@ -299,7 +337,7 @@ func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 		if tier == binderyv1alpha1.WorldStorageTierServerLowLatency || tier == binderyv1alpha1.WorldStorageTierServerHighLatency {
 			// Ensure claim exists; StorageOrchestrator will materialize the PVC.
 			claimName := stableWSCName(world.Name, shardName, storageTierRaw)
-			if err := r.ensureWorldStorageClaim(ctx, req.Namespace, &world, shardObj, claimName, scope, tier, storageSize, accessModes, shardLabel, shardName); err != nil {
+			if err := r.ensureWorldStorageClaim(ctx, req.Namespace, &world, shardObj, &providerMM, claimName, scope, tier, storageSize, accessModes, shardLabel, shardName); err != nil {
 				logger.Error(err, "failed to ensure world storage claim", "claim", claimName)
 				r.recordEventf(&binding, "Warning", "EnsureWorldStorageClaimFailed", "Failed to ensure WorldStorageClaim %q: %v", claimName, err)
 				return ctrl.Result{}, err
@@ -317,52 +355,90 @@ func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 		}
 	}
 
-	// 1) Ensure Service
-	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: req.Namespace}}
+	// 1) Ensure Service (skipped when the binding is served only over the Pod's UDS volume)
 	serviceOwner := controllerOwner(shardObj, &world, &binding, isGlobal)
-	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, service, func() error {
-		existingOwner := metav1.GetControllerOf(service)
-		if existingOwner != nil && (serviceOwner == nil || !metav1.IsControlledBy(service, serviceOwner)) {
-			logger.V(1).Info("service already owned by another controller; reusing", "service", serviceName, "owner", fmt.Sprintf("%s/%s", existingOwner.Kind, existingOwner.Name))
-			return nil
-		}
-
-		service.Labels = mergeLabels(service.Labels, serviceLabels)
-
-		// Selector logic
-		selector := map[string]string{
-			rtLabelManagedBy: rtManagedBy,
-		}
-		if !isGlobal {
-			selector[rtLabelWorldName] = world.Name
+	if udsOnly {
+		// A Service created before the group became udsOnly is removed once no
+		// consumer outside the group still dials it.
+		needed, err := r.serviceNeededOutsideGroup(ctx, &binding, &booklet, colocGroup, world.Name, shardLabel)
+		if err != nil {
+			return ctrl.Result{}, err
 		}
-		if shardLabel != "" {
-			selector[labelShardID] = shardLabel
+		if !needed {
+			svc, err := r.managedService(ctx, req.Namespace, serviceName, serviceOwner)
+			if err == nil && svc != nil {
+				logger.Info("deleting service for udsOnly binding", "service", serviceName)
+				err = client.IgnoreNotFound(r.Delete(ctx, svc))
+			}
+			if err != nil {
+				logger.Error(err, "failed to delete service for udsOnly binding", "service", serviceName)
+				return ctrl.Result{}, err
+			}
 		}
-		if isColocPod {
-			selector["bindery.platform/coloc-group"] = colocGroup.Name
-		} else {
-			selector[rtLabelModule] = providerName
+	} else {
+		service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: req.Namespace}}
+		if isStateful {
+			recreated, err := r.deleteNonHeadlessService(ctx, req.Namespace, serviceName, serviceOwner)
+			if err != nil {
+				logger.Error(err, "failed to replace non-headless service", "service", serviceName)
+				r.recordEventf(&binding, "Warning", "EnsureServiceFailed", "Failed to replace Service %q with a headless one: %v", serviceName, err)
+				return ctrl.Result{}, err
+			}
+			if recreated {
+				logger.Info("recreating service as headless for stateful module", "service", serviceName)
+				r.recordEventf(&binding, "Normal", "ServiceRecreated", "Recreating Service %q as headless for stateful module %q", serviceName, providerName)
+			}
 		}
-		service.Spec.Selector = selector
+		_, err = controllerutil.CreateOrUpdate(ctx, r.Client, service, func() error {
+			existingOwner := metav1.GetControllerOf(service)
+			if existingOwner != nil && (serviceOwner == nil || !metav1.IsControlledBy(service, serviceOwner)) {
+				logger.V(1).Info("service already owned by another controller; reusing", "service", serviceName, "owner", fmt.Sprintf("%s/%s", existingOwner.Kind, existingOwner.Name))
+				return nil
+			}
 
-		service.Spec.Type = corev1.ServiceTypeClusterIP
-		service.Spec.Ports = []corev1.ServicePort{{
-			Name:       "grpc",
-			Port:       port,
-			TargetPort: intstrFromInt32(port),
-			Protocol:   corev1.ProtocolTCP,
-		}}
-		if serviceOwner != nil {
-			return controllerutil.SetControllerReference(serviceOwner, service, r.Scheme)
+			applyPropagatedMetadata(service, &providerMM, metadataPrefixes)
+			service.Labels = mergeLabels(service.Labels, serviceLabels)
+
+			// Selector logic
+			selector := map[string]string{
+				rtLabelManagedBy: rtManagedBy,
+			}
+			if !isGlobal {
+				selector[rtLabelWorldName] = world.Name
+			}
+			if shardLabel != "" {
+				selector[labelShardID] = shardLabel
+			}
+			if isColocPod {
+				selector["bindery.platform/coloc-group"] = colocGroup.Name
+			} else {
+				selector[rtLabelModule] = providerName
+			}
+			service.Spec.Selector = selector
+
+			service.Spec.Type = corev1.ServiceTypeClusterIP
+			if isStateful && service.Spec.ClusterIP == "" {
+				// clusterIP is immutable; deleteNonHeadlessService removed any older
+				// Service with one, so this is a new Service.
+				service.Spec.ClusterIP = corev1.ClusterIPNone
+			}
+			service.Spec.Ports = []corev1.ServicePort{{
+				Name:       "grpc",
+				Port:       port,
+				TargetPort: intstrFromInt32(port),
+				Protocol:   corev1.ProtocolTCP,
+			}}
+			if serviceOwner != nil {
+				return controllerutil.SetControllerReference(serviceOwner, service, r.Scheme)
+			}
+			return nil
+		})
+		if err != nil {
+			logger.Error(err, "failed to ensure service", "service", serviceName)
+			r.recordEventf(&binding, "Warning", "EnsureServiceFailed", "Failed to ensure Service %q: %v", serviceName, err)
+			binderyControllerReconcileErrorTotal.WithLabelValues("RuntimeOrchestrator").Inc()
+			return ctrl.Result{}, err
 		}
-		return nil
-	})
-	if err != nil {
-		logger.Error(err, "failed to ensure service", "service", serviceName)
-		r.recordEventf(&binding, "Warning", "EnsureServiceFailed", "Failed to ensure Service %q: %v", serviceName, err)
-		binderyControllerReconcileErrorTotal.WithLabelValues("RuntimeOrchestrator").Inc()
-		return ctrl.Result{}, err
 	}
 
 	// 2) Ensure Deployment
@@ -384,22 +460,44 @@ func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 		preStopCommand = strings.TrimSpace(providerMM.Annotations[annPreStopCommand])
 	}
 
-	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: deploymentName, Namespace: req.Namespace}}
-	deploymentOwner := controllerOwner(shardObj, &world, &binding, isGlobal)
-	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, deployment, func() error {
-		existingOwner := metav1.GetControllerOf(deployment)
-		if existingOwner != nil && (deploymentOwner == nil || !metav1.IsControlledBy(deployment, deploymentOwner)) {
-			logger.V(1).Info("deployment already owned by another controller; reusing", "deployment", deploymentName, "owner", fmt.Sprintf("%s/%s", existingOwner.Kind, existingOwner.Name))
-			return nil
+	replicas := int32(1)
+	if runtimeSpec != nil && runtimeSpec.Replicas != nil && *runtimeSpec.Replicas > 1 {
+		switch {
+		case isColocPod:
+			logger.V(1).Info("ignoring replicas for pod-colocated module", "replicas", *runtimeSpec.Replicas)
+		case providerMM.Spec.Scaling.Statefulness == binderyv1alpha1.StatefulnessStateful:
+			logger.Info("refusing replicas > 1 for stateful module", "replicas", *runtimeSpec.Replicas)
+			r.recordEventf(&binding, "Warning", "ReplicasRefused", "Stateful module %q cannot run %d replicas; using 1", providerName, *runtimeSpec.Replicas)
+		default:
+			replicas = *runtimeSpec.Replicas
 		}
+	}
+
+	// A paused world keeps its Service, storage, and bindings but runs no pods.
+	paused := !isGlobal && worldPaused(&world)
+	if paused {
+		replicas = 0
+	}
+
+	// Capabilities whose endpoints were withheld because the provider is not serving yet.
+	var pendingDeps []string
 
-		deployment.Labels = mergeLabels(deployment.Labels, deploymentLabels)
-		deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: deploymentLabels}
-		deployment.Spec.Replicas = int32Ptr(1)
-		deployment.Spec.Template.ObjectMeta.Labels = mergeLabels(deployment.Spec.Template.ObjectMeta.Labels, deploymentLabels)
+	// mutatePodTemplate renders the module pod; shared by the Deployment and StatefulSet paths.
+	mutatePodTemplate := func(tpl *corev1.PodTemplateSpec) {
+		tpl.ObjectMeta.Labels = mergeLabels(tpl.ObjectMeta.Labels, deploymentLabels)
 
 		if terminationGracePeriod != nil {
-			deployment.Spec.Template.Spec.TerminationGracePeriodSeconds = terminationGracePeriod
+			tpl.Spec.TerminationGracePeriodSeconds = terminationGracePeriod
+		}
+		psc := podSecurityContext(runtimeSpec, volumeToMount != nil)
+		if isColocPod {
+			// Pod-colocated modules share one pod, so keep what other modules set.
+			psc = mergePodSecurityContext(tpl.Spec.SecurityContext, psc)
+		}
+		tpl.Spec.SecurityContext = psc
+		if runtimeSpec != nil {
+			// Pod-colocated modules share one pod, so collect every module's secrets.
+			tpl.Spec.ImagePullSecrets = mergeImagePullSecrets(tpl.Spec.ImagePullSecrets, runtimeSpec.ImagePullSecrets)
 		}
 
 		// Container logic
@@ -409,9 +507,10 @@ func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 		}
 
 		container := corev1.Container{
-			Name:  containerName,
-			Image: image,
-			Ports: []corev1.ContainerPort{{ContainerPort: port, Name: "grpc"}},
+			Name:            containerName,
+			Image:           image,
+			Ports:           []corev1.ContainerPort{{ContainerPort: port, Name: "grpc"}},
+			SecurityContext: containerSecurityContext(runtimeSpec),
 		}
 		if runtimeSpec != nil {
 			if len(runtimeSpec.Command) > 0 {
@@ -424,6 +523,14 @@ func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 		if volumeToMount != nil && mountToUse != nil {
 			container.VolumeMounts = append(container.VolumeMounts, *mountToUse)
 		}
+		tlsSpec := moduleTLSSpec(&providerMM)
+		if tlsSpec != nil {
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      tlsVolumeName,
+				MountPath: tlsMountPath,
+				ReadOnly:  true,
+			})
+		}
 
 		if preStopCommand != "" {
 			container.Lifecycle = &corev1.Lifecycle{
@@ -441,6 +548,15 @@ func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 			}
 		}
 
+		// TLS: the module server reads these via moduleserver.TLSServerOptionsFromEnv.
+		if tlsSpec != nil {
+			env["BINDERY_TLS_CERT_FILE"] = tlsMountPath + "/" + corev1.TLSCertKey
+			env["BINDERY_TLS_KEY_FILE"] = tlsMountPath + "/" + corev1.TLSPrivateKeyKey
+			if tlsSpec.ClientAuth {
+				env["BINDERY_TLS_CLIENT_CA_FILE"] = tlsMountPath + "/ca.crt"
+			}
+		}
+
 		// List dependencies once for service discovery + UDS hints.
 		var deps []binderyv1alpha1.CapabilityBinding
 		{
@@ -499,14 +615,14 @@ func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 		if isColocPod {
 			// Add shared volume if not present.
 			hasSharedVol := false
-			for _, v := range deployment.Spec.Template.Spec.Volumes {
+			for _, v := range tpl.Spec.Volumes {
 				if v.Name == "shared-socket" {
 					hasSharedVol = true
 					break
 				}
 			}
 			if !hasSharedVol {
-				deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+				tpl.Spec.Volumes = append(tpl.Spec.Volumes, corev1.Volume{
 					Name: "shared-socket",
 					VolumeSource: corev1.VolumeSource{
 						EmptyDir: &corev1.EmptyDirVolumeSource{},
@@ -530,11 +646,34 @@ func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 					continue
 				}
 				depGroup := getColocationGroup(&booklet, depProvider)
-				if depGroup == nil || colocGroup == nil || depGroup.Name != colocGroup.Name || depGroup.Strategy != "Pod" {
+				if depGroup == nil || colocGroup == nil || depGroup.Name != colocGroup.Name || depGroup.Strategy != binderyv1alpha1.ColocationStrategyPod {
 					continue
 				}
 				envName := fmt.Sprintf("BINDERY_UDS_%s", strings.ToUpper(strings.ReplaceAll(dep.Spec.CapabilityID, ".", "_")))
-				env[envName] = fmt.Sprintf("/var/run/bindery/%s.sock", depProvider)
+				env[envName] = udsSocketPath(depProvider)
+			}
+		}
+
+		providerCache := make(map[string]*binderyv1alpha1.ModuleManifest)
+		lookupProvider := func(name string) *binderyv1alpha1.ModuleManifest {
+			if mm, ok := providerCache[name]; ok {
+				return mm
+			}
+			var mm binderyv1alpha1.ModuleManifest
+			if err := r.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: name}, &mm); err != nil {
+				return nil
+			}
+			providerCache[name] = &mm
+			return &mm
+		}
+
+		// Endpoints this container was already given; see the serving gate below.
+		published := map[string]bool{}
+		for _, c := range tpl.Spec.Containers {
+			if c.Name == containerName {
+				for _, e := range c.Env {
+					published[e.Name] = true
+				}
 			}
 		}
 
@@ -543,32 +682,39 @@ func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 			if dep.Status.Provider == nil || dep.Status.Provider.Endpoint == nil {
 				continue
 			}
-			ep := dep.Status.Provider.Endpoint
 			capID := strings.ToUpper(strings.ReplaceAll(dep.Spec.CapabilityID, ".", "_"))
-			env[fmt.Sprintf("BINDERY_CAPABILITY_%s_ENDPOINT", capID)] = fmt.Sprintf("%s:%d", ep.Value, ep.Port)
-			env[fmt.Sprintf("BINDERY_CAPABILITY_%s_HOST", capID)] = ep.Value
-			env[fmt.Sprintf("BINDERY_CAPABILITY_%s_PORT", capID)] = fmt.Sprintf("%d", ep.Port)
+			// For providers we orchestrate, hold the endpoint back until a replica first serves it;
+			// otherwise consumers crash-loop dialing a Service with no backends. Once published it
+			// stays, so a provider rollout or pause does not roll every consumer twice.
+			// Unix socket providers share this Pod, so waiting on them would deadlock the rollout.
+			if depMM := lookupProvider(strings.TrimSpace(dep.Spec.Provider.ModuleManifestName)); isServerOrchestrated(depMM) &&
+				dep.Status.Provider.Endpoint.Type != binderyv1alpha1.EndpointTypeUnixSocket &&
+				!published[fmt.Sprintf("BINDERY_CAPABILITY_%s_ENDPOINT", capID)] &&
+				!meta.IsStatusConditionTrue(dep.Status.Conditions, BindingConditionEndpointServing) {
+				pendingDeps = append(pendingDeps, dep.Spec.CapabilityID)
+				continue
+			}
+			ep := dep.Status.Provider.Endpoint
+			env[fmt.Sprintf("BINDERY_CAPABILITY_%s_ENDPOINT", capID)] = EndpointAddress(ep)
+			if ep.Type != binderyv1alpha1.EndpointTypeUnixSocket && ep.Port > 0 {
+				env[fmt.Sprintf("BINDERY_CAPABILITY_%s_HOST", capID)] = ep.Value
+				env[fmt.Sprintf("BINDERY_CAPABILITY_%s_PORT", capID)] = fmt.Sprintf("%d", ep.Port)
+			}
+			if ep.Scheme != "" {
+				env[fmt.Sprintf("BINDERY_CAPABILITY_%s_SCHEME", capID)] = ep.Scheme
+			}
 		}
 
 		// Readiness coordination via init container: only for non-pod-colocated deployments.
 		waitTargets := make(map[string]struct{})
 		if !isColocPod {
-			providerCache := make(map[string]*binderyv1alpha1.ModuleManifest)
 			for _, dep := range deps {
 				depProvider := strings.TrimSpace(dep.Spec.Provider.ModuleManifestName)
 				if depProvider == "" {
 					continue
 				}
 
-				depMM, ok := providerCache[depProvider]
-				if !ok {
-					var mm binderyv1alpha1.ModuleManifest
-					if err := r.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: depProvider}, &mm); err != nil {
-						continue
-					}
-					depMM = &mm
-					providerCache[depProvider] = depMM
-				}
+				depMM := lookupProvider(depProvider)
 				if !isServerOrchestrated(depMM) {
 					continue
 				}
@@ -602,79 +748,99 @@ func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 			}
 
 			foundInit := false
-			for i := range deployment.Spec.Template.Spec.InitContainers {
-				if deployment.Spec.Template.Spec.InitContainers[i].Name == waitInitName {
-					deployment.Spec.Template.Spec.InitContainers[i] = initContainer
+			for i := range tpl.Spec.InitContainers {
+				if tpl.Spec.InitContainers[i].Name == waitInitName {
+					tpl.Spec.InitContainers[i] = initContainer
 					foundInit = true
 					break
 				}
 			}
 			if !foundInit {
-				deployment.Spec.Template.Spec.InitContainers = append(deployment.Spec.Template.Spec.InitContainers, initContainer)
+				tpl.Spec.InitContainers = append(tpl.Spec.InitContainers, initContainer)
 			}
 		} else {
 			// Remove wait init container if present.
-			next := deployment.Spec.Template.Spec.InitContainers[:0]
-			for _, c := range deployment.Spec.Template.Spec.InitContainers {
+			next := tpl.Spec.InitContainers[:0]
+			for _, c := range tpl.Spec.InitContainers {
 				if c.Name == waitInitName {
 					continue
 				}
 				next = append(next, c)
 			}
-			deployment.Spec.Template.Spec.InitContainers = next
+			tpl.Spec.InitContainers = next
 		}
 
 		container.Env = envVarsFromMap(env)
 
 		// Add/Update container in list
 		found := false
-		for i, c := range deployment.Spec.Template.Spec.Containers {
+		for i, c := range tpl.Spec.Containers {
 			if c.Name == containerName {
-				deployment.Spec.Template.Spec.Containers[i] = container
+				tpl.Spec.Containers[i] = container
 				found = true
 				break
 			}
 		}
 		if !found {
-			deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, container)
+			tpl.Spec.Containers = append(tpl.Spec.Containers, container)
 		}
 
 		// Handle volumes for this container (non-UDS)
 		if volumeToMount != nil {
 			// Check if volume already exists in deployment spec
 			volFound := false
-			for _, v := range deployment.Spec.Template.Spec.Volumes {
+			for _, v := range tpl.Spec.Volumes {
 				if v.Name == volumeToMount.Name {
 					volFound = true
 					break
 				}
 			}
 			if !volFound {
-				deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, *volumeToMount)
+				tpl.Spec.Volumes = append(tpl.Spec.Volumes, *volumeToMount)
+			}
+		}
+
+		if tlsSpec != nil {
+			tlsVol := corev1.Volume{
+				Name: tlsVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{SecretName: tlsSpec.SecretName},
+				},
+			}
+			volFound := false
+			for i := range tpl.Spec.Volumes {
+				if tpl.Spec.Volumes[i].Name == tlsVolumeName {
+					tpl.Spec.Volumes[i] = tlsVol
+					volFound = true
+					break
+				}
+			}
+			if !volFound {
+				tpl.Spec.Volumes = append(tpl.Spec.Volumes, tlsVol)
 			}
 		}
 
 		// Scheduling
 		if providerMM.Spec.Scheduling.Affinity != nil {
-			deployment.Spec.Template.Spec.Affinity = providerMM.Spec.Scheduling.Affinity
+			tpl.Spec.Affinity = providerMM.Spec.Scheduling.Affinity
 		}
 		if len(providerMM.Spec.Scheduling.Tolerations) > 0 {
-			deployment.Spec.Template.Spec.Tolerations = providerMM.Spec.Scheduling.Tolerations
+			tpl.Spec.Tolerations = providerMM.Spec.Scheduling.Tolerations
 		}
 		if len(providerMM.Spec.Scheduling.NodeSelector) > 0 {
-			deployment.Spec.Template.Spec.NodeSelector = providerMM.Spec.Scheduling.NodeSelector
+			tpl.Spec.NodeSelector = providerMM.Spec.Scheduling.NodeSelector
 		}
 		if providerMM.Spec.Scheduling.PriorityClassName != "" {
-			deployment.Spec.Template.Spec.PriorityClassName = providerMM.Spec.Scheduling.PriorityClassName
+			tpl.Spec.PriorityClassName = providerMM.Spec.Scheduling.PriorityClassName
 		}
 
 		// Node Strategy PodAffinity
-		if colocGroup != nil && colocGroup.Strategy == "Node" {
-			if deployment.Spec.Template.Spec.Affinity == nil {
-				deployment.Spec.Template.Spec.Affinity = &corev1.Affinity{}
+		if colocGroup != nil && colocGroup.Strategy == binderyv1alpha1.ColocationStrategyNode {
+			if tpl.Spec.Affinity == nil {
+				tpl.Spec.Affinity = &corev1.Affinity{}
 			}
-			if deployment.Spec.Template.Spec.Affinity.PodAffinity == nil {
-				deployment.Spec.Template.Spec.Affinity.PodAffinity = &corev1.PodAffinity{}
+			if tpl.Spec.Affinity.PodAffinity == nil {
+				tpl.Spec.Affinity.PodAffinity = &corev1.PodAffinity{}
 			}
 
 			matchLabels := map[string]string{
@@ -691,39 +857,113 @@ func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 				},
 				TopologyKey: "kubernetes.io/hostname",
 			}
-			deployment.Spec.Template.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(deployment.Spec.Template.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution, term)
+			tpl.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(tpl.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution, term)
 
 			// Ensure labels
-			deployment.Labels["bindery.platform/coloc-group"] = colocGroup.Name
-			deployment.Spec.Template.ObjectMeta.Labels["bindery.platform/coloc-group"] = colocGroup.Name
+			tpl.ObjectMeta.Labels["bindery.platform/coloc-group"] = colocGroup.Name
 		}
+	}
 
-		if deploymentOwner != nil {
-			return controllerutil.SetControllerReference(deploymentOwner, deployment, r.Scheme)
-		}
-		return nil
-	})
+	workloadOwner := controllerOwner(shardObj, &world, &binding, isGlobal)
+	workloadKind := "Deployment"
+	var servingCond metav1.Condition
+	if isStateful {
+		workloadKind = "StatefulSet"
+		statefulSet := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: deploymentName, Namespace: req.Namespace}}
+		_, err = controllerutil.CreateOrUpdate(ctx, r.Client, statefulSet, func() error {
+			existingOwner := metav1.GetControllerOf(statefulSet)
+			if existingOwner != nil && (workloadOwner == nil || !metav1.IsControlledBy(statefulSet, workloadOwner)) {
+				logger.V(1).Info("statefulset already owned by another controller; reusing", "statefulSet", deploymentName, "owner", fmt.Sprintf("%s/%s", existingOwner.Kind, existingOwner.Name))
+				return nil
+			}
+
+			applyPropagatedMetadata(statefulSet, &providerMM, metadataPrefixes)
+			statefulSet.Labels = mergeLabels(statefulSet.Labels, deploymentLabels)
+			statefulSet.Spec.Selector = &metav1.LabelSelector{MatchLabels: deploymentLabels}
+			statefulSet.Spec.ServiceName = serviceName
+			statefulSet.Spec.Replicas = int32Ptr(replicas)
+			mutatePodTemplate(&statefulSet.Spec.Template)
+			if colocGroup != nil && colocGroup.Strategy == binderyv1alpha1.ColocationStrategyNode {
+				statefulSet.Labels["bindery.platform/coloc-group"] = colocGroup.Name
+			}
+
+			if workloadOwner != nil {
+				return controllerutil.SetControllerReference(workloadOwner, statefulSet, r.Scheme)
+			}
+			return nil
+		})
+		servingCond = endpointServingCondition(replicas, statefulSet.Status.AvailableReplicas, paused)
+	} else {
+		deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: deploymentName, Namespace: req.Namespace}}
+		_, err = controllerutil.CreateOrUpdate(ctx, r.Client, deployment, func() error {
+			existingOwner := metav1.GetControllerOf(deployment)
+			if existingOwner != nil && (workloadOwner == nil || !metav1.IsControlledBy(deployment, workloadOwner)) {
+				logger.V(1).Info("deployment already owned by another controller; reusing", "deployment", deploymentName, "owner", fmt.Sprintf("%s/%s", existingOwner.Kind, existingOwner.Name))
+				return nil
+			}
+
+			applyPropagatedMetadata(deployment, &providerMM, metadataPrefixes)
+			deployment.Labels = mergeLabels(deployment.Labels, deploymentLabels)
+			deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: deploymentLabels}
+			deployment.Spec.Replicas = int32Ptr(replicas)
+			mutatePodTemplate(&deployment.Spec.Template)
+			if colocGroup != nil && colocGroup.Strategy == binderyv1alpha1.ColocationStrategyNode {
+				deployment.Labels["bindery.platform/coloc-group"] = colocGroup.Name
+			}
+
+			if workloadOwner != nil {
+				return controllerutil.SetControllerReference(workloadOwner, deployment, r.Scheme)
+			}
+			return nil
+		})
+		servingCond = endpointServingCondition(replicas, deployment.Status.AvailableReplicas, paused)
+	}
 	runtimeOrchestratorDeploymentDuration.Observe(time.Since(startDep).Seconds())
 	if err != nil {
-		logger.Error(err, "failed to ensure deployment", "deployment", deploymentName)
-		r.recordEventf(&binding, "Warning", "EnsureDeploymentFailed", "Failed to ensure Deployment %q: %v", deploymentName, err)
+		logger.Error(err, "failed to ensure workload", "kind", workloadKind, "name", deploymentName)
+		r.recordEventf(&binding, "Warning", "Ensure"+workloadKind+"Failed", "Failed to ensure %s %q: %v", workloadKind, deploymentName, err)
+		binderyControllerReconcileErrorTotal.WithLabelValues("RuntimeOrchestrator").Inc()
+		return ctrl.Result{}, err
+	}
+
+	// A statefulness change flips the workload kind; drop the one we no longer render.
+	if err := r.deleteStaleWorkload(ctx, req.Namespace, deploymentName, isStateful); err != nil {
+		logger.Error(err, "failed to delete stale workload", "name", deploymentName)
 		binderyControllerReconcileErrorTotal.WithLabelValues("RuntimeOrchestrator").Inc()
 		return ctrl.Result{}, err
 	}
 
 	// 3) Publish the endpoint back onto the binding status.
 	desiredEndpoint := &binderyv1alpha1.EndpointRef{
-		Type:  "kubernetesService",
-		Value: serviceName,
-		Port:  port,
+		Type:   binderyv1alpha1.EndpointTypeKubernetesService,
+		Value:  serviceName,
+		Port:   port,
+		Scheme: binderyv1alpha1.EndpointSchemeGRPC,
+	}
+	if udsOnly {
+		desiredEndpoint = &binderyv1alpha1.EndpointRef{
+			Type:   binderyv1alpha1.EndpointTypeUnixSocket,
+			Value:  udsSocketPath(providerName),
+			Scheme: binderyv1alpha1.EndpointSchemeGRPC,
+		}
+	}
+	if moduleTLSSpec(&providerMM) != nil {
+		desiredEndpoint.Scheme = binderyv1alpha1.EndpointSchemeGRPCS
 	}
 	cond := meta.FindStatusCondition(binding.Status.Conditions, BindingConditionRuntimeReady)
 	needCondPatch := cond == nil || cond.Status != metav1.ConditionTrue || cond.Reason != "EndpointPublished"
 	needEndpointPatch := binding.Status.Provider == nil || binding.Status.Provider.Endpoint == nil ||
 		binding.Status.Provider.Endpoint.Type != desiredEndpoint.Type ||
 		binding.Status.Provider.Endpoint.Value != desiredEndpoint.Value ||
-		binding.Status.Provider.Endpoint.Port != desiredEndpoint.Port
-	if needEndpointPatch || needCondPatch {
+		binding.Status.Provider.Endpoint.Port != desiredEndpoint.Port ||
+		binding.Status.Provider.Endpoint.Scheme != desiredEndpoint.Scheme
+
+	// The endpoint is only worth dialing once at least one replica behind it is available.
+	prevServing := meta.FindStatusCondition(binding.Status.Conditions, BindingConditionEndpointServing)
+	needServingPatch := prevServing == nil || prevServing.Status != servingCond.Status ||
+		prevServing.Reason != servingCond.Reason || prevServing.Message != servingCond.Message
+
+	if needEndpointPatch || needCondPatch || needServingPatch {
 		before := binding.DeepCopy()
 		binding.Status.ObservedGeneration = binding.Generation
 		binding.Status.Provider = &binderyv1alpha1.ProviderStatus{Endpoint: desiredEndpoint}
@@ -733,14 +973,20 @@ func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 			Reason:  "EndpointPublished",
 			Message: fmt.Sprintf("Endpoint published: %s/%s:%d", desiredEndpoint.Type, desiredEndpoint.Value, desiredEndpoint.Port),
 		})
+		setBindingCondition(&binding, servingCond)
 		if err := r.Status().Patch(ctx, &binding, client.MergeFrom(before)); err != nil {
 			logger.Error(err, "failed to publish endpoint to binding status", "service", serviceName, "port", port)
 			r.recordEventf(&binding, "Warning", "PublishEndpointFailed", "Failed to publish endpoint to binding status: %v", err)
 			binderyControllerReconcileErrorTotal.WithLabelValues("RuntimeOrchestrator").Inc()
 			return ctrl.Result{}, err
 		}
-		logger.Info("published endpoint", "endpointType", desiredEndpoint.Type, "endpointValue", desiredEndpoint.Value, "endpointPort", desiredEndpoint.Port)
-		r.recordEventf(&binding, "Normal", "EndpointPublished", "Published endpoint %s/%s:%d", desiredEndpoint.Type, desiredEndpoint.Value, desiredEndpoint.Port)
+		if needEndpointPatch || needCondPatch {
+			logger.Info("published endpoint", "endpointType", desiredEndpoint.Type, "endpointValue", desiredEndpoint.Value, "endpointPort", desiredEndpoint.Port)
+			r.recordEventf(&binding, "Normal", "EndpointPublished", "Published endpoint %s/%s:%d", desiredEndpoint.Type, desiredEndpoint.Value, desiredEndpoint.Port)
+		}
+		if servingCond.Status == metav1.ConditionTrue && (prevServing == nil || prevServing.Status != metav1.ConditionTrue) {
+			r.recordEventf(&binding, "Normal", "EndpointServing", "%s", servingCond.Message)
+		}
 	}
 
 	// Update the owning world's RuntimeReady condition (best-effort for debuggability).
@@ -753,6 +999,10 @@ func (r *RuntimeOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 	}
 
 	logger.Info("ensured runtime", "service", serviceName, "image", image, "port", port)
+	if len(pendingDeps) > 0 && !paused {
+		logger.V(1).Info("dependency endpoints not serving yet; requeue", "capabilities", pendingDeps)
+		return ctrl.Result{RequeueAfter: dependencyServingRequeueInterval}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -824,15 +1074,60 @@ func (r *RuntimeOrchestratorReconciler) updateWorldRuntimeReadyCondition(ctx con
 	prev := meta.FindStatusCondition(world.Status.Conditions, WorldConditionRuntimeReady)
 	setWorldCondition(world, metav1.Condition{Type: WorldConditionRuntimeReady, Status: status, Reason: reason, Message: message})
 	world.Status.ObservedGeneration = world.Generation
+	world.Status.Endpoints = worldEndpoints(bindings.Items)
+	firstReady := status == metav1.ConditionTrue && world.Status.RuntimeReadyTime == nil
+	if firstReady {
+		now := metav1.Now()
+		world.Status.RuntimeReadyTime = &now
+	}
 	if err := r.Status().Patch(ctx, world, client.MergeFrom(before)); err != nil {
 		return err
 	}
+	// Observed after the patch lands so a failed write does not double count.
+	if firstReady && !world.CreationTimestamp.IsZero() {
+		runtimeOrchestratorWorldStartupDuration.Observe(world.Status.RuntimeReadyTime.Sub(world.CreationTimestamp.Time).Seconds())
+	}
 	if (prev == nil || prev.Status != metav1.ConditionTrue) && status == metav1.ConditionTrue {
 		r.recordEventf(world, "Normal", "RuntimeReady", "%s", message)
 	}
 	return nil
 }
 
+// worldEndpoints lists the distinct endpoints published on a world's bindings.
+// Several consumers of one provider share an entry.
+func worldEndpoints(bindings []binderyv1alpha1.CapabilityBinding) []binderyv1alpha1.WorldEndpointStatus {
+	seen := map[string]struct{}{}
+	var out []binderyv1alpha1.WorldEndpointStatus
+	for i := range bindings {
+		b := &bindings[i]
+		if b.Status.Provider == nil || b.Status.Provider.Endpoint == nil {
+			continue
+		}
+		ep := b.Status.Provider.Endpoint
+		addr := EndpointAddress(ep)
+		key := b.Spec.CapabilityID + "|" + addr
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, binderyv1alpha1.WorldEndpointStatus{
+			CapabilityID: b.Spec.CapabilityID,
+			Scope:        b.Spec.Scope,
+			Provider:     b.Spec.Provider.ModuleManifestName,
+			Endpoint:     addr,
+			Scheme:       ep.Scheme,
+			Serving:      meta.IsStatusConditionTrue(b.Status.Conditions, BindingConditionEndpointServing),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].CapabilityID != out[j].CapabilityID {
+			return out[i].CapabilityID < out[j].CapabilityID
+		}
+		return out[i].Endpoint < out[j].Endpoint
+	})
+	return out
+}
+
 func (r *RuntimeOrchestratorReconciler) recordEventf(obj client.Object, eventType, reason, messageFmt string, args ...any) {
 	if r.Recorder == nil || obj == nil {
 		return
@@ -866,6 +1161,124 @@ func (r *RuntimeOrchestratorReconciler) findConsumersForBinding(ctx context.Cont
 	return reqs
 }
 
+// deleteStaleWorkload removes the Deployment (or StatefulSet, when keepStatefulSet is false)
+// with the given name if it was created by this controller.
+func (r *RuntimeOrchestratorReconciler) deleteStaleWorkload(ctx context.Context, namespace, name string, keepStatefulSet bool) error {
+	var stale client.Object = &appsv1.StatefulSet{}
+	if keepStatefulSet {
+		stale = &appsv1.Deployment{}
+	}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, stale); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if stale.GetLabels()[rtLabelManagedBy] != rtManagedBy {
+		return nil
+	}
+	return client.IgnoreNotFound(r.Delete(ctx, stale))
+}
+
+// managedService returns the named Service if this controller created it and no controller
+// other than owner holds it, or nil.
+func (r *RuntimeOrchestratorReconciler) managedService(ctx context.Context, namespace, name string, owner client.Object) (*corev1.Service, error) {
+	var svc corev1.Service
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &svc); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	if svc.Labels[rtLabelManagedBy] != rtManagedBy {
+		return nil, nil
+	}
+	if existing := metav1.GetControllerOf(&svc); existing != nil && (owner == nil || !metav1.IsControlledBy(&svc, owner)) {
+		return nil, nil
+	}
+	return &svc, nil
+}
+
+// deleteNonHeadlessService removes the named Service if this controller created it with a
+// cluster IP, reporting whether it did. clusterIP is immutable, so a module that became
+// stateful only gets the headless Service its StatefulSet needs by recreating it.
+func (r *RuntimeOrchestratorReconciler) deleteNonHeadlessService(ctx context.Context, namespace, name string, owner client.Object) (bool, error) {
+	svc, err := r.managedService(ctx, namespace, name, owner)
+	if err != nil || svc == nil || svc.Spec.ClusterIP == "" || svc.Spec.ClusterIP == corev1.ClusterIPNone {
+		return false, err
+	}
+	if err := r.Delete(ctx, svc); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	return true, nil
+}
+
+// serviceNeededOutsideGroup reports whether another binding to binding's provider, in the
+// same world and shard, has a consumer outside group and so still dials the Service.
+func (r *RuntimeOrchestratorReconciler) serviceNeededOutsideGroup(ctx context.Context, binding *binderyv1alpha1.CapabilityBinding, booklet *binderyv1alpha1.Booklet, group *binderyv1alpha1.ColocationGroup, worldName, shardLabel string) (bool, error) {
+	var bindings binderyv1alpha1.CapabilityBindingList
+	if err := r.List(ctx, &bindings, client.InNamespace(binding.Namespace)); err != nil {
+		return false, err
+	}
+	for i := range bindings.Items {
+		b := &bindings.Items[i]
+		if b.Name == binding.Name || !b.DeletionTimestamp.IsZero() ||
+			b.Spec.Provider.ModuleManifestName != binding.Spec.Provider.ModuleManifestName ||
+			b.Spec.WorldRef == nil || b.Spec.WorldRef.Name != worldName ||
+			strings.TrimSpace(b.Labels[labelShardID]) != shardLabel {
+			continue
+		}
+		if !inColocationGroup(booklet, b.Spec.Consumer.ModuleManifestName, group) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// findBindingsForWorkload maps a runtime Deployment or StatefulSet back to the bindings it serves,
+// so that replica availability changes refresh the EndpointServing condition.
+// Deployments are owned by the world/shard rather than the binding, so Owns() alone
+// does not cover them.
+func (r *RuntimeOrchestratorReconciler) findBindingsForWorkload(ctx context.Context, obj client.Object) []reconcile.Request {
+	labels := obj.GetLabels()
+	if labels[rtLabelManagedBy] != rtManagedBy {
+		return nil
+	}
+
+	opts := []client.ListOption{client.InNamespace(obj.GetNamespace())}
+	if module := labels[rtLabelModule]; module != "" {
+		opts = append(opts, client.MatchingFields{idxBindingProvider: module})
+	}
+	var bindings binderyv1alpha1.CapabilityBindingList
+	if err := r.List(ctx, &bindings, opts...); err != nil {
+		return nil
+	}
+
+	worldName := labels[rtLabelWorldName]
+	shard := labels[labelShardID]
+	var reqs []reconcile.Request
+	for _, b := range bindings.Items {
+		if worldName != "" && (b.Spec.WorldRef == nil || b.Spec.WorldRef.Name != worldName) {
+			continue
+		}
+		if shard != "" && b.Labels[labelShardID] != shard {
+			continue
+		}
+		reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: b.Name, Namespace: b.Namespace}})
+	}
+	return reqs
+}
+
+// findBindingsForWorld re-renders a world's workloads when its spec changes (e.g. desiredState).
+func (r *RuntimeOrchestratorReconciler) findBindingsForWorld(ctx context.Context, obj client.Object) []reconcile.Request {
+	var bindings binderyv1alpha1.CapabilityBindingList
+	if err := r.List(ctx, &bindings,
+		client.InNamespace(obj.GetNamespace()),
+		client.MatchingLabels{labelManagedBy: managedByCapabilityResolver, labelWorldName: obj.GetName()},
+	); err != nil {
+		return nil
+	}
+	reqs := make([]reconcile.Request, 0, len(bindings.Items))
+	for _, b := range bindings.Items {
+		reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: b.Name, Namespace: b.Namespace}})
+	}
+	return reqs
+}
+
 func (r *RuntimeOrchestratorReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	// Index spec.consumer.moduleManifestName
 	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &binderyv1alpha1.CapabilityBinding{}, idxBindingConsumer, func(rawObj client.Object) []string {
@@ -889,19 +1302,34 @@ func (r *RuntimeOrchestratorReconciler) SetupWithManager(mgr ctrl.Manager) error
 		return err
 	}
 
-	builder := ctrl.NewControllerManagedBy(mgr)
+	b := ctrl.NewControllerManagedBy(mgr)
 	if r.Name != "" {
-		builder = builder.Named(r.Name)
+		b = b.Named(r.Name)
 	}
 
-	return builder.
+	return b.
 		For(&binderyv1alpha1.CapabilityBinding{}).
 		Owns(&appsv1.Deployment{}).
+		Owns(&appsv1.StatefulSet{}).
 		Owns(&corev1.Service{}).
 		Watches(
 			&binderyv1alpha1.CapabilityBinding{},
 			handler.EnqueueRequestsFromMapFunc(r.findConsumersForBinding),
 		).
+		Watches(
+			&binderyv1alpha1.WorldInstance{},
+			handler.EnqueueRequestsFromMapFunc(r.findBindingsForWorld),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&appsv1.Deployment{},
+			handler.EnqueueRequestsFromMapFunc(r.findBindingsForWorkload),
+		).
+		Watches(
+			&appsv1.StatefulSet{},
+			handler.EnqueueRequestsFromMapFunc(r.findBindingsForWorkload),
+		).
+		WithOptions(r.Options.controllerOptions()).
 		Complete(r)
 }
 
@@ -927,6 +1355,31 @@ func controllerOwner(shard *binderyv1alpha1.WorldShard, world *binderyv1alpha1.W
 
 func int32Ptr(v int32) *int32 { return &v }
 
+func endpointServingCondition(desired, available int32, paused bool) metav1.Condition {
+	if paused {
+		return metav1.Condition{
+			Type:    BindingConditionEndpointServing,
+			Status:  metav1.ConditionFalse,
+			Reason:  "WorldPaused",
+			Message: "World is paused; provider scaled to zero",
+		}
+	}
+	if available > 0 {
+		return metav1.Condition{
+			Type:    BindingConditionEndpointServing,
+			Status:  metav1.ConditionTrue,
+			Reason:  "ReplicasAvailable",
+			Message: endpointServingMessage(available, desired),
+		}
+	}
+	return metav1.Condition{
+		Type:    BindingConditionEndpointServing,
+		Status:  metav1.ConditionFalse,
+		Reason:  "NoReplicasAvailable",
+		Message: endpointServingMessage(available, desired),
+	}
+}
+
 func intstrFromInt32(v int32) intstr.IntOrString {
 	return intstr.FromInt(int(v))
 }
@@ -972,6 +1425,21 @@ func rtNameWithShard(worldName, shardID, moduleName string) string {
 	return base + suffix
 }
 
+// defaultStorageTierForStatefulness returns the storage tier for a module without a
+// storage-tier annotation. Stateful modules default to server-low-latency storage only
+// when BINDERY_STATEFUL_DEFAULT_STORAGE is true, so existing clusters keep running
+// such modules without persistence until they opt in.
+func defaultStorageTierForStatefulness(statefulness string) binderyv1alpha1.WorldStorageTier {
+	if statefulness != binderyv1alpha1.StatefulnessStateful {
+		return ""
+	}
+	enabled, _ := strconv.ParseBool(strings.TrimSpace(os.Getenv("BINDERY_STATEFUL_DEFAULT_STORAGE")))
+	if !enabled {
+		return ""
+	}
+	return binderyv1alpha1.WorldStorageTierServerLowLatency
+}
+
 func parseCSV(raw string) []string {
 	raw = strings.TrimSpace(raw)
 	if raw == "" {
@@ -1016,6 +1484,7 @@ func (r *RuntimeOrchestratorReconciler) ensureWorldStorageClaim(
 	namespace string,
 	world *binderyv1alpha1.WorldInstance,
 	shard *binderyv1alpha1.WorldShard,
+	module *binderyv1alpha1.ModuleManifest,
 	name string,
 	scope binderyv1alpha1.WorldStorageScope,
 	tier binderyv1alpha1.WorldStorageTier,
@@ -1026,6 +1495,9 @@ func (r *RuntimeOrchestratorReconciler) ensureWorldStorageClaim(
 ) error {
 	claim := &binderyv1alpha1.WorldStorageClaim{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
 	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, claim, func() error {
+		if module != nil {
+			applyPropagatedMetadata(claim, module, propagatedMetadataPrefixes())
+		}
 		if claim.Labels == nil {
 			claim.Labels = map[string]string{}
 		}
@@ -1052,18 +1524,275 @@ func (r *RuntimeOrchestratorReconciler) ensureWorldStorageClaim(
 	return err
 }
 
+// udsSocketPath is where a Pod-colocated module serves on the shared socket volume.
+func udsSocketPath(moduleName string) string {
+	return fmt.Sprintf("/var/run/bindery/%s.sock", moduleName)
+}
+
+// EndpointAddress renders ep as a dial target: host:port, unix://path for unix socket
+// endpoints, or the value verbatim for portless (URI) endpoints. It is the value
+// consumers get in BINDERY_CAPABILITY_*_ENDPOINT.
+func EndpointAddress(ep *binderyv1alpha1.EndpointRef) string {
+	switch {
+	case ep.Type == binderyv1alpha1.EndpointTypeUnixSocket:
+		return "unix://" + ep.Value
+	case ep.Port == 0:
+		return ep.Value
+	}
+	return fmt.Sprintf("%s:%d", ep.Value, ep.Port)
+}
+
+// externalEndpointRef converts spec.external into the endpoint published on bindings.
+// URIs are kept whole; host:port is split so consumers also get _HOST and _PORT.
+func externalEndpointRef(ext *binderyv1alpha1.ModuleExternalSpec) (*binderyv1alpha1.EndpointRef, error) {
+	raw := strings.TrimSpace(ext.Endpoint)
+	ep := &binderyv1alpha1.EndpointRef{Type: binderyv1alpha1.EndpointTypeExternal, Scheme: strings.TrimSpace(ext.Scheme)}
+	if u, err := url.Parse(raw); err == nil && u.Scheme != "" && u.Host != "" {
+		ep.Value = raw
+		if ep.Scheme == "" {
+			ep.Scheme = u.Scheme
+		}
+		return ep, nil
+	}
+	host, portRaw, err := net.SplitHostPort(raw)
+	if err != nil || host == "" {
+		return nil, fmt.Errorf("external endpoint %q is neither host:port nor a URI", raw)
+	}
+	port, err := strconv.Atoi(portRaw)
+	if err != nil || port <= 0 || port > 65535 {
+		return nil, fmt.Errorf("external endpoint %q has an invalid port", raw)
+	}
+	ep.Value = host
+	ep.Port = int32(port)
+	if ep.Scheme == "" {
+		ep.Scheme = binderyv1alpha1.EndpointSchemeGRPC
+	}
+	return ep, nil
+}
+
+// publishExternalEndpoint publishes an out-of-cluster provider's endpoint on the binding.
+// No workload or Service is created, and the endpoint is not health-checked.
+func (r *RuntimeOrchestratorReconciler) publishExternalEndpoint(ctx context.Context, binding *binderyv1alpha1.CapabilityBinding, provider *binderyv1alpha1.ModuleManifest) error {
+	before := binding.DeepCopy()
+	binding.Status.ObservedGeneration = binding.Generation
+
+	ep, err := externalEndpointRef(provider.Spec.External)
+	if err != nil {
+		setBindingCondition(binding, metav1.Condition{
+			Type:    BindingConditionRuntimeReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  "InvalidExternalEndpoint",
+			Message: err.Error(),
+		})
+		r.recordEventf(binding, "Warning", "InvalidExternalEndpoint", "%v", err)
+		return r.Status().Patch(ctx, binding, client.MergeFrom(before))
+	}
+
+	changed := binding.Status.Provider == nil || binding.Status.Provider.Endpoint == nil || *binding.Status.Provider.Endpoint != *ep
+	binding.Status.Provider = &binderyv1alpha1.ProviderStatus{Endpoint: ep}
+	setBindingCondition(binding, metav1.Condition{
+		Type:    BindingConditionRuntimeReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  "ExternalEndpoint",
+		Message: fmt.Sprintf("External endpoint published: %s", EndpointAddress(ep)),
+	})
+	setBindingCondition(binding, metav1.Condition{
+		Type:    BindingConditionEndpointServing,
+		Status:  metav1.ConditionTrue,
+		Reason:  "ExternalEndpoint",
+		Message: "External endpoints are not health-checked",
+	})
+	if err := r.Status().Patch(ctx, binding, client.MergeFrom(before)); err != nil {
+		return err
+	}
+	if changed {
+		r.recordEventf(binding, "Normal", "EndpointPublished", "Published external endpoint %s", EndpointAddress(ep))
+	}
+	return nil
+}
+
+// inColocationGroup reports whether moduleName resolves to group.
+func inColocationGroup(booklet *binderyv1alpha1.Booklet, moduleName string, group *binderyv1alpha1.ColocationGroup) bool {
+	g := getColocationGroup(booklet, moduleName)
+	return g != nil && g.Name == group.Name
+}
+
+// colocationGroupFor returns the colocation group that applies to moduleName, or nil when
+// the module is not colocated. Strategy None disables colocation; unknown strategies
+// raise a warning event on the Booklet and are treated as None.
+//
+// A module listed in several groups gets the group with the smallest name, and a
+// ColocationGroupConflict warning names the others.
+func (r *RuntimeOrchestratorReconciler) colocationGroupFor(booklet *binderyv1alpha1.Booklet, moduleName string) *binderyv1alpha1.ColocationGroup {
+	groups := colocationGroupsFor(booklet, moduleName)
+	if len(groups) == 0 {
+		return nil
+	}
+	group := groups[0]
+	if len(groups) > 1 {
+		names := make([]string, 0, len(groups))
+		for _, g := range groups {
+			names = append(names, g.Name)
+		}
+		r.recordEventf(booklet, "Warning", "ColocationGroupConflict", "module %q is listed in colocation groups %s; using %q", moduleName, strings.Join(names, ", "), group.Name)
+	}
+	switch group.Strategy {
+	case binderyv1alpha1.ColocationStrategyPod, binderyv1alpha1.ColocationStrategyNode:
+		return group
+	case binderyv1alpha1.ColocationStrategyNone:
+		return nil
+	default:
+		r.recordEventf(booklet, "Warning", "UnknownColocationStrategy", "colocation group %q has unknown strategy %q; treating as None", group.Name, group.Strategy)
+		return nil
+	}
+}
+
 func getColocationGroup(game *binderyv1alpha1.Booklet, moduleName string) *binderyv1alpha1.ColocationGroup {
+	if groups := colocationGroupsFor(game, moduleName); len(groups) > 0 {
+		return groups[0]
+	}
+	return nil
+}
+
+// colocationGroupsFor returns every colocation group listing moduleName, sorted by name.
+func colocationGroupsFor(game *binderyv1alpha1.Booklet, moduleName string) []*binderyv1alpha1.ColocationGroup {
 	if game == nil {
 		return nil
 	}
-	for _, group := range game.Spec.Colocation {
+	var out []*binderyv1alpha1.ColocationGroup
+	for i := range game.Spec.Colocation {
+		group := &game.Spec.Colocation[i]
 		for _, m := range group.Modules {
 			if m == moduleName {
-				return &group
+				out = append(out, group)
+				break
 			}
 		}
 	}
-	return nil
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// Where a module's declared TLS Secret is mounted.
+const (
+	tlsVolumeName = "bindery-tls"
+	tlsMountPath  = "/etc/bindery/tls"
+)
+
+// moduleTLSSpec returns the module's TLS declaration, or nil when it serves plaintext.
+func moduleTLSSpec(mm *binderyv1alpha1.ModuleManifest) *binderyv1alpha1.ModuleTLSSpec {
+	if mm == nil || mm.Spec.Runtime == nil || mm.Spec.Runtime.TLS == nil {
+		return nil
+	}
+	if strings.TrimSpace(mm.Spec.Runtime.TLS.SecretName) == "" {
+		return nil
+	}
+	return mm.Spec.Runtime.TLS
+}
+
+// mergeImagePullSecrets appends the named secrets missing from existing.
+func mergeImagePullSecrets(existing, add []corev1.LocalObjectReference) []corev1.LocalObjectReference {
+	for _, s := range add {
+		name := strings.TrimSpace(s.Name)
+		if name == "" {
+			continue
+		}
+		found := false
+		for _, e := range existing {
+			if e.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			existing = append(existing, corev1.LocalObjectReference{Name: name})
+		}
+	}
+	return existing
+}
+
+// containerSecurityContext renders spec.runtime.securityContext onto the module
+// container, filling unset fields with the non-root defaults.
+func containerSecurityContext(rt *binderyv1alpha1.ModuleRuntimeSpec) *corev1.SecurityContext {
+	var msc binderyv1alpha1.ModuleSecurityContext
+	if rt != nil && rt.SecurityContext != nil {
+		msc = *rt.SecurityContext
+	}
+	runAsNonRoot := true
+	if msc.RunAsNonRoot != nil {
+		runAsNonRoot = *msc.RunAsNonRoot
+	}
+	allowEscalation := false
+	if msc.AllowPrivilegeEscalation != nil {
+		allowEscalation = *msc.AllowPrivilegeEscalation
+	}
+	drop := msc.DropCapabilities
+	if len(drop) == 0 {
+		drop = []string{"ALL"}
+	}
+
+	sc := &corev1.SecurityContext{
+		RunAsNonRoot:             &runAsNonRoot,
+		RunAsUser:                moduleRunAsUser(&msc, runAsNonRoot),
+		ReadOnlyRootFilesystem:   &msc.ReadOnlyRootFilesystem,
+		AllowPrivilegeEscalation: &allowEscalation,
+		Capabilities:             &corev1.Capabilities{},
+	}
+	for _, c := range drop {
+		sc.Capabilities.Drop = append(sc.Capabilities.Drop, corev1.Capability(c))
+	}
+	for _, c := range msc.AddCapabilities {
+		sc.Capabilities.Add = append(sc.Capabilities.Add, corev1.Capability(c))
+	}
+	return sc
+}
+
+// podSecurityContext applies the RuntimeDefault seccomp profile and, when the pod
+// mounts module storage, an fsGroup so a non-root container can write to it.
+// Run-as settings stay on the module container so the wait-for-deps init
+// container is unaffected.
+func podSecurityContext(rt *binderyv1alpha1.ModuleRuntimeSpec, hasStorage bool) *corev1.PodSecurityContext {
+	psc := &corev1.PodSecurityContext{
+		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+	if !hasStorage {
+		return psc
+	}
+	var msc binderyv1alpha1.ModuleSecurityContext
+	if rt != nil && rt.SecurityContext != nil {
+		msc = *rt.SecurityContext
+	}
+	psc.FSGroup = moduleRunAsUser(&msc, msc.RunAsNonRoot == nil || *msc.RunAsNonRoot)
+	return psc
+}
+
+// mergePodSecurityContext fills the fields unset in existing from add, so a
+// colocated module without storage does not clear the fsGroup another module
+// needs. When modules disagree, the fsGroup already on the pod is kept.
+func mergePodSecurityContext(existing, add *corev1.PodSecurityContext) *corev1.PodSecurityContext {
+	if existing == nil {
+		return add
+	}
+	merged := existing.DeepCopy()
+	if merged.SeccompProfile == nil {
+		merged.SeccompProfile = add.SeccompProfile
+	}
+	if merged.FSGroup == nil {
+		merged.FSGroup = add.FSGroup
+	}
+	return merged
+}
+
+func moduleRunAsUser(msc *binderyv1alpha1.ModuleSecurityContext, runAsNonRoot bool) *int64 {
+	if msc.RunAsUser != nil {
+		uid := *msc.RunAsUser
+		return &uid
+	}
+	if !runAsNonRoot {
+		return nil
+	}
+	uid := binderyv1alpha1.DefaultModuleRunAsUser
+	return &uid
 }
 
 func envVarsFromMap(env map[string]string) []corev1.EnvVar {
@@ -1084,7 +1813,7 @@ func envVarsFromMap(env map[string]string) []corev1.EnvVar {
 }
 
 func isServerOrchestrated(mm *binderyv1alpha1.ModuleManifest) bool {
-	if mm == nil {
+	if mm == nil || mm.Spec.External != nil {
 		return false
 	}
 	if mm.Spec.Runtime != nil && strings.TrimSpace(mm.Spec.Runtime.Image) != "" {
@@ -2,14 +2,18 @@ package controllers
 
 import (
 	"context"
+	"reflect"
+	"strings"
 	"testing"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -312,6 +316,72 @@ func TestRuntimeOrchestrator_ServerStorageCreatesClaimAndMount(t *testing.T) {
 	}
 }
 
+func TestRuntimeOrchestrator_StatefulModuleDefaultsStorageTier(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme(client-go): %v", err)
+	}
+	if err := binderyv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme(game): %v", err)
+	}
+
+	for _, tc := range []struct {
+		toggle    string
+		wantClaim bool
+	}{
+		{toggle: "", wantClaim: false},
+		{toggle: "true", wantClaim: true},
+	} {
+		t.Setenv("BINDERY_STATEFUL_DEFAULT_STORAGE", tc.toggle)
+
+		world := &binderyv1alpha1.WorldInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default", UID: types.UID("world-uid")},
+			Spec:       binderyv1alpha1.WorldInstanceSpec{GameRef: binderyv1alpha1.ObjectRef{Name: "game"}, WorldID: "w1"},
+		}
+		provider := &binderyv1alpha1.ModuleManifest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "state-store",
+				Namespace:   "default",
+				Annotations: map[string]string{annRuntimeImage: "alpine:3.20", annRuntimePort: "50051"},
+			},
+			Spec: binderyv1alpha1.ModuleManifestSpec{
+				Module:  binderyv1alpha1.ModuleIdentity{ID: "core.state", Version: "1.0.0"},
+				Scaling: binderyv1alpha1.ModuleScaling{Statefulness: binderyv1alpha1.StatefulnessStateful},
+			},
+		}
+		binding := &binderyv1alpha1.CapabilityBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "binding-1", Namespace: "default"},
+			Spec: binderyv1alpha1.CapabilityBindingSpec{
+				CapabilityID: "state.store",
+				Scope:        binderyv1alpha1.CapabilityScopeWorld,
+				Multiplicity: binderyv1alpha1.MultiplicityOne,
+				WorldRef:     &binderyv1alpha1.WorldRef{Name: world.Name},
+				Consumer:     binderyv1alpha1.ConsumerRef{ModuleManifestName: "consumer"},
+				Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: provider.Name, CapabilityVersion: "1.0.0"},
+			},
+		}
+		cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, provider, binding).WithStatusSubresource(binding, world).Build()
+
+		r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme}
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: binding.Name}}); err != nil {
+			t.Fatalf("toggle=%q: Reconcile: %v", tc.toggle, err)
+		}
+
+		var claims binderyv1alpha1.WorldStorageClaimList
+		if err := cl.List(ctx, &claims, client.InNamespace("default")); err != nil {
+			t.Fatalf("list claims: %v", err)
+		}
+		if got := len(claims.Items) == 1; got != tc.wantClaim {
+			t.Fatalf("toggle=%q: expected claim=%v, got %d claims", tc.toggle, tc.wantClaim, len(claims.Items))
+		}
+		if tc.wantClaim && claims.Items[0].Spec.Tier != binderyv1alpha1.WorldStorageTierServerLowLatency {
+			t.Fatalf("expected server-low-latency tier, got %q", claims.Items[0].Spec.Tier)
+		}
+	}
+}
+
 func TestRuntimeOrchestrator_SkipsWhenNoWorldRef(t *testing.T) {
 	ctx := context.Background()
 
@@ -660,3 +730,1035 @@ func TestRuntimeOrchestrator_GracefulTermination(t *testing.T) {
 		t.Errorf("Unexpected PreStop command: %v", cmd)
 	}
 }
+
+func TestRuntimeOrchestrator_EndpointServingTracksAvailableReplicas(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme(client-go): %v", err)
+	}
+	if err := binderyv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme(game): %v", err)
+	}
+
+	world := &binderyv1alpha1.WorldInstance{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "bindery.platform/v1alpha1", Kind: "WorldInstance"},
+		ObjectMeta: metav1.ObjectMeta{Name: "w1", Namespace: "ns", UID: types.UID("world-uid")},
+		Spec:       binderyv1alpha1.WorldInstanceSpec{GameRef: binderyv1alpha1.ObjectRef{Name: "g"}, WorldID: "world-001", Region: "r", ShardCount: 1},
+	}
+
+	provider := &binderyv1alpha1.ModuleManifest{
+		TypeMeta: metav1.TypeMeta{APIVersion: "bindery.platform/v1alpha1", Kind: "ModuleManifest"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "core-physics-engine",
+			Namespace:   "ns",
+			Annotations: map[string]string{annRuntimeImage: "alpine:3.20"},
+		},
+		Spec: binderyv1alpha1.ModuleManifestSpec{Module: binderyv1alpha1.ModuleIdentity{ID: "core.physics", Version: "1.3.0"}},
+	}
+
+	binding := &binderyv1alpha1.CapabilityBinding{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "bindery.platform/v1alpha1", Kind: "CapabilityBinding"},
+		ObjectMeta: metav1.ObjectMeta{Name: "binding-1", Namespace: "ns"},
+		Spec: binderyv1alpha1.CapabilityBindingSpec{
+			CapabilityID: "physics.engine",
+			Scope:        binderyv1alpha1.CapabilityScopeWorld,
+			Multiplicity: binderyv1alpha1.MultiplicityOne,
+			WorldRef:     &binderyv1alpha1.WorldRef{Name: "w1"},
+			Consumer:     binderyv1alpha1.ConsumerRef{ModuleManifestName: "core-interaction-engine"},
+			Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: "core-physics-engine", CapabilityVersion: "1.2.0"},
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, provider, binding).WithStatusSubresource(binding, world, &appsv1.Deployment{}).Build()
+	r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "binding-1"}}
+
+	servingStatus := func() metav1.ConditionStatus {
+		t.Helper()
+		var got binderyv1alpha1.CapabilityBinding
+		if err := cl.Get(ctx, req.NamespacedName, &got); err != nil {
+			t.Fatalf("get binding: %v", err)
+		}
+		cond := meta.FindStatusCondition(got.Status.Conditions, BindingConditionEndpointServing)
+		if cond == nil {
+			t.Fatalf("expected %s condition", BindingConditionEndpointServing)
+		}
+		return cond.Status
+	}
+
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if got := servingStatus(); got != metav1.ConditionFalse {
+		t.Fatalf("expected EndpointServing=False with zero available replicas, got %s", got)
+	}
+
+	var dep appsv1.Deployment
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "ns", Name: rtName(world.Name, provider.Name)}, &dep); err != nil {
+		t.Fatalf("expected deployment: %v", err)
+	}
+	dep.Status.Replicas = 1
+	dep.Status.ReadyReplicas = 1
+	dep.Status.AvailableReplicas = 1
+	if err := cl.Status().Update(ctx, &dep); err != nil {
+		t.Fatalf("update deployment status: %v", err)
+	}
+
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile 2: %v", err)
+	}
+	if got := servingStatus(); got != metav1.ConditionTrue {
+		t.Fatalf("expected EndpointServing=True once a replica is available, got %s", got)
+	}
+}
+
+func TestRuntimeOrchestrator_WithholdsDependencyEndpointUntilServing(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"},
+	}
+
+	// Physics is server-orchestrated, so its readiness gates injection.
+	physicsMM := &binderyv1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "physics-mod",
+			Namespace:   "default",
+			Annotations: map[string]string{annRuntimeImage: "physics:latest"},
+		},
+	}
+	gameMM := &binderyv1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "game-mod",
+			Namespace:   "default",
+			Annotations: map[string]string{annRuntimeImage: "game:latest"},
+		},
+	}
+
+	bindingDep := &binderyv1alpha1.CapabilityBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "binding-dep", Namespace: "default"},
+		Spec: binderyv1alpha1.CapabilityBindingSpec{
+			CapabilityID: "physics.engine",
+			WorldRef:     &binderyv1alpha1.WorldRef{Name: "world-1"},
+			Consumer:     binderyv1alpha1.ConsumerRef{ModuleManifestName: "game-mod"},
+			Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: "physics-mod"},
+		},
+		Status: binderyv1alpha1.CapabilityBindingStatus{
+			Provider: &binderyv1alpha1.ProviderStatus{
+				Endpoint: &binderyv1alpha1.EndpointRef{Type: "kubernetesService", Value: "physics-svc", Port: 8080},
+			},
+			Conditions: []metav1.Condition{{
+				Type:               BindingConditionEndpointServing,
+				Status:             metav1.ConditionFalse,
+				Reason:             "NoReplicasAvailable",
+				LastTransitionTime: metav1.Now(),
+			}},
+		},
+	}
+	bindingGame := &binderyv1alpha1.CapabilityBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "binding-game", Namespace: "default"},
+		Spec: binderyv1alpha1.CapabilityBindingSpec{
+			CapabilityID: "game.logic",
+			WorldRef:     &binderyv1alpha1.WorldRef{Name: "world-1"},
+			Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: "game-mod"},
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithIndex(&binderyv1alpha1.CapabilityBinding{}, idxBindingConsumer, func(rawObj client.Object) []string {
+			binding := rawObj.(*binderyv1alpha1.CapabilityBinding)
+			if binding.Spec.Consumer.ModuleManifestName == "" {
+				return nil
+			}
+			return []string{binding.Spec.Consumer.ModuleManifestName}
+		}).
+		WithObjects(world, physicsMM, gameMM, bindingDep, bindingGame).
+		WithStatusSubresource(bindingDep, bindingGame, world).
+		Build()
+
+	r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "binding-game"}}
+
+	hasEndpointEnv := func() bool {
+		t.Helper()
+		var dep appsv1.Deployment
+		if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: rtName("world-1", "game-mod")}, &dep); err != nil {
+			t.Fatalf("Deployment not found: %v", err)
+		}
+		for _, env := range dep.Spec.Template.Spec.Containers[0].Env {
+			if env.Name == "BINDERY_CAPABILITY_PHYSICS_ENGINE_ENDPOINT" {
+				return true
+			}
+		}
+		return false
+	}
+
+	res, err := r.Reconcile(ctx, req)
+	if err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if hasEndpointEnv() {
+		t.Fatalf("expected endpoint env to be withheld while provider is not serving")
+	}
+	if res.RequeueAfter == 0 {
+		t.Fatalf("expected requeue while dependency is not serving")
+	}
+
+	var latest binderyv1alpha1.CapabilityBinding
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "binding-dep"}, &latest); err != nil {
+		t.Fatalf("get binding dep: %v", err)
+	}
+	meta.SetStatusCondition(&latest.Status.Conditions, metav1.Condition{
+		Type:   BindingConditionEndpointServing,
+		Status: metav1.ConditionTrue,
+		Reason: "ReplicasAvailable",
+	})
+	if err := cl.Status().Update(ctx, &latest); err != nil {
+		t.Fatalf("update binding dep status: %v", err)
+	}
+
+	res, err = r.Reconcile(ctx, req)
+	if err != nil {
+		t.Fatalf("Reconcile 2: %v", err)
+	}
+	if !hasEndpointEnv() {
+		t.Fatalf("expected endpoint env once provider is serving")
+	}
+	if res.RequeueAfter != 0 {
+		t.Fatalf("expected no requeue once dependencies are serving, got %s", res.RequeueAfter)
+	}
+
+	// The provider stops serving (e.g. it rolls out or the world pauses): the consumer keeps
+	// its endpoint, so its pod template does not change.
+	var before appsv1.Deployment
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: rtName("world-1", "game-mod")}, &before); err != nil {
+		t.Fatalf("Deployment not found: %v", err)
+	}
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "binding-dep"}, &latest); err != nil {
+		t.Fatalf("get binding dep: %v", err)
+	}
+	meta.SetStatusCondition(&latest.Status.Conditions, metav1.Condition{
+		Type:   BindingConditionEndpointServing,
+		Status: metav1.ConditionFalse,
+		Reason: "NoReplicasAvailable",
+	})
+	if err := cl.Status().Update(ctx, &latest); err != nil {
+		t.Fatalf("update binding dep status: %v", err)
+	}
+	res, err = r.Reconcile(ctx, req)
+	if err != nil {
+		t.Fatalf("Reconcile 3: %v", err)
+	}
+	if !hasEndpointEnv() {
+		t.Fatalf("expected published endpoint env to be kept while provider is not serving")
+	}
+	if res.RequeueAfter != 0 {
+		t.Fatalf("expected no requeue for an already-published dependency, got %s", res.RequeueAfter)
+	}
+	var after appsv1.Deployment
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: rtName("world-1", "game-mod")}, &after); err != nil {
+		t.Fatalf("Deployment not found: %v", err)
+	}
+	if !reflect.DeepEqual(before.Spec.Template, after.Spec.Template) {
+		t.Fatalf("expected consumer pod template to stay unchanged")
+	}
+}
+
+func TestRuntimeOrchestrator_Replicas(t *testing.T) {
+	cases := []struct {
+		name         string
+		statefulness string
+		requested    int32
+		wantReplicas int32
+		wantRefused  bool
+	}{
+		{name: "stateless honors replicas", statefulness: binderyv1alpha1.StatefulnessStateless, requested: 3, wantReplicas: 3},
+		{name: "stateful refuses replicas", statefulness: binderyv1alpha1.StatefulnessStateful, requested: 3, wantReplicas: 1, wantRefused: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = binderyv1alpha1.AddToScheme(scheme)
+
+			world := &binderyv1alpha1.WorldInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"},
+				Spec:       binderyv1alpha1.WorldInstanceSpec{WorldID: "w1", ShardCount: 1},
+			}
+			requested := tc.requested
+			provider := &binderyv1alpha1.ModuleManifest{
+				ObjectMeta: metav1.ObjectMeta{Name: "provider-mod", Namespace: "default"},
+				Spec: binderyv1alpha1.ModuleManifestSpec{
+					Runtime: &binderyv1alpha1.ModuleRuntimeSpec{Image: "img", Replicas: &requested},
+					Scaling: binderyv1alpha1.ModuleScaling{DefaultScope: binderyv1alpha1.CapabilityScopeWorld, Statefulness: tc.statefulness},
+				},
+			}
+			binding := &binderyv1alpha1.CapabilityBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "binding-1", Namespace: "default"},
+				Spec: binderyv1alpha1.CapabilityBindingSpec{
+					WorldRef: &binderyv1alpha1.WorldRef{Name: "world-1"},
+					Provider: binderyv1alpha1.ProviderRef{ModuleManifestName: "provider-mod"},
+				},
+			}
+
+			cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, provider, binding).WithStatusSubresource(binding, world).Build()
+			recorder := record.NewFakeRecorder(10)
+			r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme, Recorder: recorder}
+
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "binding-1"}}); err != nil {
+				t.Fatalf("Reconcile failed: %v", err)
+			}
+
+			key := types.NamespacedName{Namespace: "default", Name: rtName("world-1", "provider-mod")}
+			var gotReplicas *int32
+			if tc.statefulness == binderyv1alpha1.StatefulnessStateful {
+				var sts appsv1.StatefulSet
+				if err := cl.Get(ctx, key, &sts); err != nil {
+					t.Fatalf("StatefulSet not found: %v", err)
+				}
+				gotReplicas = sts.Spec.Replicas
+			} else {
+				var dep appsv1.Deployment
+				if err := cl.Get(ctx, key, &dep); err != nil {
+					t.Fatalf("Deployment not found: %v", err)
+				}
+				gotReplicas = dep.Spec.Replicas
+			}
+			if gotReplicas == nil || *gotReplicas != tc.wantReplicas {
+				t.Fatalf("expected %d replicas, got %v", tc.wantReplicas, gotReplicas)
+			}
+
+			refused := false
+			for len(recorder.Events) > 0 {
+				if strings.Contains(<-recorder.Events, "ReplicasRefused") {
+					refused = true
+				}
+			}
+			if refused != tc.wantRefused {
+				t.Fatalf("expected ReplicasRefused event=%t, got %t", tc.wantRefused, refused)
+			}
+		})
+	}
+}
+
+func TestRuntimeOrchestrator_WorkloadKindFollowsStatefulness(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"},
+		Spec:       binderyv1alpha1.WorldInstanceSpec{WorldID: "w1", ShardCount: 1},
+	}
+	module := func(name, statefulness string) *binderyv1alpha1.ModuleManifest {
+		return &binderyv1alpha1.ModuleManifest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: binderyv1alpha1.ModuleManifestSpec{
+				Runtime: &binderyv1alpha1.ModuleRuntimeSpec{Image: "img"},
+				Scaling: binderyv1alpha1.ModuleScaling{DefaultScope: binderyv1alpha1.CapabilityScopeWorld, Statefulness: statefulness},
+			},
+		}
+	}
+	binding := func(name, provider string) *binderyv1alpha1.CapabilityBinding {
+		return &binderyv1alpha1.CapabilityBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: binderyv1alpha1.CapabilityBindingSpec{
+				WorldRef: &binderyv1alpha1.WorldRef{Name: "world-1"},
+				Provider: binderyv1alpha1.ProviderRef{ModuleManifestName: provider},
+			},
+		}
+	}
+	statefulBinding := binding("binding-stateful", "stateful-mod")
+	statelessBinding := binding("binding-stateless", "stateless-mod")
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(world, module("stateful-mod", binderyv1alpha1.StatefulnessStateful), module("stateless-mod", binderyv1alpha1.StatefulnessStateless), statefulBinding, statelessBinding).
+		WithStatusSubresource(statefulBinding, statelessBinding, world).
+		Build()
+	r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme}
+
+	for _, name := range []string{"binding-stateful", "binding-stateless"} {
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: name}}); err != nil {
+			t.Fatalf("Reconcile %s: %v", name, err)
+		}
+	}
+
+	statefulKey := types.NamespacedName{Namespace: "default", Name: rtName("world-1", "stateful-mod")}
+	var sts appsv1.StatefulSet
+	if err := cl.Get(ctx, statefulKey, &sts); err != nil {
+		t.Fatalf("expected StatefulSet for stateful module: %v", err)
+	}
+	if sts.Spec.ServiceName != statefulKey.Name {
+		t.Fatalf("expected StatefulSet serviceName %q, got %q", statefulKey.Name, sts.Spec.ServiceName)
+	}
+	if err := cl.Get(ctx, statefulKey, &appsv1.Deployment{}); err == nil {
+		t.Fatalf("did not expect a Deployment for stateful module")
+	}
+	var headless corev1.Service
+	if err := cl.Get(ctx, statefulKey, &headless); err != nil {
+		t.Fatalf("expected Service for stateful module: %v", err)
+	}
+	if headless.Spec.ClusterIP != corev1.ClusterIPNone {
+		t.Fatalf("expected headless Service, got clusterIP %q", headless.Spec.ClusterIP)
+	}
+
+	statelessKey := types.NamespacedName{Namespace: "default", Name: rtName("world-1", "stateless-mod")}
+	if err := cl.Get(ctx, statelessKey, &appsv1.Deployment{}); err != nil {
+		t.Fatalf("expected Deployment for stateless module: %v", err)
+	}
+	if err := cl.Get(ctx, statelessKey, &appsv1.StatefulSet{}); err == nil {
+		t.Fatalf("did not expect a StatefulSet for stateless module")
+	}
+
+	var got binderyv1alpha1.CapabilityBinding
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "binding-stateful"}, &got); err != nil {
+		t.Fatalf("get binding: %v", err)
+	}
+	if got.Status.Provider == nil || got.Status.Provider.Endpoint == nil || got.Status.Provider.Endpoint.Value != statefulKey.Name {
+		t.Fatalf("expected endpoint on headless service, got %#v", got.Status.Provider)
+	}
+}
+
+func TestRuntimeOrchestrator_RecreatesClusterIPServiceAsHeadlessForStatefulModule(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"},
+		Spec:       binderyv1alpha1.WorldInstanceSpec{WorldID: "w1", ShardCount: 1},
+	}
+	mm := &binderyv1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "stateful-mod", Namespace: "default"},
+		Spec: binderyv1alpha1.ModuleManifestSpec{
+			Runtime: &binderyv1alpha1.ModuleRuntimeSpec{Image: "img"},
+			Scaling: binderyv1alpha1.ModuleScaling{DefaultScope: binderyv1alpha1.CapabilityScopeWorld, Statefulness: binderyv1alpha1.StatefulnessStateful},
+		},
+	}
+	binding := &binderyv1alpha1.CapabilityBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "binding-stateful", Namespace: "default"},
+		Spec: binderyv1alpha1.CapabilityBindingSpec{
+			WorldRef: &binderyv1alpha1.WorldRef{Name: "world-1"},
+			Provider: binderyv1alpha1.ProviderRef{ModuleManifestName: "stateful-mod"},
+		},
+	}
+	// Created while the module was still stateless.
+	svcKey := types.NamespacedName{Namespace: "default", Name: rtName("world-1", "stateful-mod")}
+	oldSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: svcKey.Name, Namespace: "default", Labels: map[string]string{rtLabelManagedBy: rtManagedBy}},
+		Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP, ClusterIP: "10.96.0.10"},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(world, mm, binding, oldSvc).
+		WithStatusSubresource(binding, world).
+		Build()
+	rec := record.NewFakeRecorder(10)
+	r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme, Recorder: rec}
+
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "binding-stateful"}}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	var svc corev1.Service
+	if err := cl.Get(ctx, svcKey, &svc); err != nil {
+		t.Fatalf("expected Service to be recreated: %v", err)
+	}
+	if svc.Spec.ClusterIP != corev1.ClusterIPNone {
+		t.Fatalf("expected recreated Service to be headless, got clusterIP %q", svc.Spec.ClusterIP)
+	}
+	var sawRecreated bool
+	for len(rec.Events) > 0 {
+		if e := <-rec.Events; strings.Contains(e, "ServiceRecreated") {
+			sawRecreated = true
+		}
+	}
+	if !sawRecreated {
+		t.Fatalf("expected a ServiceRecreated event")
+	}
+}
+
+func TestRuntimeOrchestrator_PauseScalesToZeroAndResumeRestores(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"},
+		Spec:       binderyv1alpha1.WorldInstanceSpec{WorldID: "w1", ShardCount: 1, DesiredState: binderyv1alpha1.WorldDesiredStatePaused},
+	}
+	requested := int32(2)
+	provider := &binderyv1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "provider-mod", Namespace: "default"},
+		Spec: binderyv1alpha1.ModuleManifestSpec{
+			Runtime: &binderyv1alpha1.ModuleRuntimeSpec{Image: "img", Replicas: &requested},
+			Scaling: binderyv1alpha1.ModuleScaling{DefaultScope: binderyv1alpha1.CapabilityScopeWorld, Statefulness: binderyv1alpha1.StatefulnessStateless},
+		},
+	}
+	binding := &binderyv1alpha1.CapabilityBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "binding-1", Namespace: "default"},
+		Spec: binderyv1alpha1.CapabilityBindingSpec{
+			WorldRef: &binderyv1alpha1.WorldRef{Name: "world-1"},
+			Provider: binderyv1alpha1.ProviderRef{ModuleManifestName: "provider-mod"},
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, provider, binding).WithStatusSubresource(binding, world).Build()
+	r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "binding-1"}}
+	key := types.NamespacedName{Namespace: "default", Name: rtName("world-1", "provider-mod")}
+
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile (paused) failed: %v", err)
+	}
+	var dep appsv1.Deployment
+	if err := cl.Get(ctx, key, &dep); err != nil {
+		t.Fatalf("Deployment not found: %v", err)
+	}
+	if dep.Spec.Replicas == nil || *dep.Spec.Replicas != 0 {
+		t.Fatalf("expected paused world to scale to 0 replicas, got %v", dep.Spec.Replicas)
+	}
+	if err := cl.Get(ctx, key, &corev1.Service{}); err != nil {
+		t.Fatalf("expected Service to be preserved while paused: %v", err)
+	}
+	var got binderyv1alpha1.CapabilityBinding
+	if err := cl.Get(ctx, req.NamespacedName, &got); err != nil {
+		t.Fatalf("get binding: %v", err)
+	}
+	serving := meta.FindStatusCondition(got.Status.Conditions, BindingConditionEndpointServing)
+	if serving == nil || serving.Status != metav1.ConditionFalse || serving.Reason != "WorldPaused" {
+		t.Fatalf("expected EndpointServing=False/WorldPaused, got %#v", serving)
+	}
+
+	var latest binderyv1alpha1.WorldInstance
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "world-1"}, &latest); err != nil {
+		t.Fatalf("get world: %v", err)
+	}
+	latest.Spec.DesiredState = binderyv1alpha1.WorldDesiredStateRunning
+	if err := cl.Update(ctx, &latest); err != nil {
+		t.Fatalf("resume world: %v", err)
+	}
+
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile (resumed) failed: %v", err)
+	}
+	if err := cl.Get(ctx, key, &dep); err != nil {
+		t.Fatalf("Deployment not found: %v", err)
+	}
+	if dep.Spec.Replicas == nil || *dep.Spec.Replicas != 2 {
+		t.Fatalf("expected resumed world to restore 2 replicas, got %v", dep.Spec.Replicas)
+	}
+}
+
+func TestRuntimeOrchestrator_TLSMountsSecretAndPublishesGRPCS(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"},
+		Spec:       binderyv1alpha1.WorldInstanceSpec{WorldID: "w1", ShardCount: 1},
+	}
+	provider := &binderyv1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "provider-mod", Namespace: "default"},
+		Spec: binderyv1alpha1.ModuleManifestSpec{
+			Runtime: &binderyv1alpha1.ModuleRuntimeSpec{
+				Image: "img",
+				TLS:   &binderyv1alpha1.ModuleTLSSpec{SecretName: "provider-tls", ClientAuth: true},
+			},
+		},
+	}
+	binding := &binderyv1alpha1.CapabilityBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "binding-1", Namespace: "default"},
+		Spec: binderyv1alpha1.CapabilityBindingSpec{
+			CapabilityID: "physics.engine",
+			WorldRef:     &binderyv1alpha1.WorldRef{Name: "world-1"},
+			Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: "provider-mod"},
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, provider, binding).WithStatusSubresource(binding, world).Build()
+	r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "binding-1"}}); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+
+	var dep appsv1.Deployment
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: rtName(world.Name, provider.Name)}, &dep); err != nil {
+		t.Fatalf("Deployment not found: %v", err)
+	}
+
+	var secretVol *corev1.Volume
+	for i := range dep.Spec.Template.Spec.Volumes {
+		if v := &dep.Spec.Template.Spec.Volumes[i]; v.Name == tlsVolumeName {
+			secretVol = v
+		}
+	}
+	if secretVol == nil || secretVol.Secret == nil || secretVol.Secret.SecretName != "provider-tls" {
+		t.Fatalf("expected TLS secret volume, got %#v", dep.Spec.Template.Spec.Volumes)
+	}
+
+	container := dep.Spec.Template.Spec.Containers[0]
+	mounted := false
+	for _, m := range container.VolumeMounts {
+		if m.Name == tlsVolumeName && m.MountPath == tlsMountPath && m.ReadOnly {
+			mounted = true
+		}
+	}
+	if !mounted {
+		t.Fatalf("expected read-only TLS mount at %s, got %#v", tlsMountPath, container.VolumeMounts)
+	}
+	env := map[string]string{}
+	for _, e := range container.Env {
+		env[e.Name] = e.Value
+	}
+	for name, want := range map[string]string{
+		"BINDERY_TLS_CERT_FILE":      tlsMountPath + "/tls.crt",
+		"BINDERY_TLS_KEY_FILE":       tlsMountPath + "/tls.key",
+		"BINDERY_TLS_CLIENT_CA_FILE": tlsMountPath + "/ca.crt",
+	} {
+		if env[name] != want {
+			t.Fatalf("expected %s=%q, got %q", name, want, env[name])
+		}
+	}
+
+	var got binderyv1alpha1.CapabilityBinding
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "binding-1"}, &got); err != nil {
+		t.Fatalf("get binding: %v", err)
+	}
+	if got.Status.Provider == nil || got.Status.Provider.Endpoint == nil || got.Status.Provider.Endpoint.Scheme != binderyv1alpha1.EndpointSchemeGRPCS {
+		t.Fatalf("expected grpcs endpoint, got %#v", got.Status.Provider)
+	}
+
+	// Dropping the TLS declaration republishes a plaintext endpoint.
+	var mm binderyv1alpha1.ModuleManifest
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "provider-mod"}, &mm); err != nil {
+		t.Fatalf("get manifest: %v", err)
+	}
+	mm.Spec.Runtime.TLS = nil
+	if err := cl.Update(ctx, &mm); err != nil {
+		t.Fatalf("update manifest: %v", err)
+	}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "binding-1"}}); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "binding-1"}, &got); err != nil {
+		t.Fatalf("get binding: %v", err)
+	}
+	if got.Status.Provider.Endpoint.Scheme != binderyv1alpha1.EndpointSchemeGRPC {
+		t.Fatalf("expected grpc endpoint after TLS removal, got %q", got.Status.Provider.Endpoint.Scheme)
+	}
+}
+
+func TestRuntimeOrchestrator_ContainerSecurityContext(t *testing.T) {
+	uid := int64(1000)
+	allowRoot := false
+	for _, tc := range []struct {
+		name        string
+		sc          *binderyv1alpha1.ModuleSecurityContext
+		wantUID     int64
+		wantRO      bool
+		wantDrop    []corev1.Capability
+		wantAdd     []corev1.Capability
+		wantNonRoot bool
+	}{
+		{name: "defaults", wantUID: binderyv1alpha1.DefaultModuleRunAsUser, wantDrop: []corev1.Capability{"ALL"}, wantNonRoot: true},
+		{
+			name: "override",
+			sc: &binderyv1alpha1.ModuleSecurityContext{
+				RunAsNonRoot:           &allowRoot,
+				RunAsUser:              &uid,
+				ReadOnlyRootFilesystem: true,
+				DropCapabilities:       []string{"NET_RAW"},
+				AddCapabilities:        []string{"NET_BIND_SERVICE"},
+			},
+			wantUID:  1000,
+			wantRO:   true,
+			wantDrop: []corev1.Capability{"NET_RAW"},
+			wantAdd:  []corev1.Capability{"NET_BIND_SERVICE"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = binderyv1alpha1.AddToScheme(scheme)
+
+			world := &binderyv1alpha1.WorldInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"},
+				Spec:       binderyv1alpha1.WorldInstanceSpec{WorldID: "w1", ShardCount: 1},
+			}
+			provider := &binderyv1alpha1.ModuleManifest{
+				ObjectMeta: metav1.ObjectMeta{Name: "provider-mod", Namespace: "default"},
+				Spec: binderyv1alpha1.ModuleManifestSpec{
+					Runtime: &binderyv1alpha1.ModuleRuntimeSpec{Image: "img", SecurityContext: tc.sc},
+				},
+			}
+			binding := &binderyv1alpha1.CapabilityBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "binding-1", Namespace: "default"},
+				Spec: binderyv1alpha1.CapabilityBindingSpec{
+					CapabilityID: "physics.engine",
+					WorldRef:     &binderyv1alpha1.WorldRef{Name: "world-1"},
+					Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: "provider-mod"},
+				},
+			}
+
+			cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, provider, binding).WithStatusSubresource(binding, world).Build()
+			r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme}
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "binding-1"}}); err != nil {
+				t.Fatalf("Reconcile failed: %v", err)
+			}
+
+			var dep appsv1.Deployment
+			if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: rtName(world.Name, provider.Name)}, &dep); err != nil {
+				t.Fatalf("Deployment not found: %v", err)
+			}
+			psc := dep.Spec.Template.Spec.SecurityContext
+			if psc == nil || psc.SeccompProfile == nil || psc.SeccompProfile.Type != corev1.SeccompProfileTypeRuntimeDefault {
+				t.Fatalf("expected RuntimeDefault seccomp on the pod, got %#v", psc)
+			}
+
+			sc := dep.Spec.Template.Spec.Containers[0].SecurityContext
+			if sc == nil {
+				t.Fatalf("expected a container security context")
+			}
+			if sc.RunAsNonRoot == nil || *sc.RunAsNonRoot != tc.wantNonRoot {
+				t.Fatalf("expected runAsNonRoot=%v, got %v", tc.wantNonRoot, sc.RunAsNonRoot)
+			}
+			if sc.RunAsUser == nil || *sc.RunAsUser != tc.wantUID {
+				t.Fatalf("expected runAsUser=%d, got %v", tc.wantUID, sc.RunAsUser)
+			}
+			if sc.ReadOnlyRootFilesystem == nil || *sc.ReadOnlyRootFilesystem != tc.wantRO {
+				t.Fatalf("expected readOnlyRootFilesystem=%v, got %v", tc.wantRO, sc.ReadOnlyRootFilesystem)
+			}
+			if sc.AllowPrivilegeEscalation == nil || *sc.AllowPrivilegeEscalation {
+				t.Fatalf("expected allowPrivilegeEscalation=false, got %v", sc.AllowPrivilegeEscalation)
+			}
+			if sc.Capabilities == nil || !reflect.DeepEqual(sc.Capabilities.Drop, tc.wantDrop) || !reflect.DeepEqual(sc.Capabilities.Add, tc.wantAdd) {
+				t.Fatalf("expected capabilities drop=%v add=%v, got %#v", tc.wantDrop, tc.wantAdd, sc.Capabilities)
+			}
+		})
+	}
+}
+
+func TestMergePodSecurityContext_KeepsColocatedModuleFSGroup(t *testing.T) {
+	withStorage := podSecurityContext(&binderyv1alpha1.ModuleRuntimeSpec{Image: "img"}, true)
+	withoutStorage := podSecurityContext(&binderyv1alpha1.ModuleRuntimeSpec{Image: "img"}, false)
+
+	// Either reconcile order leaves the pod with the storage module's fsGroup.
+	for name, got := range map[string]*corev1.PodSecurityContext{
+		"storage first": mergePodSecurityContext(mergePodSecurityContext(nil, withStorage), withoutStorage),
+		"storage last":  mergePodSecurityContext(mergePodSecurityContext(nil, withoutStorage), withStorage),
+	} {
+		if got.FSGroup == nil || *got.FSGroup != binderyv1alpha1.DefaultModuleRunAsUser {
+			t.Fatalf("%s: expected fsGroup %d, got %v", name, binderyv1alpha1.DefaultModuleRunAsUser, got.FSGroup)
+		}
+		if got.SeccompProfile == nil || got.SeccompProfile.Type != corev1.SeccompProfileTypeRuntimeDefault {
+			t.Fatalf("%s: expected RuntimeDefault seccomp profile, got %v", name, got.SeccompProfile)
+		}
+	}
+	if withoutStorage.FSGroup != nil {
+		t.Fatalf("merge must not modify its inputs")
+	}
+}
+
+func TestRuntimeOrchestrator_ImagePullSecretsOnPodTemplate(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"},
+		Spec:       binderyv1alpha1.WorldInstanceSpec{WorldID: "w1", ShardCount: 1},
+	}
+	provider := &binderyv1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "provider-mod", Namespace: "default"},
+		Spec: binderyv1alpha1.ModuleManifestSpec{
+			Runtime: &binderyv1alpha1.ModuleRuntimeSpec{
+				Image:            "registry.example.com/provider:1.0.0",
+				ImagePullSecrets: []corev1.LocalObjectReference{{Name: "registry-creds"}, {Name: "registry-creds"}},
+			},
+		},
+	}
+	binding := &binderyv1alpha1.CapabilityBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "binding-1", Namespace: "default"},
+		Spec: binderyv1alpha1.CapabilityBindingSpec{
+			CapabilityID: "physics.engine",
+			WorldRef:     &binderyv1alpha1.WorldRef{Name: "world-1"},
+			Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: "provider-mod"},
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, provider, binding).WithStatusSubresource(binding, world).Build()
+	r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme}
+	for i := 0; i < 2; i++ {
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "binding-1"}}); err != nil {
+			t.Fatalf("Reconcile %d failed: %v", i, err)
+		}
+	}
+
+	var dep appsv1.Deployment
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: rtName(world.Name, provider.Name)}, &dep); err != nil {
+		t.Fatalf("Deployment not found: %v", err)
+	}
+	want := []corev1.LocalObjectReference{{Name: "registry-creds"}}
+	if got := dep.Spec.Template.Spec.ImagePullSecrets; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected imagePullSecrets %v, got %v", want, got)
+	}
+}
+
+func TestRuntimeOrchestrator_PropagatesWhitelistedModuleMetadata(t *testing.T) {
+	t.Setenv(envPropagateMetadataPrefixes, "example.com/")
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"},
+		Spec:       binderyv1alpha1.WorldInstanceSpec{WorldID: "w1", ShardCount: 1},
+	}
+	provider := &binderyv1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "provider-mod",
+			Namespace: "default",
+			Labels: map[string]string{
+				"example.com/cost-center": "cc-42",
+				"unlisted":                "x",
+				rtLabelManagedBy:          "someone-else",
+			},
+			Annotations: map[string]string{"example.com/team": "core"},
+		},
+		Spec: binderyv1alpha1.ModuleManifestSpec{Runtime: &binderyv1alpha1.ModuleRuntimeSpec{Image: "img"}},
+	}
+	binding := &binderyv1alpha1.CapabilityBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "binding-1", Namespace: "default"},
+		Spec: binderyv1alpha1.CapabilityBindingSpec{
+			CapabilityID: "physics.engine",
+			WorldRef:     &binderyv1alpha1.WorldRef{Name: "world-1"},
+			Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: "provider-mod"},
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, provider, binding).WithStatusSubresource(binding, world).Build()
+	r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "binding-1"}}); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+
+	var dep appsv1.Deployment
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: rtName(world.Name, provider.Name)}, &dep); err != nil {
+		t.Fatalf("Deployment not found: %v", err)
+	}
+	var svc corev1.Service
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: rtName(world.Name, provider.Name)}, &svc); err != nil {
+		t.Fatalf("Service not found: %v", err)
+	}
+	for kind, obj := range map[string]metav1.Object{"Deployment": &dep, "Service": &svc} {
+		labels := obj.GetLabels()
+		if labels["example.com/cost-center"] != "cc-42" {
+			t.Fatalf("%s: expected propagated cost-center label, got %v", kind, labels)
+		}
+		if _, ok := labels["unlisted"]; ok {
+			t.Fatalf("%s: label outside the prefix list was propagated: %v", kind, labels)
+		}
+		if labels[rtLabelManagedBy] != rtManagedBy || labels[rtLabelWorldName] != world.Name {
+			t.Fatalf("%s: management labels were clobbered: %v", kind, labels)
+		}
+		if obj.GetAnnotations()["example.com/team"] != "core" {
+			t.Fatalf("%s: expected propagated team annotation, got %v", kind, obj.GetAnnotations())
+		}
+	}
+}
+
+func TestRuntimeOrchestrator_WorldStatusListsPublishedEndpoints(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"},
+		Spec:       binderyv1alpha1.WorldInstanceSpec{WorldID: "w1", ShardCount: 1},
+	}
+	provider := &binderyv1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "core-physics-engine", Namespace: "default"},
+		Spec:       binderyv1alpha1.ModuleManifestSpec{Runtime: &binderyv1alpha1.ModuleRuntimeSpec{Image: "img"}},
+	}
+	binding := &binderyv1alpha1.CapabilityBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "binding-1",
+			Namespace: "default",
+			Labels:    map[string]string{labelManagedBy: managedByCapabilityResolver, labelWorldName: "world-1"},
+		},
+		Spec: binderyv1alpha1.CapabilityBindingSpec{
+			CapabilityID: "physics.engine",
+			Scope:        binderyv1alpha1.CapabilityScopeWorld,
+			WorldRef:     &binderyv1alpha1.WorldRef{Name: "world-1"},
+			Consumer:     binderyv1alpha1.ConsumerRef{ModuleManifestName: "core-interaction-engine"},
+			Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: "core-physics-engine"},
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, provider, binding).WithStatusSubresource(binding, world).Build()
+	r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "binding-1"}}); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+
+	var got binderyv1alpha1.WorldInstance
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "world-1"}, &got); err != nil {
+		t.Fatalf("get world: %v", err)
+	}
+	if len(got.Status.Endpoints) != 1 {
+		t.Fatalf("expected 1 world endpoint, got %#v", got.Status.Endpoints)
+	}
+	ep := got.Status.Endpoints[0]
+	want := rtName(world.Name, provider.Name) + ":50051"
+	if ep.CapabilityID != "physics.engine" || ep.Provider != "core-physics-engine" || ep.Endpoint != want || ep.Scheme != binderyv1alpha1.EndpointSchemeGRPC {
+		t.Fatalf("unexpected world endpoint: %#v (want endpoint %s)", ep, want)
+	}
+	if ep.Serving {
+		t.Fatalf("expected endpoint not to be serving before any replica is available")
+	}
+}
+
+func TestRuntimeOrchestrator_ExternalProviderPublishesEndpointWithoutWorkload(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"},
+		Spec:       binderyv1alpha1.WorldInstanceSpec{WorldID: "w1", ShardCount: 1},
+	}
+	bus := &binderyv1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "managed-nats", Namespace: "default"},
+		Spec: binderyv1alpha1.ModuleManifestSpec{
+			External: &binderyv1alpha1.ModuleExternalSpec{Endpoint: "nats://bus.example.com:4222"},
+		},
+	}
+	gateway := &binderyv1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "default"},
+		Spec:       binderyv1alpha1.ModuleManifestSpec{Runtime: &binderyv1alpha1.ModuleRuntimeSpec{Image: "gateway:latest"}},
+	}
+	busBinding := &binderyv1alpha1.CapabilityBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "binding-bus", Namespace: "default"},
+		Spec: binderyv1alpha1.CapabilityBindingSpec{
+			CapabilityID: "messaging.bus",
+			Scope:        binderyv1alpha1.CapabilityScopeWorld,
+			WorldRef:     &binderyv1alpha1.WorldRef{Name: "world-1"},
+			Consumer:     binderyv1alpha1.ConsumerRef{ModuleManifestName: "gateway"},
+			Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: "managed-nats"},
+		},
+	}
+	gatewayBinding := &binderyv1alpha1.CapabilityBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "binding-gateway", Namespace: "default"},
+		Spec: binderyv1alpha1.CapabilityBindingSpec{
+			CapabilityID: "gateway.ingress",
+			Scope:        binderyv1alpha1.CapabilityScopeWorld,
+			WorldRef:     &binderyv1alpha1.WorldRef{Name: "world-1"},
+			Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: "gateway"},
+		},
+	}
+
+	cl := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithIndex(&binderyv1alpha1.CapabilityBinding{}, idxBindingConsumer, func(rawObj client.Object) []string {
+			binding := rawObj.(*binderyv1alpha1.CapabilityBinding)
+			if binding.Spec.Consumer.ModuleManifestName == "" {
+				return nil
+			}
+			return []string{binding.Spec.Consumer.ModuleManifestName}
+		}).
+		WithObjects(world, bus, gateway, busBinding, gatewayBinding).
+		WithStatusSubresource(busBinding, gatewayBinding, world).
+		Build()
+	r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme}
+
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "binding-bus"}}); err != nil {
+		t.Fatalf("Reconcile external binding: %v", err)
+	}
+	var got binderyv1alpha1.CapabilityBinding
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "binding-bus"}, &got); err != nil {
+		t.Fatalf("get binding: %v", err)
+	}
+	if got.Status.Provider == nil || got.Status.Provider.Endpoint == nil {
+		t.Fatalf("expected external endpoint to be published")
+	}
+	ep := got.Status.Provider.Endpoint
+	if ep.Type != binderyv1alpha1.EndpointTypeExternal || ep.Value != "nats://bus.example.com:4222" || ep.Scheme != "nats" {
+		t.Fatalf("unexpected external endpoint: %#v", ep)
+	}
+	if !meta.IsStatusConditionTrue(got.Status.Conditions, BindingConditionRuntimeReady) {
+		t.Fatalf("expected RuntimeReady=True for external binding")
+	}
+
+	var deps appsv1.DeploymentList
+	if err := cl.List(ctx, &deps, client.InNamespace("default")); err != nil {
+		t.Fatalf("list deployments: %v", err)
+	}
+	var svcs corev1.ServiceList
+	if err := cl.List(ctx, &svcs, client.InNamespace("default")); err != nil {
+		t.Fatalf("list services: %v", err)
+	}
+	if len(deps.Items) != 0 || len(svcs.Items) != 0 {
+		t.Fatalf("expected no workload for an external provider, got %d deployments and %d services", len(deps.Items), len(svcs.Items))
+	}
+
+	// The consumer still gets the standard endpoint env var.
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "binding-gateway"}}); err != nil {
+		t.Fatalf("Reconcile gateway binding: %v", err)
+	}
+	var dep appsv1.Deployment
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: rtName("world-1", "gateway")}, &dep); err != nil {
+		t.Fatalf("gateway deployment not found: %v", err)
+	}
+	env := map[string]string{}
+	for _, e := range dep.Spec.Template.Spec.Containers[0].Env {
+		env[e.Name] = e.Value
+	}
+	if got := env["BINDERY_CAPABILITY_MESSAGING_BUS_ENDPOINT"]; got != "nats://bus.example.com:4222" {
+		t.Fatalf("expected external endpoint injected verbatim, got %q", got)
+	}
+}
+
+func TestExternalEndpointRef(t *testing.T) {
+	ep, err := externalEndpointRef(&binderyv1alpha1.ModuleExternalSpec{Endpoint: "bus.example.com:4222"})
+	if err != nil {
+		t.Fatalf("externalEndpointRef: %v", err)
+	}
+	if ep.Value != "bus.example.com" || ep.Port != 4222 || ep.Scheme != binderyv1alpha1.EndpointSchemeGRPC {
+		t.Fatalf("unexpected host:port endpoint: %#v", ep)
+	}
+	for _, bad := range []string{"", "bus.example.com", "bus.example.com:0"} {
+		if _, err := externalEndpointRef(&binderyv1alpha1.ModuleExternalSpec{Endpoint: bad}); err == nil {
+			t.Fatalf("expected %q to be rejected", bad)
+		}
+	}
+}
@@ -316,7 +316,8 @@ func TestIntegration_RuntimeOrchestrator_ShardStorage_CreatesClaimMountAndPVC(t
 			return false, nil
 		}
 
-		var dep appsv1.Deployment
+		// Stateful modules are materialized as StatefulSets.
+		var dep appsv1.StatefulSet
 		if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: ns.Name, Name: workloadName}, &dep); err != nil {
 			return false, client.IgnoreNotFound(err)
 		}
@@ -356,7 +357,7 @@ func TestIntegration_RuntimeOrchestrator_ShardStorage_CreatesClaimMountAndPVC(t
 		}
 		return true, nil
 	}); err != nil {
-		t.Fatalf("wait for claim+deployment+status: %v", err)
+		t.Fatalf("wait for claim+statefulset+status: %v", err)
 	}
 
 	// Now reconcile the StorageOrchestrator and verify the PVC is created.
@@ -2,15 +2,20 @@ package controllers
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	binderyv1alpha1 "github.com/bayleafwalker/bindery-core/api/v1alpha1"
@@ -100,3 +105,363 @@ func TestRuntimeOrchestrator_PropagatesSchedulingConstraints(t *testing.T) {
 		t.Errorf("Expected toleration not found in %v", dep.Spec.Template.Spec.Tolerations)
 	}
 }
+
+func TestRuntimeOrchestrator_ColocationStrategies(t *testing.T) {
+	for _, tc := range []struct {
+		strategy     string
+		wantAffinity bool
+		wantWarning  bool
+	}{
+		{strategy: binderyv1alpha1.ColocationStrategyNode, wantAffinity: true},
+		{strategy: binderyv1alpha1.ColocationStrategyNone},
+		{strategy: "Rack", wantWarning: true},
+	} {
+		t.Run(tc.strategy, func(t *testing.T) {
+			ctx := context.Background()
+
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = binderyv1alpha1.AddToScheme(scheme)
+
+			world := &binderyv1alpha1.WorldInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "coloc-world", Namespace: "default"},
+				Spec:       binderyv1alpha1.WorldInstanceSpec{GameRef: binderyv1alpha1.ObjectRef{Name: "coloc-game"}, WorldID: "w1", ShardCount: 1},
+			}
+			booklet := &binderyv1alpha1.Booklet{
+				ObjectMeta: metav1.ObjectMeta{Name: "coloc-game", Namespace: "default"},
+				Spec: binderyv1alpha1.BookletSpec{
+					Colocation: []binderyv1alpha1.ColocationGroup{{Name: "sim", Modules: []string{"physics"}, Strategy: tc.strategy}},
+				},
+			}
+			provider := &binderyv1alpha1.ModuleManifest{
+				ObjectMeta: metav1.ObjectMeta{Name: "physics", Namespace: "default"},
+				Spec:       binderyv1alpha1.ModuleManifestSpec{Runtime: &binderyv1alpha1.ModuleRuntimeSpec{Image: "img"}},
+			}
+			binding := &binderyv1alpha1.CapabilityBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "coloc-binding", Namespace: "default"},
+				Spec: binderyv1alpha1.CapabilityBindingSpec{
+					CapabilityID: "physics.engine",
+					Scope:        binderyv1alpha1.CapabilityScopeWorld,
+					WorldRef:     &binderyv1alpha1.WorldRef{Name: "coloc-world"},
+					Consumer:     binderyv1alpha1.ConsumerRef{ModuleManifestName: "consumer"},
+					Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: "physics"},
+				},
+			}
+
+			cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, booklet, provider, binding).WithStatusSubresource(binding, world).Build()
+			rec := record.NewFakeRecorder(10)
+			r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme, Recorder: rec}
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "coloc-binding"}}); err != nil {
+				t.Fatalf("Reconcile: %v", err)
+			}
+
+			var dep appsv1.Deployment
+			if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: rtName(world.Name, provider.Name)}, &dep); err != nil {
+				t.Fatalf("expected module deployment: %v", err)
+			}
+			aff := dep.Spec.Template.Spec.Affinity
+			hasAffinity := aff != nil && aff.PodAffinity != nil && len(aff.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution) > 0
+			if hasAffinity != tc.wantAffinity {
+				t.Fatalf("expected pod affinity=%v, got %#v", tc.wantAffinity, aff)
+			}
+
+			warned := false
+			for len(rec.Events) > 0 {
+				if ev := <-rec.Events; strings.Contains(ev, "UnknownColocationStrategy") {
+					warned = true
+				}
+			}
+			if warned != tc.wantWarning {
+				t.Fatalf("expected UnknownColocationStrategy warning=%v", tc.wantWarning)
+			}
+		})
+	}
+}
+
+func TestRuntimeOrchestrator_NodeColocationAffinityIsPerShard(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "coloc-world", Namespace: "default"},
+		Spec:       binderyv1alpha1.WorldInstanceSpec{GameRef: binderyv1alpha1.ObjectRef{Name: "coloc-game"}, WorldID: "w1", ShardCount: 2},
+	}
+	booklet := &binderyv1alpha1.Booklet{
+		ObjectMeta: metav1.ObjectMeta{Name: "coloc-game", Namespace: "default"},
+		Spec: binderyv1alpha1.BookletSpec{
+			Colocation: []binderyv1alpha1.ColocationGroup{{Name: "sim", Modules: []string{"physics"}, Strategy: binderyv1alpha1.ColocationStrategyNode}},
+		},
+	}
+	provider := &binderyv1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "physics", Namespace: "default"},
+		Spec:       binderyv1alpha1.ModuleManifestSpec{Runtime: &binderyv1alpha1.ModuleRuntimeSpec{Image: "img"}},
+	}
+	objs := []client.Object{world, booklet, provider}
+	for _, id := range []int32{0, 1} {
+		shardID := fmt.Sprintf("%d", id)
+		objs = append(objs,
+			&binderyv1alpha1.WorldShard{
+				ObjectMeta: metav1.ObjectMeta{Name: stableWorldShardName(world.Name, id), Namespace: "default"},
+				Spec:       binderyv1alpha1.WorldShardSpec{WorldRef: binderyv1alpha1.ObjectRef{Name: world.Name}, ShardID: id},
+			},
+			&binderyv1alpha1.CapabilityBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "coloc-binding-" + shardID, Namespace: "default", Labels: map[string]string{labelShardID: shardID}},
+				Spec: binderyv1alpha1.CapabilityBindingSpec{
+					CapabilityID: "physics.engine",
+					Scope:        binderyv1alpha1.CapabilityScopeWorldShard,
+					WorldRef:     &binderyv1alpha1.WorldRef{Name: world.Name},
+					Consumer:     binderyv1alpha1.ConsumerRef{ModuleManifestName: "consumer"},
+					Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: "physics"},
+				},
+			},
+		)
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+		WithStatusSubresource(&binderyv1alpha1.CapabilityBinding{}, world).
+		Build()
+	r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme}
+
+	for _, shardID := range []string{"0", "1"} {
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "coloc-binding-" + shardID}}); err != nil {
+			t.Fatalf("Reconcile shard %s: %v", shardID, err)
+		}
+		var dep appsv1.Deployment
+		if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: rtNameWithShard(world.Name, shardID, provider.Name)}, &dep); err != nil {
+			t.Fatalf("expected shard %s deployment: %v", shardID, err)
+		}
+		if got := dep.Spec.Template.Labels[labelShardID]; got != shardID {
+			t.Fatalf("shard %s: expected pod label %s=%s, got %q", shardID, labelShardID, shardID, got)
+		}
+		aff := dep.Spec.Template.Spec.Affinity
+		if aff == nil || aff.PodAffinity == nil || len(aff.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution) != 1 {
+			t.Fatalf("shard %s: expected one required pod affinity term, got %#v", shardID, aff)
+		}
+		match := aff.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution[0].LabelSelector.MatchLabels
+		if match[labelShardID] != shardID || match["bindery.platform/coloc-group"] != "sim" || match[rtLabelWorldName] != world.Name {
+			t.Fatalf("shard %s: affinity must select its own shard's coloc group, got %v", shardID, match)
+		}
+	}
+}
+
+func TestRuntimeOrchestrator_ColocationGroupConflictPicksByName(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "coloc-world", Namespace: "default"},
+		Spec:       binderyv1alpha1.WorldInstanceSpec{GameRef: binderyv1alpha1.ObjectRef{Name: "coloc-game"}, WorldID: "w1", ShardCount: 1},
+	}
+	// "zeta" is listed first but "alpha" sorts first and must win.
+	booklet := &binderyv1alpha1.Booklet{
+		ObjectMeta: metav1.ObjectMeta{Name: "coloc-game", Namespace: "default"},
+		Spec: binderyv1alpha1.BookletSpec{
+			Colocation: []binderyv1alpha1.ColocationGroup{
+				{Name: "zeta", Modules: []string{"physics", "audio"}, Strategy: binderyv1alpha1.ColocationStrategyPod},
+				{Name: "alpha", Modules: []string{"physics"}, Strategy: binderyv1alpha1.ColocationStrategyNode},
+			},
+		},
+	}
+	provider := &binderyv1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "physics", Namespace: "default"},
+		Spec:       binderyv1alpha1.ModuleManifestSpec{Runtime: &binderyv1alpha1.ModuleRuntimeSpec{Image: "img"}},
+	}
+	binding := &binderyv1alpha1.CapabilityBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "coloc-binding", Namespace: "default"},
+		Spec: binderyv1alpha1.CapabilityBindingSpec{
+			CapabilityID: "physics.engine",
+			Scope:        binderyv1alpha1.CapabilityScopeWorld,
+			WorldRef:     &binderyv1alpha1.WorldRef{Name: "coloc-world"},
+			Consumer:     binderyv1alpha1.ConsumerRef{ModuleManifestName: "consumer"},
+			Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: "physics"},
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, booklet, provider, binding).WithStatusSubresource(binding, world).Build()
+	rec := record.NewFakeRecorder(10)
+	r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme, Recorder: rec}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "coloc-binding"}}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	// Node strategy keeps the module's own Deployment; Pod would have merged it into coloc-zeta.
+	var dep appsv1.Deployment
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: rtName(world.Name, provider.Name)}, &dep); err != nil {
+		t.Fatalf("expected module deployment from group alpha: %v", err)
+	}
+	if got := dep.Spec.Template.Labels["bindery.platform/coloc-group"]; got != "alpha" {
+		t.Fatalf("expected coloc-group label alpha, got %q", got)
+	}
+
+	warned := false
+	for len(rec.Events) > 0 {
+		if ev := <-rec.Events; strings.Contains(ev, "ColocationGroupConflict") && strings.Contains(ev, "alpha, zeta") {
+			warned = true
+		}
+	}
+	if !warned {
+		t.Fatalf("expected a ColocationGroupConflict warning naming both groups")
+	}
+}
+
+func TestRuntimeOrchestrator_UDSOnlyColocationSkipsServiceForIntraPodBinding(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "coloc-world", Namespace: "default"},
+		Spec:       binderyv1alpha1.WorldInstanceSpec{GameRef: binderyv1alpha1.ObjectRef{Name: "coloc-game"}, WorldID: "w1", ShardCount: 1},
+	}
+	booklet := &binderyv1alpha1.Booklet{
+		ObjectMeta: metav1.ObjectMeta{Name: "coloc-game", Namespace: "default"},
+		Spec: binderyv1alpha1.BookletSpec{
+			Colocation: []binderyv1alpha1.ColocationGroup{{
+				Name:     "sim",
+				Modules:  []string{"physics", "interaction"},
+				Strategy: binderyv1alpha1.ColocationStrategyPod,
+				UDSOnly:  true,
+			}},
+		},
+	}
+	provider := &binderyv1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "physics", Namespace: "default"},
+		Spec:       binderyv1alpha1.ModuleManifestSpec{Runtime: &binderyv1alpha1.ModuleRuntimeSpec{Image: "img"}},
+	}
+	newBinding := func(name, consumer string) *binderyv1alpha1.CapabilityBinding {
+		return &binderyv1alpha1.CapabilityBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: binderyv1alpha1.CapabilityBindingSpec{
+				CapabilityID: "physics.engine",
+				Scope:        binderyv1alpha1.CapabilityScopeWorld,
+				WorldRef:     &binderyv1alpha1.WorldRef{Name: "coloc-world"},
+				Consumer:     binderyv1alpha1.ConsumerRef{ModuleManifestName: consumer},
+				Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: "physics"},
+			},
+		}
+	}
+	intra := newBinding("intra-binding", "interaction")
+	cross := newBinding("cross-binding", "ui")
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, booklet, provider, intra, cross).WithStatusSubresource(intra, cross, world).Build()
+	r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme}
+	reconcileBinding := func(name string) binderyv1alpha1.CapabilityBinding {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: name}}); err != nil {
+			t.Fatalf("Reconcile %s: %v", name, err)
+		}
+		var b binderyv1alpha1.CapabilityBinding
+		if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: name}, &b); err != nil {
+			t.Fatalf("get binding %s: %v", name, err)
+		}
+		if b.Status.Provider == nil || b.Status.Provider.Endpoint == nil {
+			t.Fatalf("expected endpoint published on %s", name)
+		}
+		return b
+	}
+	serviceKey := types.NamespacedName{Namespace: "default", Name: rtName(world.Name, provider.Name)}
+
+	ep := reconcileBinding("intra-binding").Status.Provider.Endpoint
+	if ep.Type != binderyv1alpha1.EndpointTypeUnixSocket || ep.Value != "/var/run/bindery/physics.sock" {
+		t.Fatalf("expected unix socket endpoint for intra-pod binding, got %#v", ep)
+	}
+	var svc corev1.Service
+	if err := cl.Get(ctx, serviceKey, &svc); !apierrors.IsNotFound(err) {
+		t.Fatalf("expected no Service for an intra-pod binding, got err=%v", err)
+	}
+
+	// A consumer outside the group still needs the network Service.
+	ep = reconcileBinding("cross-binding").Status.Provider.Endpoint
+	if ep.Type != binderyv1alpha1.EndpointTypeKubernetesService || ep.Value != serviceKey.Name {
+		t.Fatalf("expected kubernetesService endpoint for cross-pod binding, got %#v", ep)
+	}
+	if err := cl.Get(ctx, serviceKey, &svc); err != nil {
+		t.Fatalf("expected Service for cross-pod consumer: %v", err)
+	}
+}
+
+func TestRuntimeOrchestrator_UDSOnlySwitchDeletesUnusedService(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "coloc-world", Namespace: "default"},
+		Spec:       binderyv1alpha1.WorldInstanceSpec{GameRef: binderyv1alpha1.ObjectRef{Name: "coloc-game"}, WorldID: "w1", ShardCount: 1},
+	}
+	booklet := &binderyv1alpha1.Booklet{
+		ObjectMeta: metav1.ObjectMeta{Name: "coloc-game", Namespace: "default"},
+		Spec: binderyv1alpha1.BookletSpec{
+			Colocation: []binderyv1alpha1.ColocationGroup{{
+				Name:     "sim",
+				Modules:  []string{"physics", "interaction"},
+				Strategy: binderyv1alpha1.ColocationStrategyPod,
+			}},
+		},
+	}
+	provider := &binderyv1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "physics", Namespace: "default"},
+		Spec:       binderyv1alpha1.ModuleManifestSpec{Runtime: &binderyv1alpha1.ModuleRuntimeSpec{Image: "img"}},
+	}
+	newBinding := func(name, consumer string) *binderyv1alpha1.CapabilityBinding {
+		return &binderyv1alpha1.CapabilityBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: binderyv1alpha1.CapabilityBindingSpec{
+				CapabilityID: "physics.engine",
+				Scope:        binderyv1alpha1.CapabilityScopeWorld,
+				WorldRef:     &binderyv1alpha1.WorldRef{Name: "coloc-world"},
+				Consumer:     binderyv1alpha1.ConsumerRef{ModuleManifestName: consumer},
+				Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: "physics"},
+			},
+		}
+	}
+	intra := newBinding("intra-binding", "interaction")
+	cross := newBinding("cross-binding", "ui")
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, booklet, provider, intra, cross).WithStatusSubresource(intra, cross, world).Build()
+	r := &RuntimeOrchestratorReconciler{Client: cl, Scheme: scheme}
+	reconcileIntra := func() {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "intra-binding"}}); err != nil {
+			t.Fatalf("Reconcile: %v", err)
+		}
+	}
+	serviceKey := types.NamespacedName{Namespace: "default", Name: rtName(world.Name, provider.Name)}
+
+	reconcileIntra()
+	if err := cl.Get(ctx, serviceKey, &corev1.Service{}); err != nil {
+		t.Fatalf("expected Service before the group is udsOnly: %v", err)
+	}
+
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "coloc-game"}, booklet); err != nil {
+		t.Fatalf("get booklet: %v", err)
+	}
+	booklet.Spec.Colocation[0].UDSOnly = true
+	if err := cl.Update(ctx, booklet); err != nil {
+		t.Fatalf("update booklet: %v", err)
+	}
+
+	// The consumer outside the group still dials the Service.
+	reconcileIntra()
+	if err := cl.Get(ctx, serviceKey, &corev1.Service{}); err != nil {
+		t.Fatalf("expected Service kept for the cross-pod consumer: %v", err)
+	}
+
+	if err := cl.Delete(ctx, cross); err != nil {
+		t.Fatalf("delete cross binding: %v", err)
+	}
+	reconcileIntra()
+	if err := cl.Get(ctx, serviceKey, &corev1.Service{}); !apierrors.IsNotFound(err) {
+		t.Fatalf("expected Service deleted once only udsOnly bindings remain, got err=%v", err)
+	}
+}
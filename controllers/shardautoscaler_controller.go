@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -13,24 +14,40 @@ import (
 	metrics "k8s.io/metrics/pkg/client/clientset/versioned"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	binderyv1alpha1 "github.com/bayleafwalker/bindery-core/api/v1alpha1"
 )
 
+const (
+	// annLastActivity is stamped on a WorldInstance (RFC3339) by a gateway when players
+	// connect. With minShards 0 it is the activity signal for scale-to-zero.
+	annLastActivity = "bindery.platform/last-activity"
+	// annScaledToZero marks a world the ShardAutoscaler paused for being idle, so a
+	// pause requested by an operator is never resumed automatically.
+	annScaledToZero = "bindery.platform/scaled-to-zero"
+
+	defaultStabilizationWindow = 5 * time.Minute
+)
+
 // ShardAutoscalerReconciler reconciles a ShardAutoscaler object
 type ShardAutoscalerReconciler struct {
 	client.Client
 	Scheme        *runtime.Scheme
 	Recorder      record.EventRecorder
 	MetricsClient metrics.Interface
+	// Options tunes worker concurrency and requeue backoff.
+	Options ControllerOptions
 }
 
 //+kubebuilder:rbac:groups=bindery.platform,resources=shardautoscalers,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=bindery.platform,resources=shardautoscalers/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=bindery.platform,resources=worldinstances,verbs=get;list;watch;update;patch
 
-func (r *ShardAutoscalerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *ShardAutoscalerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, err error) {
+	defer observeReconcile("ShardAutoscaler", time.Now(), &res, &err)
 	logger := log.FromContext(ctx)
 
 	var sa binderyv1alpha1.ShardAutoscaler
@@ -45,9 +62,29 @@ func (r *ShardAutoscalerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, err
 	}
 
-	currentShards := world.Spec.ShardCount
-	if currentShards == 0 {
-		currentShards = 1
+	currentShards := world.Spec.EffectiveShardCount()
+
+	if sa.Spec.MinShards == 0 {
+		handled, err := r.reconcileScaleToZero(ctx, &sa, &world, currentShards)
+		if err != nil {
+			logger.Error(err, "unable to apply scale-to-zero")
+			return ctrl.Result{}, err
+		}
+		if handled {
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+		}
+	}
+
+	// Paused worlds have no load to measure; hold the current shard count until resumed.
+	if worldPaused(&world) {
+		logger.V(1).Info("world paused; holding shard count", "shards", currentShards)
+		sa.Status.CurrentShards = currentShards
+		sa.Status.DesiredShards = currentShards
+		if err := r.Status().Update(ctx, &sa); err != nil {
+			logger.Error(err, "unable to update ShardAutoscaler status")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
 	}
 
 	// Calculate desired shards based on metrics
@@ -79,6 +116,10 @@ func (r *ShardAutoscalerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	if desiredShards > sa.Spec.MaxShards {
 		desiredShards = sa.Spec.MaxShards
 	}
+	// A running world keeps at least one shard; zero is only reached by pausing it.
+	if desiredShards < 1 {
+		desiredShards = 1
+	}
 
 	// Update Status
 	sa.Status.CurrentShards = currentShards
@@ -108,6 +149,94 @@ func (r *ShardAutoscalerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
 }
 
+// reconcileScaleToZero pauses a world whose last recorded activity (or, if none, its
+// creation) is older than the stabilization window, and resumes a world it paused once
+// newer activity is recorded.
+// It reports whether it handled this reconcile; otherwise regular scaling continues.
+func (r *ShardAutoscalerReconciler) reconcileScaleToZero(ctx context.Context, sa *binderyv1alpha1.ShardAutoscaler, world *binderyv1alpha1.WorldInstance, currentShards int32) (bool, error) {
+	logger := log.FromContext(ctx)
+	lastActivity, hasActivity := worldLastActivity(world)
+
+	if world.Annotations[annScaledToZero] == "true" {
+		if !worldPaused(world) {
+			// Resumed by hand: drop the marker and scale normally.
+			delete(world.Annotations, annScaledToZero)
+			return false, r.Update(ctx, world)
+		}
+		if hasActivity && (sa.Status.LastScaleTime == nil || lastActivity.After(sa.Status.LastScaleTime.Time)) {
+			logger.Info("activity recorded; resuming idle world", "lastActivity", lastActivity, "shards", currentShards)
+			world.Spec.DesiredState = binderyv1alpha1.WorldDesiredStateRunning
+			delete(world.Annotations, annScaledToZero)
+			if err := r.Update(ctx, world); err != nil {
+				return true, err
+			}
+			r.recordEventf(world, corev1.EventTypeNormal, "WorldActivated", "Activity recorded; resuming %d shard(s)", currentShards)
+			return true, r.updateScaleStatus(ctx, sa, 0, currentShards)
+		}
+		return true, r.updateScaleStatus(ctx, sa, 0, 0)
+	}
+
+	if world.Spec.DesiredState != "" && world.Spec.DesiredState != binderyv1alpha1.WorldDesiredStateRunning {
+		return false, nil
+	}
+	// The window also runs from the last scale, so a world that was just activated
+	// stays up long enough for players to connect. A world no player has ever
+	// reached has no activity annotation; it is idle since its creation.
+	idleSince := lastActivity
+	if !hasActivity {
+		idleSince = world.CreationTimestamp.Time
+	}
+	if t := sa.Status.LastScaleTime; t != nil && t.After(idleSince) {
+		idleSince = t.Time
+	}
+	if time.Since(idleSince) < stabilizationWindow(sa) {
+		return false, nil
+	}
+
+	logger.Info("world idle; scaling to zero", "idleSince", idleSince)
+	if world.Annotations == nil {
+		world.Annotations = map[string]string{}
+	}
+	world.Annotations[annScaledToZero] = "true"
+	world.Spec.DesiredState = binderyv1alpha1.WorldDesiredStatePaused
+	if err := r.Update(ctx, world); err != nil {
+		return true, err
+	}
+	r.recordEventf(world, corev1.EventTypeNormal, "WorldIdle", "No activity since %s; scaling to zero", idleSince.Format(time.RFC3339))
+	return true, r.updateScaleStatus(ctx, sa, currentShards, 0)
+}
+
+// updateScaleStatus records a scale-to-zero transition (or hold) on the autoscaler.
+func (r *ShardAutoscalerReconciler) updateScaleStatus(ctx context.Context, sa *binderyv1alpha1.ShardAutoscaler, current, desired int32) error {
+	sa.Status.CurrentShards = current
+	sa.Status.DesiredShards = desired
+	if desired != current {
+		now := metav1.Now()
+		sa.Status.LastScaleTime = &now
+	}
+	return r.Status().Update(ctx, sa)
+}
+
+// worldLastActivity parses the gateway's last-activity annotation.
+func worldLastActivity(world *binderyv1alpha1.WorldInstance) (time.Time, bool) {
+	raw := strings.TrimSpace(world.Annotations[annLastActivity])
+	if raw == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func stabilizationWindow(sa *binderyv1alpha1.ShardAutoscaler) time.Duration {
+	if sa.Spec.StabilizationWindowSeconds == nil {
+		return defaultStabilizationWindow
+	}
+	return time.Duration(*sa.Spec.StabilizationWindowSeconds) * time.Second
+}
+
 func (r *ShardAutoscalerReconciler) calculateReplicaCount(ctx context.Context, namespace, worldName string, currentShards int32, resource *binderyv1alpha1.ResourceMetricSource) (int32, error) {
 	if resource.TargetAverageUtilization == nil {
 		return currentShards, nil
@@ -126,24 +255,14 @@ func (r *ShardAutoscalerReconciler) calculateReplicaCount(ctx context.Context, n
 		return currentShards, nil
 	}
 
-	var totalUsage int64
-	var totalRequest int64 // We need requests to calculate utilization %
-
-	// Note: In a real implementation, we would need to fetch the Pods to get their resource requests.
-	// For this simplified implementation, we will assume a fixed request or just use raw usage if target is raw value.
-	// But the API says TargetAverageUtilization (percentage).
-	// So we MUST fetch pods to get requests.
-	// To keep it simple and avoid fetching all pods, let's assume we can get requests from the first pod we find (assuming homogeneity).
-	// Or better, let's just sum up usage and assume the user provided a raw target?
-	// The API spec says TargetAverageUtilization is int32 (percentage).
-
-	// Let's fetch the pods to get requests.
+	// Utilization is averaged per pod so shards with different request sizes weigh
+	// equally; summing raw usage and requests would let the largest pods dominate.
 	var podList corev1.PodList
 	if err := r.List(ctx, &podList, client.InNamespace(namespace), client.MatchingLabels{rtLabelWorldName: worldName}); err != nil {
 		return 0, err
 	}
 
-	podRequests := make(map[string]int64) // podName -> request value
+	podRequests := make(map[string]int64, len(podList.Items)) // podName -> request value
 	for _, pod := range podList.Items {
 		req := int64(0)
 		for _, c := range pod.Spec.Containers {
@@ -156,7 +275,7 @@ func (r *ShardAutoscalerReconciler) calculateReplicaCount(ctx context.Context, n
 		podRequests[pod.Name] = req
 	}
 
-	count := 0
+	podUsage := make(map[string]int64, len(podMetricsList.Items)) // podName -> usage value
 	for _, pm := range podMetricsList.Items {
 		usage := int64(0)
 		for _, c := range pm.Containers {
@@ -166,20 +285,13 @@ func (r *ShardAutoscalerReconciler) calculateReplicaCount(ctx context.Context, n
 				usage += c.Usage.Memory().Value()
 			}
 		}
-
-		if req, ok := podRequests[pm.Name]; ok && req > 0 {
-			totalUsage += usage
-			totalRequest += req
-			count++
-		}
+		podUsage[pm.Name] = usage
 	}
 
-	if count == 0 || totalRequest == 0 {
+	avgUtilization, ok := averageUtilization(podUsage, podRequests)
+	if !ok {
 		return currentShards, nil
 	}
-
-	// avgUtilization = (totalUsage / totalRequest) * 100
-	avgUtilization := (float64(totalUsage) / float64(totalRequest)) * 100
 	targetUtilization := float64(*resource.TargetAverageUtilization)
 
 	// desired = current * (avg / target)
@@ -189,8 +301,51 @@ func (r *ShardAutoscalerReconciler) calculateReplicaCount(ctx context.Context, n
 	return desired, nil
 }
 
+// averageUtilization returns the mean of usage/request (as a percentage) across pods
+// that have both a metrics sample and a non-zero request. It reports false when no
+// pod qualifies.
+func averageUtilization(usage, requests map[string]int64) (float64, bool) {
+	var sum float64
+	count := 0
+	for name, u := range usage {
+		req := requests[name]
+		if req <= 0 {
+			continue
+		}
+		sum += float64(u) / float64(req) * 100
+		count++
+	}
+	if count == 0 {
+		return 0, false
+	}
+	return sum / float64(count), true
+}
+
+func (r *ShardAutoscalerReconciler) recordEventf(obj client.Object, eventType, reason, messageFmt string, args ...any) {
+	if r.Recorder == nil || obj == nil {
+		return
+	}
+	r.Recorder.Eventf(obj, eventType, reason, messageFmt, args...)
+}
+
 func (r *ShardAutoscalerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// World changes (notably a gateway stamping last-activity) re-evaluate the
+	// autoscalers targeting that world, so activation does not wait for a requeue.
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&binderyv1alpha1.ShardAutoscaler{}).
+		Watches(&binderyv1alpha1.WorldInstance{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+			var list binderyv1alpha1.ShardAutoscalerList
+			if err := mgr.GetClient().List(ctx, &list, client.InNamespace(obj.GetNamespace())); err != nil {
+				return nil
+			}
+			var out []reconcile.Request
+			for i := range list.Items {
+				if list.Items[i].Spec.WorldRef.Name == obj.GetName() {
+					out = append(out, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&list.Items[i])})
+				}
+			}
+			return out
+		})).
+		WithOptions(r.Options.controllerOptions()).
 		Complete(r)
 }
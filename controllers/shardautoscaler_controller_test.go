@@ -3,12 +3,19 @@ package controllers
 import (
 	"context"
 	"testing"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	metricsfake "k8s.io/metrics/pkg/client/clientset/versioned/fake"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	binderyv1alpha1 "github.com/bayleafwalker/bindery-core/api/v1alpha1"
@@ -97,3 +104,236 @@ func TestShardAutoscaler_ScalesToMin(t *testing.T) {
 		t.Errorf("Expected World ShardCount scaled to 3, got %d", updatedWorld.Spec.ShardCount)
 	}
 }
+
+func TestShardAutoscaler_HoldsWhilePaused(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"},
+		Spec:       binderyv1alpha1.WorldInstanceSpec{WorldID: "w1", ShardCount: 1, DesiredState: binderyv1alpha1.WorldDesiredStatePaused},
+	}
+
+	sa := &binderyv1alpha1.ShardAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "sa-1", Namespace: "default"},
+		Spec: binderyv1alpha1.ShardAutoscalerSpec{
+			WorldRef:  binderyv1alpha1.ObjectRef{Name: "world-1"},
+			MinShards: 3,
+			MaxShards: 5,
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, sa).WithStatusSubresource(sa).Build()
+
+	r := &ShardAutoscalerReconciler{Client: cl, Scheme: scheme, MetricsClient: nil}
+
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "sa-1"}}); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+
+	var updatedWorld binderyv1alpha1.WorldInstance
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "world-1"}, &updatedWorld); err != nil {
+		t.Fatalf("Get World failed: %v", err)
+	}
+	if updatedWorld.Spec.ShardCount != 1 {
+		t.Errorf("Expected paused world to keep 1 shard, got %d", updatedWorld.Spec.ShardCount)
+	}
+}
+
+func TestShardAutoscaler_AveragesUtilizationPerPod(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	labels := map[string]string{rtLabelWorldName: "world-1"}
+	pod := func(name, cpu string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name:      "module",
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)}},
+			}}},
+		}
+	}
+	podMetrics := func(name, cpu string) *metricsv1beta1.PodMetrics {
+		return &metricsv1beta1.PodMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+			Containers: []metricsv1beta1.ContainerMetrics{{
+				Name:  "module",
+				Usage: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+			}},
+		}
+	}
+
+	// small runs at 90% of 100m, large at 10% of 1000m: the per-pod average is 50%,
+	// while summing raw totals would report 200m/1100m ≈ 18%.
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		pod("small", "100m"), pod("large", "1000m"), pod("pending", "0"),
+	).Build()
+	mc := metricsfake.NewSimpleClientset()
+	mc.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &metricsv1beta1.PodMetricsList{Items: []metricsv1beta1.PodMetrics{
+			*podMetrics("small", "90m"), *podMetrics("large", "100m"), *podMetrics("pending", "50m"),
+		}}, nil
+	})
+
+	r := &ShardAutoscalerReconciler{Client: cl, Scheme: scheme, MetricsClient: mc}
+	target := int32(25)
+	desired, err := r.calculateReplicaCount(ctx, "default", "world-1", 2, &binderyv1alpha1.ResourceMetricSource{Name: "cpu", TargetAverageUtilization: &target})
+	if err != nil {
+		t.Fatalf("calculateReplicaCount failed: %v", err)
+	}
+	// 2 shards * (50% / 25%) = 4
+	if desired != 4 {
+		t.Fatalf("expected 4 desired shards from 50%% average utilization, got %d", desired)
+	}
+
+	if avg, ok := averageUtilization(map[string]int64{"small": 90, "large": 100}, map[string]int64{"small": 100, "large": 1000}); !ok || avg != 50 {
+		t.Fatalf("expected 50%% average utilization, got %v (ok=%v)", avg, ok)
+	}
+	if _, ok := averageUtilization(map[string]int64{"pending": 50}, map[string]int64{"pending": 0}); ok {
+		t.Fatalf("expected pods with zero requests to be skipped")
+	}
+}
+
+func newScaleToZeroFixture(t *testing.T, lastActivity time.Time, annotations map[string]string, desiredState string, lastScale *metav1.Time) (*ShardAutoscalerReconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	ann := map[string]string{}
+	if !lastActivity.IsZero() {
+		ann[annLastActivity] = lastActivity.UTC().Format(time.RFC3339)
+	}
+	for k, v := range annotations {
+		ann[k] = v
+	}
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "world-1",
+			Namespace:         "default",
+			Annotations:       ann,
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+		},
+		Spec:       binderyv1alpha1.WorldInstanceSpec{WorldID: "w1", ShardCount: 2, DesiredState: desiredState},
+	}
+	window := int32(60)
+	sa := &binderyv1alpha1.ShardAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "sa-1", Namespace: "default"},
+		Spec: binderyv1alpha1.ShardAutoscalerSpec{
+			WorldRef:                   binderyv1alpha1.ObjectRef{Name: "world-1"},
+			MinShards:                  0,
+			MaxShards:                  5,
+			StabilizationWindowSeconds: &window,
+		},
+		Status: binderyv1alpha1.ShardAutoscalerStatus{LastScaleTime: lastScale},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, sa).WithStatusSubresource(sa).Build()
+	return &ShardAutoscalerReconciler{Client: cl, Scheme: scheme}, cl
+}
+
+func reconcileAutoscaler(t *testing.T, r *ShardAutoscalerReconciler, cl client.Client) (binderyv1alpha1.WorldInstance, binderyv1alpha1.ShardAutoscaler) {
+	t.Helper()
+	ctx := context.Background()
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "sa-1"}}); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	var world binderyv1alpha1.WorldInstance
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "world-1"}, &world); err != nil {
+		t.Fatalf("Get World failed: %v", err)
+	}
+	var sa binderyv1alpha1.ShardAutoscaler
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "sa-1"}, &sa); err != nil {
+		t.Fatalf("Get SA failed: %v", err)
+	}
+	return world, sa
+}
+
+func TestShardAutoscaler_ScalesIdleWorldToZero(t *testing.T) {
+	r, cl := newScaleToZeroFixture(t, time.Now().Add(-10*time.Minute), nil, "", nil)
+	world, sa := reconcileAutoscaler(t, r, cl)
+
+	if world.Spec.DesiredState != binderyv1alpha1.WorldDesiredStatePaused {
+		t.Fatalf("expected idle world to be paused, got desiredState %q", world.Spec.DesiredState)
+	}
+	if world.Annotations[annScaledToZero] != "true" {
+		t.Fatalf("expected %s marker on idle world", annScaledToZero)
+	}
+	if world.Spec.ShardCount != 2 {
+		t.Fatalf("expected shard count to be kept for resume, got %d", world.Spec.ShardCount)
+	}
+	if sa.Status.DesiredShards != 0 || sa.Status.LastScaleTime == nil {
+		t.Fatalf("expected desired 0 with a scale time, got desired=%d lastScale=%v", sa.Status.DesiredShards, sa.Status.LastScaleTime)
+	}
+}
+
+func TestShardAutoscaler_ScalesNeverActiveWorldToZero(t *testing.T) {
+	// No gateway ever stamped activity: the world is idle since it was created.
+	r, cl := newScaleToZeroFixture(t, time.Time{}, nil, "", nil)
+	world, sa := reconcileAutoscaler(t, r, cl)
+	if world.Spec.DesiredState != binderyv1alpha1.WorldDesiredStatePaused || world.Annotations[annScaledToZero] != "true" {
+		t.Fatalf("expected never-active world to be scaled to zero, got desiredState %q annotations %v", world.Spec.DesiredState, world.Annotations)
+	}
+	if sa.Status.DesiredShards != 0 {
+		t.Fatalf("expected desired 0, got %d", sa.Status.DesiredShards)
+	}
+
+	// Within the window of the last scale it keeps running.
+	justScaled := metav1.NewTime(time.Now().Add(-10 * time.Second))
+	r, cl = newScaleToZeroFixture(t, time.Time{}, nil, "", &justScaled)
+	if world, _ := reconcileAutoscaler(t, r, cl); worldPaused(&world) {
+		t.Fatalf("expected never-active world scaled within the stabilization window to keep running")
+	}
+}
+
+func TestShardAutoscaler_KeepsRecentlyActiveWorld(t *testing.T) {
+	// Activity inside the window, and a world activated moments ago, both stay up.
+	r, cl := newScaleToZeroFixture(t, time.Now().Add(-30*time.Second), nil, "", nil)
+	if world, _ := reconcileAutoscaler(t, r, cl); worldPaused(&world) {
+		t.Fatalf("expected recently active world to keep running")
+	}
+
+	justScaled := metav1.NewTime(time.Now().Add(-10 * time.Second))
+	r, cl = newScaleToZeroFixture(t, time.Now().Add(-10*time.Minute), nil, "", &justScaled)
+	if world, _ := reconcileAutoscaler(t, r, cl); worldPaused(&world) {
+		t.Fatalf("expected world scaled within the stabilization window to keep running")
+	}
+}
+
+func TestShardAutoscaler_ReactivatesOnNewActivity(t *testing.T) {
+	idledAt := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	// No activity since the world was idled: hold at zero.
+	r, cl := newScaleToZeroFixture(t, idledAt.Add(-10*time.Minute), map[string]string{annScaledToZero: "true"}, binderyv1alpha1.WorldDesiredStatePaused, &idledAt)
+	world, sa := reconcileAutoscaler(t, r, cl)
+	if !worldPaused(&world) || sa.Status.DesiredShards != 0 {
+		t.Fatalf("expected idle world to stay at zero, got desiredState=%q desired=%d", world.Spec.DesiredState, sa.Status.DesiredShards)
+	}
+
+	// A gateway records a connection: resume with the previous shard count.
+	r, cl = newScaleToZeroFixture(t, time.Now(), map[string]string{annScaledToZero: "true"}, binderyv1alpha1.WorldDesiredStatePaused, &idledAt)
+	world, sa = reconcileAutoscaler(t, r, cl)
+	if world.Spec.DesiredState != binderyv1alpha1.WorldDesiredStateRunning {
+		t.Fatalf("expected activated world to run, got desiredState %q", world.Spec.DesiredState)
+	}
+	if _, ok := world.Annotations[annScaledToZero]; ok {
+		t.Fatalf("expected %s marker to be removed on activation", annScaledToZero)
+	}
+	if sa.Status.DesiredShards != 2 {
+		t.Fatalf("expected desired 2 shards after activation, got %d", sa.Status.DesiredShards)
+	}
+}
+
+func TestShardAutoscaler_DoesNotResumeOperatorPause(t *testing.T) {
+	r, cl := newScaleToZeroFixture(t, time.Now(), nil, binderyv1alpha1.WorldDesiredStatePaused, nil)
+	if world, _ := reconcileAutoscaler(t, r, cl); !worldPaused(&world) {
+		t.Fatalf("expected operator-paused world to stay paused despite activity")
+	}
+}
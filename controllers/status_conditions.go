@@ -13,8 +13,14 @@ const (
 	WorldConditionModulesResolved  = "ModulesResolved"
 	WorldConditionBindingsResolved = "BindingsResolved"
 	WorldConditionRuntimeReady     = "RuntimeReady"
+	WorldConditionPaused           = "Paused"
+	// WorldConditionCheckpointRequested is raised on deletion; modules should flush state before teardown.
+	WorldConditionCheckpointRequested = "CheckpointRequested"
 
-	BindingConditionRuntimeReady = "RuntimeReady"
+	BindingConditionRuntimeReady    = "RuntimeReady"
+	BindingConditionEndpointServing = "EndpointServing"
+
+	RealmConditionReady = "RealmReady"
 )
 
 func setWorldCondition(world *binderyv1alpha1.WorldInstance, condition metav1.Condition) {
@@ -33,9 +39,26 @@ func setBindingCondition(binding *binderyv1alpha1.CapabilityBinding, condition m
 	meta.SetStatusCondition(&binding.Status.Conditions, condition)
 }
 
+func setRealmCondition(realm *binderyv1alpha1.Realm, condition metav1.Condition) {
+	if realm == nil {
+		return
+	}
+	condition.ObservedGeneration = realm.Generation
+	meta.SetStatusCondition(&realm.Status.Conditions, condition)
+}
+
+// worldPaused reports whether the world's desired state asks for its workloads to be scaled to zero.
+func worldPaused(world *binderyv1alpha1.WorldInstance) bool {
+	return world != nil && world.Spec.DesiredState == binderyv1alpha1.WorldDesiredStatePaused
+}
+
 func runtimeReadyMessage(readyCount, totalCount int) string {
 	if totalCount <= 0 {
 		return "No server workloads required"
 	}
 	return fmt.Sprintf("%d/%d server workloads have published endpoints", readyCount, totalCount)
 }
+
+func endpointServingMessage(available, desired int32) string {
+	return fmt.Sprintf("%d/%d provider replicas available", available, desired)
+}
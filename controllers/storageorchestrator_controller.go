@@ -7,11 +7,16 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -21,20 +26,40 @@ import (
 	binderyv1alpha1 "github.com/bayleafwalker/bindery-core/api/v1alpha1"
 )
 
+// storageClassRetryInterval is how often a claim naming a missing StorageClass is rechecked.
+const storageClassRetryInterval = time.Minute
+
+// pvcPendingResyncInterval is how often a claim with a Pending PVC is rechecked. PVC phase
+// changes do not always produce an update the Owns watch acts on promptly (e.g. WaitForFirstConsumer).
+const pvcPendingResyncInterval = 15 * time.Second
+
+// annSnapshotRequested on a WorldStorageClaim requests a VolumeSnapshot of its PVC.
+// Each distinct value yields one snapshot, so changing it (e.g. to a timestamp) takes a new backup.
+const annSnapshotRequested = "bindery.platform/snapshot-requested"
+
+// volumeSnapshotGVK is the CSI external-snapshotter kind. It is handled as unstructured so the
+// controller does not depend on the snapshotter client module.
+var volumeSnapshotGVK = schema.GroupVersionKind{Group: "snapshot.storage.k8s.io", Version: "v1", Kind: "VolumeSnapshot"}
+
 // StorageOrchestratorReconciler materializes backing PVCs for WorldStorageClaims (server tiers).
 //
 // RBAC:
 // +kubebuilder:rbac:groups=bindery.platform,resources=worldstorageclaims,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=bindery.platform,resources=worldstorageclaims/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list;watch;create
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch;update
 type StorageOrchestratorReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+	// Options tunes worker concurrency and requeue backoff.
+	Options ControllerOptions
 }
 
-func (r *StorageOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *StorageOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, err error) {
+	defer observeReconcile("StorageOrchestrator", time.Now(), &res, &err)
 	logger := log.FromContext(ctx).WithValues(
 		"controller", "StorageOrchestrator",
 		"namespace", req.Namespace,
@@ -86,6 +111,28 @@ func (r *StorageOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 		return ctrl.Result{}, nil
 	}
 
+	// A PVC naming a missing StorageClass never binds; surface that instead of creating it.
+	if requestedSC != "" {
+		var sc storagev1.StorageClass
+		if err := r.Get(ctx, client.ObjectKey{Name: requestedSC}, &sc); err != nil {
+			if !apierrors.IsNotFound(err) {
+				logger.Error(err, "failed to get storageclass", "storageClass", requestedSC)
+				return ctrl.Result{}, err
+			}
+			before := claim.DeepCopy()
+			claim.Status.Phase = "Error"
+			claim.Status.Message = fmt.Sprintf("StorageClassNotFound: StorageClass %q does not exist", requestedSC)
+			claim.Status.StorageClassName = requestedSC
+			if err := r.Status().Patch(ctx, &claim, client.MergeFrom(before)); err != nil {
+				logger.Error(err, "failed to patch claim status")
+				return ctrl.Result{}, err
+			}
+			r.recordEventf(&claim, "Warning", "StorageClassNotFound", "StorageClass %q does not exist", requestedSC)
+			// StorageClasses are not watched; poll so the claim recovers once the class is created.
+			return ctrl.Result{RequeueAfter: storageClassRetryInterval}, nil
+		}
+	}
+
 	accessModes := claim.Spec.AccessModes
 	if len(accessModes) == 0 {
 		accessModes = []string{"ReadWriteOnce"}
@@ -98,6 +145,8 @@ func (r *StorageOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 	pvcName := stablePVCName(claim.Spec.WorldRef.Name, shardRefName(claim.Spec.ShardRef), string(claim.Spec.Tier))
 	pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: pvcName, Namespace: req.Namespace}}
 	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, pvc, func() error {
+		// The RuntimeOrchestrator copied the module's propagated metadata onto the claim.
+		applyPropagatedMetadata(pvc, &claim, propagatedMetadataPrefixes())
 		if pvc.Labels == nil {
 			pvc.Labels = map[string]string{}
 		}
@@ -128,20 +177,71 @@ func (r *StorageOrchestratorReconciler) Reconcile(ctx context.Context, req ctrl.
 	claim.Status.ClaimName = pvcName
 	claim.Status.StorageClassName = requestedSC
 	if pvc.Status.Phase == corev1.ClaimBound {
+		snapshotName, err := r.ensureVolumeSnapshot(ctx, &claim, pvcName)
+		if err != nil {
+			logger.Error(err, "failed to ensure volumesnapshot", "pvc", pvcName)
+			r.recordEventf(&claim, "Warning", "SnapshotFailed", "Failed to snapshot PVC %q: %v", pvcName, err)
+			return ctrl.Result{}, err
+		}
+		if snapshotName != "" {
+			claim.Status.SnapshotName = snapshotName
+		}
 		claim.Status.Phase = "Bound"
 		claim.Status.Message = "PVC bound"
+		claim.Status.Capacity = ""
+		if q, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+			claim.Status.Capacity = q.String()
+		}
 	} else {
 		claim.Status.Phase = "Pending"
 		claim.Status.Message = "PVC pending"
 	}
+	claim.Status.Conditions = pvcConditions(pvc, claim.Generation)
 	if err := r.Status().Patch(ctx, &claim, client.MergeFrom(before)); err != nil {
 		logger.Error(err, "failed to patch claim status")
 		return ctrl.Result{}, err
 	}
 
+	if claim.Status.Phase == "Pending" {
+		return ctrl.Result{RequeueAfter: pvcPendingResyncInterval}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
+// ensureVolumeSnapshot creates the VolumeSnapshot requested by the claim's snapshot annotation,
+// returning its name, or "" when no snapshot is requested. It is idempotent per annotation value.
+func (r *StorageOrchestratorReconciler) ensureVolumeSnapshot(ctx context.Context, claim *binderyv1alpha1.WorldStorageClaim, pvcName string) (string, error) {
+	token := strings.TrimSpace(claim.Annotations[annSnapshotRequested])
+	if token == "" {
+		return "", nil
+	}
+	name := stableSnapshotName(pvcName, token)
+
+	snap := &unstructured.Unstructured{}
+	snap.SetGroupVersionKind(volumeSnapshotGVK)
+	snap.SetName(name)
+	snap.SetNamespace(claim.Namespace)
+	snap.SetLabels(map[string]string{
+		labelWorldName:                  claim.Spec.WorldRef.Name,
+		"bindery.platform/storage-tier": string(claim.Spec.Tier),
+	})
+	spec := map[string]any{
+		"source": map[string]any{"persistentVolumeClaimName": pvcName},
+	}
+	if class := defaultVolumeSnapshotClass(); class != "" {
+		spec["volumeSnapshotClassName"] = class
+	}
+	snap.Object["spec"] = spec
+
+	switch err := r.Create(ctx, snap); {
+	case err == nil:
+		r.recordEventf(claim, "Normal", "SnapshotCreated", "Created VolumeSnapshot %q of PVC %q", name, pvcName)
+	case !apierrors.IsAlreadyExists(err):
+		return "", err
+	}
+	return name, nil
+}
+
 func (r *StorageOrchestratorReconciler) recordEventf(obj client.Object, eventType, reason, messageFmt string, args ...any) {
 	if r.Recorder == nil || obj == nil {
 		return
@@ -153,6 +253,7 @@ func (r *StorageOrchestratorReconciler) SetupWithManager(mgr ctrl.Manager) error
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&binderyv1alpha1.WorldStorageClaim{}).
 		Owns(&corev1.PersistentVolumeClaim{}).
+		WithOptions(r.Options.controllerOptions()).
 		Complete(r)
 }
 
@@ -168,6 +269,35 @@ func defaultStorageClassForTier(tier binderyv1alpha1.WorldStorageTier) string {
 	}
 }
 
+// pvcConditions converts the PVC's status conditions (resize progress and the like) into
+// metav1.Conditions for the claim status.
+func pvcConditions(pvc *corev1.PersistentVolumeClaim, generation int64) []metav1.Condition {
+	if len(pvc.Status.Conditions) == 0 {
+		return nil
+	}
+	out := make([]metav1.Condition, 0, len(pvc.Status.Conditions))
+	for _, c := range pvc.Status.Conditions {
+		reason := c.Reason
+		if reason == "" {
+			reason = string(c.Type)
+		}
+		out = append(out, metav1.Condition{
+			Type:               string(c.Type),
+			Status:             metav1.ConditionStatus(c.Status),
+			ObservedGeneration: generation,
+			LastTransitionTime: c.LastTransitionTime,
+			Reason:             reason,
+			Message:            c.Message,
+		})
+	}
+	return out
+}
+
+// defaultVolumeSnapshotClass is the VolumeSnapshotClass for claim snapshots; empty uses the cluster default.
+func defaultVolumeSnapshotClass() string {
+	return strings.TrimSpace(os.Getenv("BINDERY_VOLUMESNAPSHOTCLASS"))
+}
+
 func defaultClientStorageURI(worldName, shardName string) string {
 	if shardName == "" {
 		return fmt.Sprintf("file://$HOME/.bindery/worlds/%s", worldName)
@@ -182,6 +312,16 @@ func shardRefName(ref *binderyv1alpha1.ObjectRef) string {
 	return ref.Name
 }
 
+// stableSnapshotName derives a DNS-safe VolumeSnapshot name from the PVC and request token.
+func stableSnapshotName(pvcName, token string) string {
+	h := sha1.Sum([]byte(token))
+	suffix := "-snap-" + hex.EncodeToString(h[:])[:8]
+	if len(pvcName)+len(suffix) > 253 {
+		pvcName = strings.Trim(pvcName[:253-len(suffix)], "-")
+	}
+	return pvcName + suffix
+}
+
 func stablePVCName(worldName, shard, tier string) string {
 	base := fmt.Sprintf("pvc-%s-%s-%s", worldName, shard, tier)
 	base = strings.ToLower(base)
@@ -2,10 +2,13 @@ package controllers
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -98,3 +101,237 @@ func TestStorageOrchestrator_ClientTierSetsExternalStatus(t *testing.T) {
 		t.Fatalf("expected externalUri")
 	}
 }
+
+func TestStorageOrchestrator_MissingStorageClassSetsError(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	if err := binderyv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme(game): %v", err)
+	}
+
+	claim := &binderyv1alpha1.WorldStorageClaim{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "bindery.platform/v1alpha1", Kind: "WorldStorageClaim"},
+		ObjectMeta: metav1.ObjectMeta{Name: "c1", Namespace: "ns"},
+		Spec: binderyv1alpha1.WorldStorageClaimSpec{
+			Scope:            binderyv1alpha1.WorldStorageScopeWorld,
+			Tier:             binderyv1alpha1.WorldStorageTierServerLowLatency,
+			WorldRef:         binderyv1alpha1.ObjectRef{Name: "w1"},
+			Size:             "1Gi",
+			StorageClassName: "does-not-exist",
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(claim).WithStatusSubresource(claim).Build()
+
+	r := &StorageOrchestratorReconciler{Client: cl, Scheme: scheme}
+	res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "c1"}})
+	if err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if res.RequeueAfter == 0 {
+		t.Fatalf("expected a requeue while the StorageClass is missing")
+	}
+
+	var got binderyv1alpha1.WorldStorageClaim
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "ns", Name: "c1"}, &got); err != nil {
+		t.Fatalf("Get claim: %v", err)
+	}
+	if got.Status.Phase != "Error" {
+		t.Fatalf("expected Error, got %q", got.Status.Phase)
+	}
+	if !strings.HasPrefix(got.Status.Message, "StorageClassNotFound") {
+		t.Fatalf("expected StorageClassNotFound message, got %q", got.Status.Message)
+	}
+
+	var pvcs corev1.PersistentVolumeClaimList
+	if err := cl.List(ctx, &pvcs); err != nil {
+		t.Fatalf("List PVCs: %v", err)
+	}
+	if len(pvcs.Items) != 0 {
+		t.Fatalf("expected no PVC for a missing StorageClass, got %d", len(pvcs.Items))
+	}
+}
+
+func TestStorageOrchestrator_BoundPVCCapacityAndConditionsPropagate(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	if err := binderyv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme(game): %v", err)
+	}
+
+	claim := &binderyv1alpha1.WorldStorageClaim{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "bindery.platform/v1alpha1", Kind: "WorldStorageClaim"},
+		ObjectMeta: metav1.ObjectMeta{Name: "c1", Namespace: "ns"},
+		Spec: binderyv1alpha1.WorldStorageClaimSpec{
+			Scope:    binderyv1alpha1.WorldStorageScopeWorld,
+			Tier:     binderyv1alpha1.WorldStorageTierServerLowLatency,
+			WorldRef: binderyv1alpha1.ObjectRef{Name: "w1"},
+			Size:     "1Gi",
+		},
+	}
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      stablePVCName("w1", "", string(binderyv1alpha1.WorldStorageTierServerLowLatency)),
+			Namespace: "ns",
+		},
+		Status: corev1.PersistentVolumeClaimStatus{
+			Phase:    corev1.ClaimBound,
+			Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("2Gi")},
+			Conditions: []corev1.PersistentVolumeClaimCondition{{
+				Type:   corev1.PersistentVolumeClaimFileSystemResizePending,
+				Status: corev1.ConditionTrue,
+			}},
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(claim, pvc).WithStatusSubresource(claim, pvc).Build()
+
+	r := &StorageOrchestratorReconciler{Client: cl, Scheme: scheme}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "c1"}}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	var got binderyv1alpha1.WorldStorageClaim
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "ns", Name: "c1"}, &got); err != nil {
+		t.Fatalf("Get claim: %v", err)
+	}
+	if got.Status.Phase != "Bound" {
+		t.Fatalf("expected Bound, got %q", got.Status.Phase)
+	}
+	if got.Status.Capacity != "2Gi" {
+		t.Fatalf("expected capacity 2Gi, got %q", got.Status.Capacity)
+	}
+	if len(got.Status.Conditions) != 1 || got.Status.Conditions[0].Type != string(corev1.PersistentVolumeClaimFileSystemResizePending) ||
+		got.Status.Conditions[0].Status != metav1.ConditionTrue {
+		t.Fatalf("expected FileSystemResizePending=True, got %+v", got.Status.Conditions)
+	}
+}
+
+func TestStorageOrchestrator_SnapshotRequestCreatesVolumeSnapshot(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("BINDERY_VOLUMESNAPSHOTCLASS", "csi-snapclass")
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	if err := binderyv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme(game): %v", err)
+	}
+	// Register the external-snapshotter kind the way the CRD would.
+	scheme.AddKnownTypeWithName(volumeSnapshotGVK, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(volumeSnapshotGVK.GroupVersion().WithKind("VolumeSnapshotList"), &unstructured.UnstructuredList{})
+
+	claim := &binderyv1alpha1.WorldStorageClaim{
+		TypeMeta: metav1.TypeMeta{APIVersion: "bindery.platform/v1alpha1", Kind: "WorldStorageClaim"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "c1",
+			Namespace:   "ns",
+			Annotations: map[string]string{annSnapshotRequested: "2026-01-01T00:00:00Z"},
+		},
+		Spec: binderyv1alpha1.WorldStorageClaimSpec{
+			Scope:    binderyv1alpha1.WorldStorageScopeWorld,
+			Tier:     binderyv1alpha1.WorldStorageTierServerLowLatency,
+			WorldRef: binderyv1alpha1.ObjectRef{Name: "w1"},
+			Size:     "1Gi",
+		},
+	}
+	pvcName := stablePVCName("w1", "", string(binderyv1alpha1.WorldStorageTierServerLowLatency))
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: pvcName, Namespace: "ns"},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(claim, pvc).WithStatusSubresource(claim, pvc).Build()
+
+	r := &StorageOrchestratorReconciler{Client: cl, Scheme: scheme}
+	for i := 0; i < 2; i++ {
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "c1"}}); err != nil {
+			t.Fatalf("Reconcile %d: %v", i, err)
+		}
+	}
+
+	var got binderyv1alpha1.WorldStorageClaim
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "ns", Name: "c1"}, &got); err != nil {
+		t.Fatalf("Get claim: %v", err)
+	}
+	if got.Status.SnapshotName == "" {
+		t.Fatalf("expected status.snapshotName")
+	}
+
+	snap := &unstructured.Unstructured{}
+	snap.SetGroupVersionKind(volumeSnapshotGVK)
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "ns", Name: got.Status.SnapshotName}, snap); err != nil {
+		t.Fatalf("expected VolumeSnapshot: %v", err)
+	}
+	source, _, _ := unstructured.NestedString(snap.Object, "spec", "source", "persistentVolumeClaimName")
+	if source != pvcName {
+		t.Fatalf("expected snapshot of %q, got %q", pvcName, source)
+	}
+	class, _, _ := unstructured.NestedString(snap.Object, "spec", "volumeSnapshotClassName")
+	if class != "csi-snapclass" {
+		t.Fatalf("expected volumeSnapshotClassName csi-snapclass, got %q", class)
+	}
+}
+
+func TestStorageOrchestrator_PendingPVCRequeuesUntilBound(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	if err := binderyv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme(game): %v", err)
+	}
+
+	claim := &binderyv1alpha1.WorldStorageClaim{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "bindery.platform/v1alpha1", Kind: "WorldStorageClaim"},
+		ObjectMeta: metav1.ObjectMeta{Name: "c1", Namespace: "ns"},
+		Spec: binderyv1alpha1.WorldStorageClaimSpec{
+			Scope:    binderyv1alpha1.WorldStorageScopeWorld,
+			Tier:     binderyv1alpha1.WorldStorageTierServerLowLatency,
+			WorldRef: binderyv1alpha1.ObjectRef{Name: "w1"},
+			Size:     "1Gi",
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(claim).WithStatusSubresource(claim, &corev1.PersistentVolumeClaim{}).Build()
+	r := &StorageOrchestratorReconciler{Client: cl, Scheme: scheme}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "c1"}}
+
+	res, err := r.Reconcile(ctx, req)
+	if err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if res.RequeueAfter == 0 {
+		t.Fatalf("expected a requeue while the PVC is pending")
+	}
+
+	var got binderyv1alpha1.WorldStorageClaim
+	if err := cl.Get(ctx, req.NamespacedName, &got); err != nil {
+		t.Fatalf("Get claim: %v", err)
+	}
+	var pvc corev1.PersistentVolumeClaim
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: "ns", Name: got.Status.ClaimName}, &pvc); err != nil {
+		t.Fatalf("Get PVC: %v", err)
+	}
+	pvc.Status.Phase = corev1.ClaimBound
+	if err := cl.Status().Update(ctx, &pvc); err != nil {
+		t.Fatalf("bind PVC: %v", err)
+	}
+
+	res, err = r.Reconcile(ctx, req)
+	if err != nil {
+		t.Fatalf("Reconcile after bind: %v", err)
+	}
+	if res.RequeueAfter != 0 {
+		t.Fatalf("expected no requeue once bound, got %v", res.RequeueAfter)
+	}
+	if err := cl.Get(ctx, req.NamespacedName, &got); err != nil {
+		t.Fatalf("Get claim: %v", err)
+	}
+	if got.Status.Phase != "Bound" {
+		t.Fatalf("expected Bound, got %q", got.Status.Phase)
+	}
+}
@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -16,7 +19,9 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	binderyv1alpha1 "github.com/bayleafwalker/bindery-core/api/v1alpha1"
 )
@@ -25,23 +30,34 @@ const (
 	labelShardID = "bindery.platform/shard"
 
 	managedByWorldShardController = "worldshardcontroller"
+
+	// WorldShard status phases. A shard is Ready only once every workload the
+	// RuntimeOrchestrator runs for it is available, or when it has none to run.
+	WorldShardPhasePending = "Pending"
+	WorldShardPhaseReady   = "Ready"
 )
 
-// WorldShardReconciler materializes explicit WorldShard resources for a WorldInstance.
+// WorldShardReconciler materializes explicit WorldShard resources for a WorldInstance
+// and reports each shard's readiness from its Deployments and StatefulSets.
 //
 // RBAC:
 // +kubebuilder:rbac:groups=bindery.platform,resources=worldinstances,verbs=get;list;watch
 // +kubebuilder:rbac:groups=bindery.platform,resources=worldinstances/status,verbs=get
 // +kubebuilder:rbac:groups=bindery.platform,resources=worldshards,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=bindery.platform,resources=worldshards/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=bindery.platform,resources=capabilitybindings;modulemanifests,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch;update
 type WorldShardReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+	// Options tunes worker concurrency and requeue backoff.
+	Options ControllerOptions
 }
 
-func (r *WorldShardReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *WorldShardReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, err error) {
+	defer observeReconcile("WorldShard", time.Now(), &res, &err)
 	logger := log.FromContext(ctx).WithValues(
 		"controller", "WorldShard",
 		"namespace", req.Namespace,
@@ -53,10 +69,7 @@ func (r *WorldShardReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	shardCount := world.Spec.ShardCount
-	if shardCount < 1 {
-		shardCount = 1
-	}
+	shardCount := world.Spec.EffectiveShardCount()
 
 	var shards binderyv1alpha1.WorldShardList
 	if err := r.List(ctx, &shards,
@@ -89,7 +102,6 @@ func (r *WorldShardReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 					WorldRef: binderyv1alpha1.ObjectRef{Name: world.Name},
 					ShardID:  id,
 				},
-				Status: binderyv1alpha1.WorldShardStatus{Phase: "Ready"},
 			}
 			if err := controllerutil.SetControllerReference(&world, create, r.Scheme); err != nil {
 				return ctrl.Result{}, err
@@ -128,9 +140,142 @@ func (r *WorldShardReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		logger.Info("reconciled shards", "shardCount", shardCount, "created", created, "deleted", deleted)
 	}
 
+	for id := int32(0); id < shardCount; id++ {
+		if err := r.updateShardStatus(ctx, &world, id); err != nil {
+			logger.Error(err, "failed to update worldshard status", "shard", id)
+			return ctrl.Result{}, err
+		}
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// updateShardStatus sets the shard's phase from the availability of the workloads
+// labeled with its world and shard id. A shard with no workloads yet, or of a
+// paused world, stays Pending.
+func (r *WorldShardReconciler) updateShardStatus(ctx context.Context, world *binderyv1alpha1.WorldInstance, id int32) error {
+	var shard binderyv1alpha1.WorldShard
+	if err := r.Get(ctx, types.NamespacedName{Namespace: world.Namespace, Name: stableWorldShardName(world.Name, id)}, &shard); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	selector := client.MatchingLabels{rtLabelWorldName: world.Name, labelShardID: fmt.Sprintf("%d", id)}
+	var deployments appsv1.DeploymentList
+	if err := r.List(ctx, &deployments, client.InNamespace(world.Namespace), selector); err != nil {
+		return err
+	}
+	var statefulSets appsv1.StatefulSetList
+	if err := r.List(ctx, &statefulSets, client.InNamespace(world.Namespace), selector); err != nil {
+		return err
+	}
+
+	total := len(deployments.Items) + len(statefulSets.Items)
+	available := 0
+	for i := range deployments.Items {
+		if deploymentAvailable(&deployments.Items[i]) {
+			available++
+		}
+	}
+	for i := range statefulSets.Items {
+		if statefulSetAvailable(&statefulSets.Items[i]) {
+			available++
+		}
+	}
+
+	phase := WorldShardPhasePending
+	message := "Waiting for shard workloads"
+	switch {
+	case worldPaused(world):
+		// Paused workloads are scaled to zero but can still report Available.
+		message = "World is paused"
+	case total > 0:
+		message = fmt.Sprintf("%d/%d workloads available", available, total)
+		if available == total {
+			phase = WorldShardPhaseReady
+		}
+	default:
+		// Matches the world's RuntimeReady NoServerWorkloads: nothing will ever run here.
+		expects, err := r.shardExpectsWorkloads(ctx, world, id)
+		if err != nil {
+			return err
+		}
+		if !expects {
+			phase = WorldShardPhaseReady
+			message = "No server workloads"
+		}
+	}
+	if shard.Status.Phase == phase && shard.Status.Message == message {
+		return nil
+	}
+	before := shard.DeepCopy()
+	shard.Status.Phase = phase
+	shard.Status.Message = message
+	return r.Status().Patch(ctx, &shard, client.MergeFrom(before))
+}
+
+// shardExpectsWorkloads reports whether any of the shard's bindings has a provider the
+// RuntimeOrchestrator runs. A missing provider counts, since it may still be installed.
+func (r *WorldShardReconciler) shardExpectsWorkloads(ctx context.Context, world *binderyv1alpha1.WorldInstance, id int32) (bool, error) {
+	var bindings binderyv1alpha1.CapabilityBindingList
+	if err := r.List(ctx, &bindings,
+		client.InNamespace(world.Namespace),
+		client.MatchingLabels{labelManagedBy: managedByCapabilityResolver, labelWorldName: world.Name, labelShardID: fmt.Sprintf("%d", id)},
+	); err != nil {
+		return false, err
+	}
+	for i := range bindings.Items {
+		provider := strings.TrimSpace(bindings.Items[i].Spec.Provider.ModuleManifestName)
+		if provider == "" {
+			continue
+		}
+		var mm binderyv1alpha1.ModuleManifest
+		if err := r.Get(ctx, types.NamespacedName{Namespace: world.Namespace, Name: provider}, &mm); err != nil {
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		if isServerOrchestrated(&mm) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// deploymentAvailable requires at least one available replica, since a
+// Deployment scaled to zero keeps its Available condition.
+func deploymentAvailable(d *appsv1.Deployment) bool {
+	if d.Status.AvailableReplicas == 0 {
+		return false
+	}
+	for _, c := range d.Status.Conditions {
+		if c.Type == appsv1.DeploymentAvailable {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func statefulSetAvailable(s *appsv1.StatefulSet) bool {
+	desired := int32(1)
+	if s.Spec.Replicas != nil {
+		desired = *s.Spec.Replicas
+	}
+	return s.Status.ObservedGeneration >= s.Generation && s.Status.AvailableReplicas > 0 && s.Status.AvailableReplicas >= desired
+}
+
+// enqueueWorldForShardWorkload maps a shard-labeled workload to its WorldInstance.
+func enqueueWorldForShardWorkload() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		labels := obj.GetLabels()
+		world := labels[rtLabelWorldName]
+		if world == "" || labels[labelShardID] == "" {
+			return nil
+		}
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: world}}}
+	})
+}
+
 func (r *WorldShardReconciler) recordEventf(obj client.Object, eventType, reason, messageFmt string, args ...any) {
 	if r.Recorder == nil || obj == nil {
 		return
@@ -143,6 +288,9 @@ func (r *WorldShardReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Named("worldshard").
 		For(&binderyv1alpha1.WorldInstance{}).
 		Owns(&binderyv1alpha1.WorldShard{}).
+		Watches(&appsv1.Deployment{}, enqueueWorldForShardWorkload()).
+		Watches(&appsv1.StatefulSet{}, enqueueWorldForShardWorkload()).
+		WithOptions(r.Options.controllerOptions()).
 		Complete(r)
 }
 
@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -36,7 +38,9 @@ func TestWorldShardController_CreatesShardsForWorld(t *testing.T) {
 		},
 	}
 
-	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world).Build()
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world).
+		WithStatusSubresource(&binderyv1alpha1.WorldShard{}).
+		Build()
 
 	r := &WorldShardReconciler{Client: cl, Scheme: scheme}
 	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "w1"}})
@@ -52,3 +56,188 @@ func TestWorldShardController_CreatesShardsForWorld(t *testing.T) {
 		t.Fatalf("expected 3 shards, got %d", len(list.Items))
 	}
 }
+
+func TestWorldShardController_ShardPendingUntilDeploymentAvailable(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "w1", Namespace: "ns"},
+		Spec: binderyv1alpha1.WorldInstanceSpec{
+			GameRef:    binderyv1alpha1.ObjectRef{Name: "g"},
+			WorldID:    "world-1",
+			Region:     "r",
+			ShardCount: 1,
+		},
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "w1-shard-0-physics",
+			Namespace: "ns",
+			Labels:    map[string]string{rtLabelWorldName: "w1", labelShardID: "0"},
+		},
+		Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{
+			{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionFalse, Reason: "MinimumReplicasUnavailable"},
+		}},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, deployment).
+		WithStatusSubresource(&binderyv1alpha1.WorldShard{}, &appsv1.Deployment{}).
+		Build()
+	r := &WorldShardReconciler{Client: cl, Scheme: scheme}
+	shardStatus := func() binderyv1alpha1.WorldShardStatus {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "w1"}}); err != nil {
+			t.Fatalf("Reconcile: %v", err)
+		}
+		var ws binderyv1alpha1.WorldShard
+		if err := cl.Get(ctx, types.NamespacedName{Namespace: "ns", Name: stableWorldShardName("w1", 0)}, &ws); err != nil {
+			t.Fatalf("get shard: %v", err)
+		}
+		return ws.Status
+	}
+
+	if st := shardStatus(); st.Phase != WorldShardPhasePending || st.Message != "0/1 workloads available" {
+		t.Fatalf("expected Pending with 0/1 available, got %+v", st)
+	}
+
+	deployment.Status.Conditions[0].Status = corev1.ConditionTrue
+	deployment.Status.AvailableReplicas = 1
+	if err := cl.Status().Update(ctx, deployment); err != nil {
+		t.Fatalf("update deployment status: %v", err)
+	}
+	if st := shardStatus(); st.Phase != WorldShardPhaseReady || st.Message != "1/1 workloads available" {
+		t.Fatalf("expected Ready with 1/1 available, got %+v", st)
+	}
+}
+
+func TestWorldShardController_PausedWorldShardNotReady(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "w1", Namespace: "ns"},
+		Spec: binderyv1alpha1.WorldInstanceSpec{
+			GameRef:      binderyv1alpha1.ObjectRef{Name: "g"},
+			WorldID:      "world-1",
+			Region:       "r",
+			ShardCount:   1,
+			DesiredState: binderyv1alpha1.WorldDesiredStatePaused,
+		},
+	}
+	// Scaled to zero by the pause, yet still reporting Available=True.
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "w1-shard-0-physics",
+			Namespace: "ns",
+			Labels:    map[string]string{rtLabelWorldName: "w1", labelShardID: "0"},
+		},
+		Spec: appsv1.DeploymentSpec{Replicas: int32Ptr(0)},
+		Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{
+			{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue, Reason: "MinimumReplicasAvailable"},
+		}},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, deployment).
+		WithStatusSubresource(&binderyv1alpha1.WorldShard{}, &appsv1.Deployment{}).
+		Build()
+	r := &WorldShardReconciler{Client: cl, Scheme: scheme}
+	shardStatus := func() binderyv1alpha1.WorldShardStatus {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "w1"}}); err != nil {
+			t.Fatalf("Reconcile: %v", err)
+		}
+		var ws binderyv1alpha1.WorldShard
+		if err := cl.Get(ctx, types.NamespacedName{Namespace: "ns", Name: stableWorldShardName("w1", 0)}, &ws); err != nil {
+			t.Fatalf("get shard: %v", err)
+		}
+		return ws.Status
+	}
+
+	if st := shardStatus(); st.Phase != WorldShardPhasePending || st.Message != "World is paused" {
+		t.Fatalf("expected paused shard to be Pending, got %+v", st)
+	}
+
+	// Resuming does not make the shard Ready until a replica is available again.
+	world.Spec.DesiredState = binderyv1alpha1.WorldDesiredStateRunning
+	if err := cl.Update(ctx, world); err != nil {
+		t.Fatalf("resume world: %v", err)
+	}
+	if st := shardStatus(); st.Phase != WorldShardPhasePending || st.Message != "0/1 workloads available" {
+		t.Fatalf("expected Pending with 0/1 available after resume, got %+v", st)
+	}
+}
+
+func TestWorldShardController_ShardWithoutServerWorkloadsIsReady(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = binderyv1alpha1.AddToScheme(scheme)
+
+	world := &binderyv1alpha1.WorldInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "w1", Namespace: "ns"},
+		Spec: binderyv1alpha1.WorldInstanceSpec{
+			GameRef:    binderyv1alpha1.ObjectRef{Name: "g"},
+			WorldID:    "world-1",
+			Region:     "r",
+			ShardCount: 1,
+		},
+	}
+	// Client-side module: bound to the shard but never run by the orchestrator.
+	clientModule := &binderyv1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "hud", Namespace: "ns"},
+	}
+	serverModule := &binderyv1alpha1.ModuleManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "physics", Namespace: "ns"},
+		Spec:       binderyv1alpha1.ModuleManifestSpec{Runtime: &binderyv1alpha1.ModuleRuntimeSpec{Image: "img"}},
+	}
+	shardBinding := func(name, provider string) *binderyv1alpha1.CapabilityBinding {
+		return &binderyv1alpha1.CapabilityBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "ns",
+				Labels:    map[string]string{labelManagedBy: managedByCapabilityResolver, labelWorldName: "w1", labelShardID: "0"},
+			},
+			Spec: binderyv1alpha1.CapabilityBindingSpec{
+				Scope:    binderyv1alpha1.CapabilityScopeWorldShard,
+				WorldRef: &binderyv1alpha1.WorldRef{Name: "w1"},
+				Provider: binderyv1alpha1.ProviderRef{ModuleManifestName: provider},
+			},
+		}
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(world, clientModule, serverModule, shardBinding("b-hud", "hud")).
+		WithStatusSubresource(&binderyv1alpha1.WorldShard{}).
+		Build()
+	r := &WorldShardReconciler{Client: cl, Scheme: scheme}
+	shardStatus := func() binderyv1alpha1.WorldShardStatus {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "w1"}}); err != nil {
+			t.Fatalf("Reconcile: %v", err)
+		}
+		var ws binderyv1alpha1.WorldShard
+		if err := cl.Get(ctx, types.NamespacedName{Namespace: "ns", Name: stableWorldShardName("w1", 0)}, &ws); err != nil {
+			t.Fatalf("get shard: %v", err)
+		}
+		return ws.Status
+	}
+
+	if st := shardStatus(); st.Phase != WorldShardPhaseReady || st.Message != "No server workloads" {
+		t.Fatalf("expected Ready with no server workloads, got %+v", st)
+	}
+
+	// A server module bound to the shard keeps it Pending until its workload exists.
+	if err := cl.Create(ctx, shardBinding("b-physics", "physics")); err != nil {
+		t.Fatalf("create binding: %v", err)
+	}
+	if st := shardStatus(); st.Phase != WorldShardPhasePending {
+		t.Fatalf("expected Pending while the server workload is missing, got %+v", st)
+	}
+}
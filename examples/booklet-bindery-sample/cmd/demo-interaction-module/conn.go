@@ -0,0 +1,72 @@
+package main
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+)
+
+// dialFunc opens a client connection to target.
+type dialFunc func(target string) (*grpc.ClientConn, error)
+
+func dialInsecure(target string) (*grpc.ClientConn, error) {
+	return grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
+
+// persistentConn keeps one grpc.ClientConn for the life of the client loop.
+// grpc already reconnects a live ClientConn when the transport drops; the
+// connection is only discarded and re-dialed after a call fails in a way that
+// suggests it is unusable (see connectionLost).
+type persistentConn struct {
+	target string
+	dial   dialFunc
+	conn   *grpc.ClientConn
+}
+
+func newPersistentConn(target string, dial dialFunc) *persistentConn {
+	if dial == nil {
+		dial = dialInsecure
+	}
+	return &persistentConn{target: target, dial: dial}
+}
+
+// client returns a client on the current connection, dialing first if there is none.
+func (p *persistentConn) client() (enginev1.EngineModuleClient, error) {
+	if p.conn == nil {
+		conn, err := p.dial(p.target)
+		if err != nil {
+			return nil, err
+		}
+		p.conn = conn
+	}
+	return enginev1.NewEngineModuleClient(p.conn), nil
+}
+
+// observe inspects the error of a call made on the connection and drops the
+// connection if it looks lost, so the next client call re-dials. It reports
+// whether the connection was dropped.
+func (p *persistentConn) observe(err error) bool {
+	if err == nil || p.conn == nil || !connectionLost(err) {
+		return false
+	}
+	_ = p.conn.Close()
+	p.conn = nil
+	return true
+}
+
+// Close closes the current connection, if any.
+func (p *persistentConn) Close() {
+	if p.conn != nil {
+		_ = p.conn.Close()
+		p.conn = nil
+	}
+}
+
+// connectionLost reports whether err is a transport-level failure (the
+// server could not be reached) rather than an error returned by the engine.
+func connectionLost(err error) bool {
+	return status.Code(err) == codes.Unavailable
+}
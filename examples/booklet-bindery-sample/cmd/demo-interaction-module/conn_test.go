@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+)
+
+func TestPersistentConn_ReusesConnectionAndRedialsAfterFailure(t *testing.T) {
+	serve := func() (*grpc.Server, *bufconn.Listener) {
+		lis := bufconn.Listen(1 << 20)
+		srv := grpc.NewServer()
+		enginev1.RegisterEngineModuleServer(srv, &server{})
+		go func() { _ = srv.Serve(lis) }()
+		return srv, lis
+	}
+	srv, lis := serve()
+
+	dials := 0
+	pc := newPersistentConn("passthrough:///physics", func(target string) (*grpc.ClientConn, error) {
+		dials++
+		current := lis
+		return grpc.NewClient(target,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return current.DialContext(ctx) }),
+		)
+	})
+	defer pc.Close()
+
+	call := func() error {
+		t.Helper()
+		c, err := pc.client()
+		if err != nil {
+			t.Fatalf("client: %v", err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		_, err = c.Tick(ctx, &enginev1.TickRequest{WorldId: "world-1"})
+		return err
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := call(); err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+	}
+	if dials != 1 {
+		t.Fatalf("expected one dial across successful calls, got %d", dials)
+	}
+
+	// Losing the server makes calls fail as Unavailable, which drops the connection.
+	srv.Stop()
+	err := call()
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected Unavailable after the server stopped, got %v", err)
+	}
+	if !pc.observe(err) {
+		t.Fatalf("expected an Unavailable error to drop the connection")
+	}
+
+	srv, lis = serve()
+	defer srv.Stop()
+	if err := call(); err != nil {
+		t.Fatalf("call after re-dial: %v", err)
+	}
+	if dials != 2 {
+		t.Fatalf("expected exactly one re-dial, got %d dials", dials)
+	}
+}
+
+func TestPersistentConn_KeepsConnectionOnEngineErrors(t *testing.T) {
+	dials := 0
+	pc := newPersistentConn("passthrough:///physics", func(target string) (*grpc.ClientConn, error) {
+		dials++
+		return grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	})
+	defer pc.Close()
+
+	if _, err := pc.client(); err != nil {
+		t.Fatalf("client: %v", err)
+	}
+	if pc.observe(status.Error(codes.InvalidArgument, "bad command")) {
+		t.Fatalf("an application error must not drop the connection")
+	}
+	if _, err := pc.client(); err != nil {
+		t.Fatalf("client: %v", err)
+	}
+	if dials != 1 {
+		t.Fatalf("expected the connection to be reused, got %d dials", dials)
+	}
+
+	failing := newPersistentConn("physics", func(string) (*grpc.ClientConn, error) { return nil, errors.New("boom") })
+	if _, err := failing.client(); err == nil {
+		t.Fatalf("expected the dial error to surface")
+	}
+}
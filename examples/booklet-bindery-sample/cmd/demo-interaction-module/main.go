@@ -12,9 +12,9 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+	"github.com/bayleafwalker/bindery-sample-game/internal/demoserver"
 )
 
 type server struct {
@@ -71,6 +71,7 @@ func main() {
 
 	grpcServer := grpc.NewServer()
 	enginev1.RegisterEngineModuleServer(grpcServer, &server{})
+	healthServer := demoserver.RegisterStandardServices(grpcServer)
 
 	physicsTarget := strings.TrimSpace(os.Getenv("BINDERY_CAPABILITY_PHYSICS_ENGINE_ENDPOINT"))
 	worldID := strings.TrimSpace(os.Getenv("BINDERY_DEMO_WORLD_ID"))
@@ -86,7 +87,7 @@ func main() {
 	snapshotInterval := time.Duration(envInt("BINDERY_DEMO_SNAPSHOT_INTERVAL_MS", 500)) * time.Millisecond
 
 	if physicsTarget != "" {
-		go runClientLoop(physicsTarget, worldID, actorID, commandInterval, snapshotInterval)
+		go runClientLoop(physicsTarget, worldID, actorID, commandInterval, snapshotInterval, commandMixFromEnv(), int64(envInt("BINDERY_DEMO_SEED", 0)))
 	} else {
 		fmt.Printf("No physics dependency injected (BINDERY_CAPABILITY_PHYSICS_ENGINE_ENDPOINT is empty)\n")
 	}
@@ -95,6 +96,7 @@ func main() {
 	if err != nil {
 		panic(fmt.Errorf("listen %s: %w", listenAddr, err))
 	}
+	demoserver.MarkServing(healthServer)
 	fmt.Printf("demo-interaction: listen=%s world=%s actor=%s physics=%s\n", listenAddr, worldID, actorID, physicsTarget)
 
 	if err := grpcServer.Serve(lis); err != nil {
@@ -102,24 +104,30 @@ func main() {
 	}
 }
 
-func runClientLoop(target, worldID, actorID string, commandInterval, snapshotInterval time.Duration) {
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+// runClientLoop spawns the actor's entity, then sends one command per interval
+// drawn from mix. A non-zero seed makes the command sequence reproducible.
+func runClientLoop(target, worldID, actorID string, commandInterval, snapshotInterval time.Duration, mix commandMix, seed int64) {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	rng := rand.New(rand.NewSource(seed))
 	entityID := fmt.Sprintf("entity-%s", actorID)
 
 	var spawned bool
 	var lastSnapshot time.Time
 	var smokePrinted bool
 
+	pc := newPersistentConn(target, nil)
+	defer pc.Close()
+
 	for {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		c, err := pc.client()
 		if err != nil {
-			cancel()
 			fmt.Printf("physics dial failed (%s): %v\n", target, err)
 			time.Sleep(1 * time.Second)
 			continue
 		}
-		c := enginev1.NewEngineModuleClient(conn)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 
 		now := time.Now()
 		if !spawned {
@@ -128,7 +136,11 @@ func runClientLoop(target, worldID, actorID string, commandInterval, snapshotInt
 				CommandId:          reqID,
 				ActorId:            actorID,
 				IssuedAtUnixMillis: now.UnixMilli(),
-				Payload:            &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: entityID}},
+				Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{
+					EntityId: entityID,
+					// Health gives damage commands something to act on.
+					Components: []*enginev1.Component{{Payload: &enginev1.Component_Health{Health: &enginev1.HealthComponent{Current: spawnHealth, Max: spawnHealth}}}},
+				}},
 			}
 			resp, err := c.ApplyCommand(ctx, &enginev1.ApplyCommandRequest{
 				WorldId:   worldID,
@@ -137,48 +149,38 @@ func runClientLoop(target, worldID, actorID string, commandInterval, snapshotInt
 			})
 			if err != nil {
 				cancel()
-				_ = conn.Close()
+				pc.observe(err)
 				fmt.Printf("spawn ApplyCommand failed: %v\n", err)
 				time.Sleep(1 * time.Second)
 				continue
 			}
 			if resp.GetError() != nil {
 				cancel()
-				_ = conn.Close()
 				fmt.Printf("spawn rejected: code=%s message=%q\n", resp.GetError().GetCode().String(), resp.GetError().GetMessage())
 				time.Sleep(1 * time.Second)
 				continue
 			}
 			spawned = true
 		} else {
-			reqID := fmt.Sprintf("move-%d", now.UnixNano())
-			cmd := &enginev1.Command{
-				CommandId:          reqID,
-				ActorId:            actorID,
-				IssuedAtUnixMillis: now.UnixMilli(),
-				Payload: &enginev1.Command_Move{Move: &enginev1.MoveCommand{
-					EntityId: entityID,
-					Position: &enginev1.Vec3{
-						X: float64(rng.Intn(10)),
-						Y: float64(rng.Intn(10)),
-						Z: 0,
-					},
-				}},
-			}
+			action := mix.pick(rng)
+			cmd := mixCommand(action, rng, actorID, entityID, now)
 			resp, err := c.ApplyCommand(ctx, &enginev1.ApplyCommandRequest{
 				WorldId:   worldID,
-				RequestId: reqID,
+				RequestId: cmd.GetCommandId(),
 				Command:   cmd,
 			})
 			if err != nil {
 				cancel()
-				_ = conn.Close()
-				fmt.Printf("move ApplyCommand failed: %v\n", err)
+				pc.observe(err)
+				fmt.Printf("%s ApplyCommand failed: %v\n", action, err)
 				time.Sleep(1 * time.Second)
 				continue
 			}
 			if resp.GetError() != nil {
-				fmt.Printf("move rejected: code=%s message=%q\n", resp.GetError().GetCode().String(), resp.GetError().GetMessage())
+				fmt.Printf("%s rejected: code=%s message=%q\n", action, resp.GetError().GetCode().String(), resp.GetError().GetMessage())
+			} else if action == actionDespawn {
+				// Respawn on the next iteration so the entity keeps receiving traffic.
+				spawned = false
 			}
 		}
 
@@ -190,6 +192,7 @@ func runClientLoop(target, worldID, actorID string, commandInterval, snapshotInt
 				Selector:  &enginev1.GetStateSnapshotRequest_Latest{Latest: &enginev1.SnapshotLatest{}},
 			})
 			if err != nil {
+				pc.observe(err)
 				fmt.Printf("GetStateSnapshot failed: %v\n", err)
 			} else if snap.GetOk() != nil {
 				state := snap.GetOk().GetWorldState()
@@ -204,12 +207,23 @@ func runClientLoop(target, worldID, actorID string, commandInterval, snapshotInt
 		}
 
 		cancel()
-		_ = conn.Close()
 
 		time.Sleep(commandInterval)
 	}
 }
 
+func envFloat(name string, def float64) float64 {
+	raw := strings.TrimSpace(os.Getenv(name))
+	if raw == "" {
+		return def
+	}
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
 func envInt(name string, def int) int {
 	raw := strings.TrimSpace(os.Getenv(name))
 	if raw == "" {
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+)
+
+// mixAction is one kind of command the client loop can send once its entity exists.
+type mixAction int
+
+const (
+	actionMove mixAction = iota
+	actionDespawn
+	actionDamage
+)
+
+func (a mixAction) String() string {
+	switch a {
+	case actionDespawn:
+		return "despawn"
+	case actionDamage:
+		return "damage"
+	default:
+		return "move"
+	}
+}
+
+// damageCommandType is the OpaqueCommand type used for damage; the engine
+// contract has no typed damage command, and the demo physics engine applies
+// this one to the entity's health component.
+const damageCommandType = "demo.damage"
+
+// spawnHealth is the health the client's entity spawns with.
+const spawnHealth = 100
+
+// commandMix weights the actions the client loop picks each iteration. Weights
+// are relative (they need not sum to 1) and negative weights count as 0. With
+// no positive weight every pick is a move, matching the original demo.
+type commandMix struct {
+	Move    float64
+	Despawn float64
+	Damage  float64
+}
+
+func commandMixFromEnv() commandMix {
+	return commandMix{
+		Move:    envFloat("BINDERY_DEMO_MIX_MOVE", 1),
+		Despawn: envFloat("BINDERY_DEMO_MIX_DESPAWN", 0),
+		Damage:  envFloat("BINDERY_DEMO_MIX_DAMAGE", 0),
+	}
+}
+
+// pick draws an action from rng in proportion to the mix weights.
+func (m commandMix) pick(rng *rand.Rand) mixAction {
+	weights := [...]float64{actionMove: m.Move, actionDespawn: m.Despawn, actionDamage: m.Damage}
+	var total float64
+	for i, w := range weights {
+		if w < 0 {
+			weights[i] = 0
+		}
+		total += weights[i]
+	}
+	if total <= 0 {
+		return actionMove
+	}
+	r := rng.Float64() * total
+	for i, w := range weights {
+		if r < w {
+			return mixAction(i)
+		}
+		r -= w
+	}
+	// Float rounding can leave r at the boundary; fall back to the last weighted action.
+	for i := len(weights) - 1; i >= 0; i-- {
+		if weights[i] > 0 {
+			return mixAction(i)
+		}
+	}
+	return actionMove
+}
+
+// mixCommand builds the command for action against entityID.
+func mixCommand(action mixAction, rng *rand.Rand, actorID, entityID string, now time.Time) *enginev1.Command {
+	cmd := &enginev1.Command{
+		CommandId:          fmt.Sprintf("%s-%d", action, now.UnixNano()),
+		ActorId:            actorID,
+		IssuedAtUnixMillis: now.UnixMilli(),
+	}
+	switch action {
+	case actionDespawn:
+		cmd.Payload = &enginev1.Command_DespawnEntity{DespawnEntity: &enginev1.DespawnEntityCommand{EntityId: entityID}}
+	case actionDamage:
+		data, _ := json.Marshal(map[string]any{"entityId": entityID, "amount": 1 + rng.Intn(10)})
+		cmd.Payload = &enginev1.Command_Opaque{Opaque: &enginev1.OpaqueCommand{Type: damageCommandType, Data: data}}
+	default:
+		cmd.Payload = &enginev1.Command_Move{Move: &enginev1.MoveCommand{
+			EntityId: entityID,
+			Position: &enginev1.Vec3{
+				X: float64(rng.Intn(10)),
+				Y: float64(rng.Intn(10)),
+				Z: 0,
+			},
+		}}
+	}
+	return cmd
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestCommandMix_PickHonorsWeights(t *testing.T) {
+	mix := commandMix{Move: 6, Despawn: 1, Damage: 3}
+	rng := rand.New(rand.NewSource(42))
+
+	const n = 20000
+	counts := map[mixAction]int{}
+	for i := 0; i < n; i++ {
+		counts[mix.pick(rng)]++
+	}
+	want := map[mixAction]float64{actionMove: 0.6, actionDespawn: 0.1, actionDamage: 0.3}
+	for action, share := range want {
+		got := float64(counts[action]) / n
+		if math.Abs(got-share) > 0.02 {
+			t.Fatalf("%s: expected share %.2f, got %.3f (counts %v)", action, share, got, counts)
+		}
+	}
+
+	// The same seed replays the same sequence.
+	a, b := rand.New(rand.NewSource(7)), rand.New(rand.NewSource(7))
+	for i := 0; i < 100; i++ {
+		if x, y := mix.pick(a), mix.pick(b); x != y {
+			t.Fatalf("pick %d diverged with equal seeds: %s vs %s", i, x, y)
+		}
+	}
+}
+
+func TestCommandMix_ZeroWeightsNeverPicked(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	only := commandMix{Damage: 2, Despawn: -1}
+	for i := 0; i < 1000; i++ {
+		if got := only.pick(rng); got != actionDamage {
+			t.Fatalf("expected only damage, got %s", got)
+		}
+	}
+	if got := (commandMix{}).pick(rng); got != actionMove {
+		t.Fatalf("expected an empty mix to fall back to move, got %s", got)
+	}
+}
+
+func TestMixCommand_UsesTypedPayloads(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	now := time.Unix(0, 123)
+	if cmd := mixCommand(actionDespawn, rng, "a", "e1", now); cmd.GetDespawnEntity().GetEntityId() != "e1" || cmd.GetCommandId() != "despawn-123" {
+		t.Fatalf("unexpected despawn command %v", cmd)
+	}
+	if cmd := mixCommand(actionMove, rng, "a", "e1", now); cmd.GetMove().GetEntityId() != "e1" || cmd.GetMove().GetPosition() == nil {
+		t.Fatalf("unexpected move command %v", cmd)
+	}
+	if cmd := mixCommand(actionDamage, rng, "a", "e1", now); cmd.GetOpaque().GetType() != damageCommandType {
+		t.Fatalf("unexpected damage command %v", cmd)
+	}
+}
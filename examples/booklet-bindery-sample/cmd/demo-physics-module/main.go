@@ -2,17 +2,27 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"log/slog"
 	"net"
+	"net/http"
+	"net/http/pprof"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 
 	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+	"github.com/bayleafwalker/bindery-sample-game/internal/demoserver"
 	"github.com/bayleafwalker/bindery-sample-game/internal/physics"
 )
 
@@ -27,12 +37,16 @@ func (s *server) InitializeWorld(ctx context.Context, req *enginev1.InitializeWo
 		return &enginev1.InitializeWorldResponse{Result: &enginev1.InitializeWorldResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "request is nil")}}, nil
 	}
 	if strings.TrimSpace(req.GetWorldId()) == "" {
-		return &enginev1.InitializeWorldResponse{Result: &enginev1.InitializeWorldResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "world_id is empty")}}, nil
+		return &enginev1.InitializeWorldResponse{Result: &enginev1.InitializeWorldResponse_Error{Error: errField(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "world_id is empty", "world_id")}}, nil
 	}
 
-	initialTick, err := s.engine.InitializeWorld(req.GetWorldId())
+	initialTick, err := s.engine.InitializeWorld(req.GetWorldId(), req.GetForce(), req.GetConfig().GetValues())
 	if err != nil {
-		return &enginev1.InitializeWorldResponse{Result: &enginev1.InitializeWorldResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_INTERNAL, err.Error())}}, nil
+		code := enginev1.StatusCode_STATUS_CODE_INTERNAL
+		if errors.Is(err, physics.ErrInvalidWorldConfig) {
+			return &enginev1.InitializeWorldResponse{Result: &enginev1.InitializeWorldResponse_Error{Error: errField(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, err.Error(), "config")}}, nil
+		}
+		return &enginev1.InitializeWorldResponse{Result: &enginev1.InitializeWorldResponse_Error{Error: errStatus(code, err.Error())}}, nil
 	}
 
 	return &enginev1.InitializeWorldResponse{
@@ -45,20 +59,50 @@ func (s *server) InitializeWorld(ctx context.Context, req *enginev1.InitializeWo
 	}, nil
 }
 
+func (s *server) ResetWorld(ctx context.Context, req *enginev1.ResetWorldRequest) (*enginev1.ResetWorldResponse, error) {
+	_ = ctx
+	if req == nil {
+		return &enginev1.ResetWorldResponse{Result: &enginev1.ResetWorldResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "request is nil")}}, nil
+	}
+	if strings.TrimSpace(req.GetWorldId()) == "" {
+		return &enginev1.ResetWorldResponse{Result: &enginev1.ResetWorldResponse_Error{Error: errField(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "world_id is empty", "world_id")}}, nil
+	}
+
+	tick, err := s.engine.ResetWorld(req.GetWorldId())
+	if err != nil {
+		code := enginev1.StatusCode_STATUS_CODE_INTERNAL
+		if errors.Is(err, physics.ErrWorldNotFound) {
+			return &enginev1.ResetWorldResponse{Result: &enginev1.ResetWorldResponse_Error{Error: errField(enginev1.StatusCode_STATUS_CODE_NOT_FOUND, err.Error(), "world_id")}}, nil
+		}
+		return &enginev1.ResetWorldResponse{Result: &enginev1.ResetWorldResponse_Error{Error: errStatus(code, err.Error())}}, nil
+	}
+
+	return &enginev1.ResetWorldResponse{Result: &enginev1.ResetWorldResponse_Ok{Ok: &enginev1.ResetWorldOk{Tick: tick}}}, nil
+}
+
 func (s *server) ApplyCommand(ctx context.Context, req *enginev1.ApplyCommandRequest) (*enginev1.ApplyCommandResponse, error) {
 	_ = ctx
 	if req == nil {
 		return &enginev1.ApplyCommandResponse{Result: &enginev1.ApplyCommandResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "request is nil")}}, nil
 	}
 	if strings.TrimSpace(req.GetWorldId()) == "" {
-		return &enginev1.ApplyCommandResponse{Result: &enginev1.ApplyCommandResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "world_id is empty")}}, nil
+		return &enginev1.ApplyCommandResponse{Result: &enginev1.ApplyCommandResponse_Error{Error: errField(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "world_id is empty", "world_id")}}, nil
 	}
 	if req.Command == nil {
-		return &enginev1.ApplyCommandResponse{Result: &enginev1.ApplyCommandResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "command is nil")}}, nil
+		return &enginev1.ApplyCommandResponse{Result: &enginev1.ApplyCommandResponse_Error{Error: errField(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "command is nil", "command")}}, nil
 	}
 
-	appliedTick, err := s.engine.EnqueueCommand(req.GetWorldId(), req.Command, req.GetDryRun())
+	appliedTick, err := s.engine.EnqueueCommand(req.GetWorldId(), req.Command, req.GetDryRun(), req.GetExpectedCurrentTick())
 	if err != nil {
+		if errors.Is(err, physics.ErrTickConflict) {
+			return &enginev1.ApplyCommandResponse{Result: &enginev1.ApplyCommandResponse_Error{Error: errField(enginev1.StatusCode_STATUS_CODE_CONFLICT, err.Error(), "expected_current_tick")}}, nil
+		}
+		if errors.Is(err, physics.ErrQueueFull) {
+			return &enginev1.ApplyCommandResponse{Result: &enginev1.ApplyCommandResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_RESOURCE_EXHAUSTED, err.Error())}}, nil
+		}
+		if errors.Is(err, physics.ErrWorldNotInitialized) {
+			return &enginev1.ApplyCommandResponse{Result: &enginev1.ApplyCommandResponse_Error{Error: errField(enginev1.StatusCode_STATUS_CODE_FAILED_PRECONDITION, err.Error(), "world_id")}}, nil
+		}
 		return &enginev1.ApplyCommandResponse{Result: &enginev1.ApplyCommandResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_FAILED_PRECONDITION, err.Error())}}, nil
 	}
 
@@ -71,37 +115,50 @@ func (s *server) ApplyCommand(ctx context.Context, req *enginev1.ApplyCommandReq
 	}, nil
 }
 
-func (s *server) Tick(ctx context.Context, req *enginev1.TickRequest) (*enginev1.TickResponse, error) {
+func (s *server) ValidateCommand(ctx context.Context, req *enginev1.ValidateCommandRequest) (*enginev1.ValidateCommandResponse, error) {
 	_ = ctx
+	if err := physics.ValidateCommand(req.GetCommand()); err != nil {
+		return &enginev1.ValidateCommandResponse{Result: &enginev1.ValidateCommandResponse_Error{Error: errField(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, err.Error(), "command")}}, nil
+	}
+	return &enginev1.ValidateCommandResponse{Result: &enginev1.ValidateCommandResponse_Ok{Ok: &enginev1.ValidateCommandOk{}}}, nil
+}
+
+func (s *server) Tick(ctx context.Context, req *enginev1.TickRequest) (*enginev1.TickResponse, error) {
 	if req == nil {
 		return &enginev1.TickResponse{Result: &enginev1.TickResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "request is nil")}}, nil
 	}
 	if strings.TrimSpace(req.GetWorldId()) == "" {
-		return &enginev1.TickResponse{Result: &enginev1.TickResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "world_id is empty")}}, nil
+		return &enginev1.TickResponse{Result: &enginev1.TickResponse_Error{Error: errField(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "world_id is empty", "world_id")}}, nil
 	}
 
-	newTick, events, err := s.engine.Tick(req.GetWorldId(), req.GetExpectedCurrentTick(), req.GetTargetTick())
+	newTick, events, err := s.engine.TickContext(ctx, req.GetWorldId(), req.GetExpectedCurrentTick(), req.GetTargetTick())
 	if err != nil {
+		if isContextError(err) {
+			return nil, status.FromContextError(err).Err()
+		}
+		if errors.Is(err, physics.ErrWorldNotInitialized) {
+			return &enginev1.TickResponse{Result: &enginev1.TickResponse_Error{Error: errField(enginev1.StatusCode_STATUS_CODE_FAILED_PRECONDITION, err.Error(), "world_id")}}, nil
+		}
 		return &enginev1.TickResponse{Result: &enginev1.TickResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_CONFLICT, err.Error())}}, nil
 	}
 
 	return &enginev1.TickResponse{
 		Result: &enginev1.TickResponse_Ok{
 			Ok: &enginev1.TickOk{
-				NewTick: newTick,
-				Events:  events,
+				NewTick:  newTick,
+				Events:   physics.FilterEvents(events, req.GetEventTypePrefixes()),
+				Metadata: physics.TickMetadata(req.GetTargetTick(), newTick),
 			},
 		},
 	}, nil
 }
 
 func (s *server) GetStateSnapshot(ctx context.Context, req *enginev1.GetStateSnapshotRequest) (*enginev1.GetStateSnapshotResponse, error) {
-	_ = ctx
 	if req == nil {
 		return &enginev1.GetStateSnapshotResponse{Result: &enginev1.GetStateSnapshotResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "request is nil")}}, nil
 	}
 	if strings.TrimSpace(req.GetWorldId()) == "" {
-		return &enginev1.GetStateSnapshotResponse{Result: &enginev1.GetStateSnapshotResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "world_id is empty")}}, nil
+		return &enginev1.GetStateSnapshotResponse{Result: &enginev1.GetStateSnapshotResponse_Error{Error: errField(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "world_id is empty", "world_id")}}, nil
 	}
 
 	var atTick *int64
@@ -111,21 +168,102 @@ func (s *server) GetStateSnapshot(ctx context.Context, req *enginev1.GetStateSna
 		atTick = &t
 	}
 
-	ws, err := s.engine.Snapshot(req.GetWorldId(), atTick, req.GetEntityIds(), req.GetIncludeComponents())
+	ws, err := s.engine.SnapshotByTags(req.GetWorldId(), atTick, req.GetEntityIds(), req.GetTags(), req.GetIncludeComponents())
 	if err != nil {
+		if errors.Is(err, physics.ErrWorldNotInitialized) {
+			return &enginev1.GetStateSnapshotResponse{Result: &enginev1.GetStateSnapshotResponse_Error{Error: errField(enginev1.StatusCode_STATUS_CODE_FAILED_PRECONDITION, err.Error(), "world_id")}}, nil
+		}
 		return &enginev1.GetStateSnapshotResponse{Result: &enginev1.GetStateSnapshotResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_FAILED_PRECONDITION, err.Error())}}, nil
 	}
 
+	// Building the snapshot can take a while for large worlds; skip serializing
+	// it for a caller that has already gone away.
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	ok := &enginev1.GetStateSnapshotOk{
+		WorldState: ws,
+		Metadata:   map[string]string{"demo": "true"},
+	}
+	if req.GetCompress() {
+		data, err := physics.CompressWorldState(ws)
+		if err != nil {
+			return &enginev1.GetStateSnapshotResponse{Result: &enginev1.GetStateSnapshotResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_INTERNAL, err.Error())}}, nil
+		}
+		ok.WorldState = nil
+		ok.CompressedWorldState = data
+		ok.Metadata[physics.MetadataContentEncoding] = physics.ContentEncodingGzip
+	}
+
 	return &enginev1.GetStateSnapshotResponse{
-		Result: &enginev1.GetStateSnapshotResponse_Ok{
-			Ok: &enginev1.GetStateSnapshotOk{
-				WorldState: ws,
-				Metadata:   map[string]string{"demo": "true"},
-			},
+		Result: &enginev1.GetStateSnapshotResponse_Ok{Ok: ok},
+	}, nil
+}
+
+// isContextError reports whether err comes from a cancelled or expired context.
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+func (s *server) GetStateSnapshots(ctx context.Context, req *enginev1.GetStateSnapshotsRequest) (*enginev1.GetStateSnapshotsResponse, error) {
+	_ = ctx
+	if req == nil {
+		return &enginev1.GetStateSnapshotsResponse{Result: &enginev1.GetStateSnapshotsResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "request is nil")}}, nil
+	}
+
+	snaps := s.engine.SnapshotMany(req.GetWorldIds(), req.GetIncludeComponents())
+	results := make([]*enginev1.WorldSnapshotResult, 0, len(snaps))
+	for _, snap := range snaps {
+		r := &enginev1.WorldSnapshotResult{WorldId: snap.WorldID}
+		if snap.Err != nil {
+			code := enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT
+			if errors.Is(snap.Err, physics.ErrWorldNotFound) {
+				code = enginev1.StatusCode_STATUS_CODE_NOT_FOUND
+			}
+			r.Result = &enginev1.WorldSnapshotResult_Error{Error: errStatus(code, snap.Err.Error())}
+		} else {
+			r.Result = &enginev1.WorldSnapshotResult_WorldState{WorldState: snap.State}
+		}
+		results = append(results, r)
+	}
+
+	return &enginev1.GetStateSnapshotsResponse{
+		Result: &enginev1.GetStateSnapshotsResponse_Ok{Ok: &enginev1.GetStateSnapshotsOk{Results: results}},
+	}, nil
+}
+
+func (s *server) ListWorlds(ctx context.Context, req *enginev1.ListWorldsRequest) (*enginev1.ListWorldsResponse, error) {
+	_ = ctx
+	_ = req
+	return &enginev1.ListWorldsResponse{
+		Result: &enginev1.ListWorldsResponse_Ok{
+			Ok: &enginev1.ListWorldsOk{Worlds: s.engine.ListWorlds()},
 		},
 	}, nil
 }
 
+func (s *server) GetEvents(ctx context.Context, req *enginev1.GetEventsRequest) (*enginev1.GetEventsResponse, error) {
+	_ = ctx
+	if req == nil {
+		return &enginev1.GetEventsResponse{Result: &enginev1.GetEventsResponse_Error{Error: errStatus(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "request is nil")}}, nil
+	}
+	if strings.TrimSpace(req.GetWorldId()) == "" {
+		return &enginev1.GetEventsResponse{Result: &enginev1.GetEventsResponse_Error{Error: errField(enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "world_id is empty", "world_id")}}, nil
+	}
+
+	ok, err := s.engine.GetEvents(req.GetWorldId(), req.GetFromTick(), req.GetToTick())
+	if err != nil {
+		code := enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT
+		if errors.Is(err, physics.ErrEventsNotRetained) || errors.Is(err, physics.ErrWorldNotInitialized) {
+			code = enginev1.StatusCode_STATUS_CODE_FAILED_PRECONDITION
+		}
+		return &enginev1.GetEventsResponse{Result: &enginev1.GetEventsResponse_Error{Error: errStatus(code, err.Error())}}, nil
+	}
+
+	return &enginev1.GetEventsResponse{Result: &enginev1.GetEventsResponse_Ok{Ok: ok}}, nil
+}
+
 func errStatus(code enginev1.StatusCode, message string) *enginev1.Error {
 	return &enginev1.Error{
 		Code:    code,
@@ -133,39 +271,151 @@ func errStatus(code enginev1.StatusCode, message string) *enginev1.Error {
 	}
 }
 
+// errField is errStatus with metadata["field"] naming the request field at fault.
+func errField(code enginev1.StatusCode, message, field string) *enginev1.Error {
+	e := errStatus(code, message)
+	e.Metadata = map[string]string{"field": field}
+	return e
+}
+
 func main() {
 	var listenAddr string
+	var metricsAddr string
 	flag.StringVar(&listenAddr, "listen", ":50051", "address to listen on")
+	flag.StringVar(&metricsAddr, "metrics-bind-address", envString("BINDERY_DEMO_METRICS_ADDR", ":9090"), "address to serve /metrics on (empty disables)")
 	flag.Parse()
 
 	maxPerTick := envInt("BINDERY_DEMO_MAX_COMMANDS_PER_TICK", 16)
 	tickInterval := time.Duration(envInt("BINDERY_DEMO_TICK_INTERVAL_MS", 200)) * time.Millisecond
 	autoTick := envBool("BINDERY_DEMO_AUTOTICK", true)
 
-	eng := physics.New(physics.Config{MaxCommandsPerTick: maxPerTick})
+	spawnJitter := envFloat("BINDERY_DEMO_SPAWN_JITTER", 0)
+	maxStepsPerTick := envInt("BINDERY_DEMO_MAX_STEPS_PER_TICK", 1000)
+	dedupeWindow := envInt("BINDERY_DEMO_COMMAND_DEDUPE_WINDOW", 4096)
+	maxEntities := envInt("BINDERY_DEMO_MAX_ENTITIES_PER_WORLD", 0)
+	maxQueued := envInt("BINDERY_DEMO_MAX_QUEUED_COMMANDS", 0)
+	integrateVelocity := envBool("BINDERY_DEMO_INTEGRATE_VELOCITY", false)
+	requireInit := envBool("BINDERY_DEMO_REQUIRE_EXPLICIT_INIT", false)
+	worldIdleTTL := time.Duration(envInt("BINDERY_DEMO_WORLD_IDLE_TTL_SECONDS", 0)) * time.Second
+	stateDir := envString("BINDERY_DEMO_STATE_DIR", "")
+	shutdownTimeout := time.Duration(envInt("BINDERY_DEMO_SHUTDOWN_TIMEOUT_SECONDS", 25)) * time.Second
+
+	logLevel := slog.LevelInfo
+	if envBool("BINDERY_DEMO_DEBUG", false) {
+		logLevel = slog.LevelDebug
+	}
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: logLevel}))
+
+	eng := physics.New(physics.Config{MaxCommandsPerTick: maxPerTick, TickObserver: observeTick, SpawnJitter: spawnJitter, MaxStepsPerTick: int64(maxStepsPerTick), CommandDedupeWindow: dedupeWindow, MaxEntitiesPerWorld: maxEntities, MaxQueuedCommands: maxQueued, IntegrateVelocity: integrateVelocity, RequireExplicitInit: requireInit, WorldIdleTTL: worldIdleTTL, OnEvict: evictWorld(stateDir), Logger: logger})
+
+	if metricsAddr != "" {
+		reg := prometheus.NewRegistry()
+		reg.MustRegister(&engineCollector{stats: eng}, physicsTickDuration)
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+		go func() {
+			if err := http.ListenAndServe(metricsAddr, mux); err != nil {
+				fmt.Printf("demo-physics: metrics server stopped: %v\n", err)
+			}
+		}()
+	}
+
+	// Profiling gets its own mux and listener so it never shares a port with
+	// metrics or gRPC.
+	if pprofAddr := envString("BINDERY_PPROF_ADDR", ""); pprofAddr != "" {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/debug/pprof/", pprof.Index)
+		mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+		mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+		mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+		go func() {
+			if err := http.ListenAndServe(pprofAddr, mux); err != nil {
+				fmt.Printf("demo-physics: pprof server stopped: %v\n", err)
+			}
+		}()
+	}
 
 	if autoTick {
+		if err := eng.StartAutoTick(tickInterval); err != nil {
+			panic(fmt.Errorf("auto-tick: %w", err))
+		}
+	}
+
+	if worldIdleTTL > 0 {
+		// Sweeping at half the TTL keeps an idle world at most 1.5x TTL old.
 		go func() {
-			t := time.NewTicker(tickInterval)
+			t := time.NewTicker(worldIdleTTL / 2)
 			defer t.Stop()
 			for range t.C {
-				_ = eng.TickAll()
+				eng.EvictIdleWorlds()
 			}
 		}()
 	}
 
 	grpcServer := grpc.NewServer()
 	enginev1.RegisterEngineModuleServer(grpcServer, &server{engine: eng})
+	healthServer := demoserver.RegisterStandardServices(grpcServer)
 
 	lis, err := net.Listen("tcp", listenAddr)
 	if err != nil {
 		panic(fmt.Errorf("listen %s: %w", listenAddr, err))
 	}
-	fmt.Printf("demo-physics: listen=%s autotick=%t tickInterval=%s maxCommandsPerTick=%d\n", listenAddr, autoTick, tickInterval, maxPerTick)
+	demoserver.MarkServing(healthServer)
+	fmt.Printf("demo-physics: listen=%s metrics=%s autotick=%t tickInterval=%s maxCommandsPerTick=%d\n", listenAddr, metricsAddr, autoTick, tickInterval, maxPerTick)
 
-	if err := grpcServer.Serve(lis); err != nil {
-		panic(fmt.Errorf("grpc serve: %w", err))
+	sigCtx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+	serveErr := make(chan error, 1)
+	go func() { serveErr <- grpcServer.Serve(lis) }()
+
+	select {
+	case err := <-serveErr:
+		if err != nil {
+			panic(fmt.Errorf("grpc serve: %w", err))
+		}
+	case <-sigCtx.Done():
+	}
+	// Fail health checks first so probes stop routing here while RPCs drain.
+	healthServer.Shutdown()
+	shutdown(grpcServer, eng, stateDir, shutdownTimeout)
+}
+
+// shutdown drains in-flight RPCs, stops auto-ticking so world state settles,
+// and saves every world to stateDir (if set), all within timeout. timeout
+// should stay below the pod's termination grace period.
+func shutdown(grpcServer *grpc.Server, eng *physics.Engine, stateDir string, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	drained := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		grpcServer.Stop()
+	}
+	eng.StopAutoTick()
+
+	if stateDir == "" {
+		return
+	}
+	saved, err := saveWorlds(ctx, eng, stateDir)
+	if err != nil {
+		fmt.Printf("demo-physics: shutdown save failed: %v\n", err)
+		return
+	}
+	fmt.Printf("demo-physics: saved %d worlds to %s\n", saved, stateDir)
+}
+
+func envString(name, def string) string {
+	if raw, ok := os.LookupEnv(name); ok {
+		return strings.TrimSpace(raw)
 	}
+	return def
 }
 
 func envInt(name string, def int) int {
@@ -180,6 +430,18 @@ func envInt(name string, def int) int {
 	return v
 }
 
+func envFloat(name string, def float64) float64 {
+	raw := strings.TrimSpace(os.Getenv(name))
+	if raw == "" {
+		return def
+	}
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
 func envBool(name string, def bool) bool {
 	raw := strings.TrimSpace(os.Getenv(name))
 	if raw == "" {
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+	"github.com/bayleafwalker/bindery-sample-game/internal/physics"
+)
+
+func TestServer_ValidationErrorsNameTheField(t *testing.T) {
+	s := &server{engine: physics.New(physics.Config{})}
+	ctx := context.Background()
+
+	initResp, err := s.InitializeWorld(ctx, &enginev1.InitializeWorldRequest{})
+	if err != nil {
+		t.Fatalf("InitializeWorld: %v", err)
+	}
+	applyResp, err := s.ApplyCommand(ctx, &enginev1.ApplyCommandRequest{WorldId: "world-1"})
+	if err != nil {
+		t.Fatalf("ApplyCommand: %v", err)
+	}
+	tickResp, err := s.Tick(ctx, &enginev1.TickRequest{})
+	if err != nil {
+		t.Fatalf("Tick: %v", err)
+	}
+	validateResp, err := s.ValidateCommand(ctx, &enginev1.ValidateCommandRequest{Command: &enginev1.Command{CommandId: "c1"}})
+	if err != nil {
+		t.Fatalf("ValidateCommand: %v", err)
+	}
+	resetResp, err := s.ResetWorld(ctx, &enginev1.ResetWorldRequest{WorldId: "missing"})
+	if err != nil {
+		t.Fatalf("ResetWorld: %v", err)
+	}
+
+	cases := []struct {
+		name  string
+		err   *enginev1.Error
+		code  enginev1.StatusCode
+		field string
+	}{
+		{"InitializeWorld", initResp.GetError(), enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "world_id"},
+		{"ApplyCommand", applyResp.GetError(), enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "command"},
+		{"Tick", tickResp.GetError(), enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "world_id"},
+		{"ValidateCommand", validateResp.GetError(), enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "command"},
+		{"ResetWorld", resetResp.GetError(), enginev1.StatusCode_STATUS_CODE_NOT_FOUND, "world_id"},
+	}
+	for _, tc := range cases {
+		if tc.err == nil {
+			t.Fatalf("%s: expected an error result", tc.name)
+		}
+		if tc.err.GetCode() != tc.code {
+			t.Fatalf("%s: expected code %s, got %s", tc.name, tc.code, tc.err.GetCode())
+		}
+		if got := tc.err.GetMetadata()["field"]; got != tc.field {
+			t.Fatalf("%s: expected field %q, got %q (message %q)", tc.name, tc.field, got, tc.err.GetMessage())
+		}
+		if tc.err.GetMessage() == "" {
+			t.Fatalf("%s: expected a human-readable message", tc.name)
+		}
+	}
+}
+
+func TestServer_ApplyCommandTickConflictNamesExpectedTick(t *testing.T) {
+	s := &server{engine: physics.New(physics.Config{})}
+	ctx := context.Background()
+	if _, err := s.engine.InitializeWorld("world-1", false, nil); err != nil {
+		t.Fatalf("init: %v", err)
+	}
+	if _, _, err := s.engine.Tick("world-1", 0, 2); err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+
+	resp, err := s.ApplyCommand(ctx, &enginev1.ApplyCommandRequest{
+		WorldId:             "world-1",
+		ExpectedCurrentTick: 1,
+		Command: &enginev1.Command{
+			CommandId: "c1",
+			Payload:   &enginev1.Command_Opaque{Opaque: &enginev1.OpaqueCommand{}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ApplyCommand: %v", err)
+	}
+	e := resp.GetError()
+	if e.GetCode() != enginev1.StatusCode_STATUS_CODE_CONFLICT || e.GetMetadata()["field"] != "expected_current_tick" {
+		t.Fatalf("unexpected error: %v", e)
+	}
+}
+
+func TestServer_TickFiltersEventsByTypePrefix(t *testing.T) {
+	s := &server{engine: physics.New(physics.Config{})}
+	ctx := context.Background()
+	if _, err := s.engine.InitializeWorld("world-1", false, nil); err != nil {
+		t.Fatalf("init: %v", err)
+	}
+	for _, cmd := range []*enginev1.Command{
+		{CommandId: "spawn", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}}},
+		{CommandId: "walk", Payload: &enginev1.Command_MoveToward{MoveToward: &enginev1.MoveTowardCommand{
+			EntityId: "e1", Target: &enginev1.Vec3{X: 1}, MaxSpeed: 5,
+		}}},
+	} {
+		if _, err := s.engine.EnqueueCommand("world-1", cmd, false, 0); err != nil {
+			t.Fatalf("enqueue %s: %v", cmd.GetCommandId(), err)
+		}
+	}
+
+	// Both commands apply on tick 1 and the entity arrives that tick, so the
+	// unfiltered tick would return two applied events and one arrival.
+	resp, err := s.Tick(ctx, &enginev1.TickRequest{WorldId: "world-1", EventTypePrefixes: []string{"physics.entity."}})
+	if err != nil {
+		t.Fatalf("Tick: %v", err)
+	}
+	events := resp.GetOk().GetEvents()
+	if len(events) != 1 || events[0].GetType() != physics.EventTypeArrived {
+		t.Fatalf("expected only the arrived event, got %v", events)
+	}
+	if resp.GetOk().GetNewTick() != 1 {
+		t.Fatalf("expected the tick to advance to 1, got %d", resp.GetOk().GetNewTick())
+	}
+
+	// Filtered events are still retained for GetEvents.
+	all, err := s.engine.GetEvents("world-1", 1, 1)
+	if err != nil {
+		t.Fatalf("GetEvents: %v", err)
+	}
+	if len(all.GetEvents()) != 3 {
+		t.Fatalf("expected all 3 events retained, got %v", all.GetEvents())
+	}
+}
+
+func TestServer_StrictModeRejectsUninitializedWorld(t *testing.T) {
+	s := &server{engine: physics.New(physics.Config{RequireExplicitInit: true})}
+	ctx := context.Background()
+
+	applyResp, err := s.ApplyCommand(ctx, &enginev1.ApplyCommandRequest{WorldId: "typo", Command: &enginev1.Command{
+		CommandId: "c1",
+		Payload:   &enginev1.Command_Opaque{Opaque: &enginev1.OpaqueCommand{}},
+	}})
+	if err != nil {
+		t.Fatalf("ApplyCommand: %v", err)
+	}
+	tickResp, err := s.Tick(ctx, &enginev1.TickRequest{WorldId: "typo"})
+	if err != nil {
+		t.Fatalf("Tick: %v", err)
+	}
+	snapResp, err := s.GetStateSnapshot(ctx, &enginev1.GetStateSnapshotRequest{WorldId: "typo"})
+	if err != nil {
+		t.Fatalf("GetStateSnapshot: %v", err)
+	}
+
+	for name, e := range map[string]*enginev1.Error{"ApplyCommand": applyResp.GetError(), "Tick": tickResp.GetError(), "GetStateSnapshot": snapResp.GetError()} {
+		if e.GetCode() != enginev1.StatusCode_STATUS_CODE_FAILED_PRECONDITION || e.GetMetadata()["field"] != "world_id" {
+			t.Fatalf("%s: expected FAILED_PRECONDITION on world_id, got %v", name, e)
+		}
+	}
+}
+
+func TestServer_CancelledContextAbortsTickAndSnapshot(t *testing.T) {
+	s := &server{engine: physics.New(physics.Config{})}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := s.Tick(ctx, &enginev1.TickRequest{WorldId: "world-1", TargetTick: 500}); status.Code(err) != codes.Canceled {
+		t.Fatalf("Tick: expected Canceled, got %v", err)
+	}
+	if _, err := s.GetStateSnapshot(ctx, &enginev1.GetStateSnapshotRequest{WorldId: "world-1"}); status.Code(err) != codes.Canceled {
+		t.Fatalf("GetStateSnapshot: expected Canceled, got %v", err)
+	}
+
+	resp, err := s.GetStateSnapshot(context.Background(), &enginev1.GetStateSnapshotRequest{WorldId: "world-1"})
+	if err != nil {
+		t.Fatalf("GetStateSnapshot: %v", err)
+	}
+	if tick := resp.GetOk().GetWorldState().GetTick(); tick != 0 {
+		t.Fatalf("expected the cancelled tick to leave the world at tick 0, got %d", tick)
+	}
+}
+
+func TestServer_FullQueueReturnsResourceExhausted(t *testing.T) {
+	s := &server{engine: physics.New(physics.Config{MaxQueuedCommands: 3})}
+	ctx := context.Background()
+	apply := func(id string, dryRun bool) *enginev1.ApplyCommandResponse {
+		t.Helper()
+		resp, err := s.ApplyCommand(ctx, &enginev1.ApplyCommandRequest{WorldId: "w1", DryRun: dryRun, Command: &enginev1.Command{
+			CommandId: id,
+			Payload:   &enginev1.Command_Opaque{Opaque: &enginev1.OpaqueCommand{}},
+		}})
+		if err != nil {
+			t.Fatalf("ApplyCommand %s: %v", id, err)
+		}
+		return resp
+	}
+
+	rejected := 0
+	for i := 0; i < 10; i++ {
+		resp := apply(fmt.Sprintf("flood-%d", i), false)
+		if e := resp.GetError(); e != nil {
+			if e.GetCode() != enginev1.StatusCode_STATUS_CODE_RESOURCE_EXHAUSTED {
+				t.Fatalf("flood-%d: expected RESOURCE_EXHAUSTED, got %v", i, e)
+			}
+			rejected++
+		}
+	}
+	if rejected != 7 {
+		t.Fatalf("expected 7 rejections past the cap of 3, got %d", rejected)
+	}
+
+	if e := apply("dry", true).GetError(); e != nil {
+		t.Fatalf("dry-run must not count against the cap, got %v", e)
+	}
+
+	if _, err := s.Tick(ctx, &enginev1.TickRequest{WorldId: "w1"}); err != nil {
+		t.Fatalf("Tick: %v", err)
+	}
+	if e := apply("after-tick", false).GetError(); e != nil {
+		t.Fatalf("expected a drained queue to accept again, got %v", e)
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/bayleafwalker/bindery-sample-game/internal/physics"
+)
+
+var (
+	physicsTicksDesc = prometheus.NewDesc(
+		"bindery_physics_ticks_total",
+		"Number of tick steps applied per world.",
+		[]string{"world"}, nil,
+	)
+	physicsEntitiesDesc = prometheus.NewDesc(
+		"bindery_physics_entities",
+		"Number of entities currently present per world.",
+		[]string{"world"}, nil,
+	)
+	physicsQueuedCommandsDesc = prometheus.NewDesc(
+		"bindery_physics_queued_commands",
+		"Number of commands waiting to be applied per world.",
+		[]string{"world"}, nil,
+	)
+
+	physicsTickDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "bindery_physics_tick_duration_seconds",
+			Help:    "Time taken to apply a Tick request.",
+			Buckets: []float64{.0001, .0005, .001, .0025, .005, .01, .025, .05, .1},
+		},
+		[]string{"world"},
+	)
+)
+
+// engineCollector reads per-world counters from the engine at scrape time.
+type engineCollector struct {
+	stats physics.StatsReader
+}
+
+func (c *engineCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- physicsTicksDesc
+	ch <- physicsEntitiesDesc
+	ch <- physicsQueuedCommandsDesc
+}
+
+func (c *engineCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, ws := range c.stats.WorldStats() {
+		ch <- prometheus.MustNewConstMetric(physicsTicksDesc, prometheus.CounterValue, float64(ws.Ticks), ws.WorldID)
+		ch <- prometheus.MustNewConstMetric(physicsEntitiesDesc, prometheus.GaugeValue, float64(ws.Entities), ws.WorldID)
+		ch <- prometheus.MustNewConstMetric(physicsQueuedCommandsDesc, prometheus.GaugeValue, float64(ws.QueuedCommands), ws.WorldID)
+	}
+}
+
+func observeTick(worldID string, d time.Duration) {
+	physicsTickDuration.WithLabelValues(worldID).Observe(d.Seconds())
+}
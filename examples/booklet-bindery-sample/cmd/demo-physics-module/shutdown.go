@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+	"github.com/bayleafwalker/bindery-sample-game/internal/physics"
+)
+
+// savedWorldSuffix names the files saveWorlds writes, one per world.
+const savedWorldSuffix = ".worldstate.gz"
+
+// saveWorlds writes every world held by eng to dir, returning how many were
+// saved. Each file is written under a temporary name and renamed into place,
+// so an interrupted save never leaves a truncated state file behind. It stops
+// early once ctx is done (e.g. the termination grace period is running out).
+func saveWorlds(ctx context.Context, eng *physics.Engine, dir string) (int, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return 0, fmt.Errorf("create state dir: %w", err)
+	}
+	saved := 0
+	for _, summary := range eng.ListWorlds() {
+		if err := ctx.Err(); err != nil {
+			return saved, fmt.Errorf("saved %d worlds before stopping: %w", saved, err)
+		}
+		id := summary.GetWorldId()
+		if err := saveWorld(eng, id, filepath.Join(dir, url.PathEscape(id)+savedWorldSuffix)); err != nil {
+			return saved, fmt.Errorf("save world %q: %w", id, err)
+		}
+		saved++
+	}
+	return saved, nil
+}
+
+func saveWorld(eng *physics.Engine, worldID, path string) error {
+	return writeStateFile(path, func(out io.Writer) error { return eng.SaveWorld(worldID, out) })
+}
+
+// evictWorld is the physics.Config.OnEvict hook: it drops the evicted world's
+// tick-duration series and, if stateDir is set, saves its final state there in
+// the same format as saveWorlds.
+func evictWorld(stateDir string) func(worldID string, state *enginev1.WorldState) {
+	return func(worldID string, state *enginev1.WorldState) {
+		physicsTickDuration.DeleteLabelValues(worldID)
+		if stateDir == "" {
+			return
+		}
+		err := os.MkdirAll(stateDir, 0o755)
+		if err == nil {
+			err = writeStateFile(filepath.Join(stateDir, url.PathEscape(worldID)+savedWorldSuffix), func(out io.Writer) error {
+				data, err := physics.CompressWorldState(state)
+				if err != nil {
+					return err
+				}
+				_, err = out.Write(data)
+				return err
+			})
+		}
+		if err != nil {
+			fmt.Printf("demo-physics: save evicted world %q failed: %v\n", worldID, err)
+		}
+	}
+}
+
+// writeStateFile writes path via a temporary file in the same directory that
+// is renamed into place once write succeeds.
+func writeStateFile(path string, write func(io.Writer) error) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".save-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := write(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
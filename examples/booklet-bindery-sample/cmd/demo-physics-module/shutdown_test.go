@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+	"github.com/bayleafwalker/bindery-sample-game/internal/physics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestSaveWorlds_WritesEveryWorld(t *testing.T) {
+	eng := physics.New(physics.Config{})
+	ticks := map[string]int{"alpha": 1, "beta": 3, "team/gamma": 0}
+	for id, n := range ticks {
+		if _, err := eng.InitializeWorld(id, false, nil); err != nil {
+			t.Fatalf("init %s: %v", id, err)
+		}
+		if _, err := eng.EnqueueCommand(id, &enginev1.Command{
+			CommandId: "spawn",
+			Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}},
+		}, false, 0); err != nil {
+			t.Fatalf("enqueue %s: %v", id, err)
+		}
+		for i := 0; i < n; i++ {
+			if _, _, err := eng.Tick(id, 0, 0); err != nil {
+				t.Fatalf("tick %s: %v", id, err)
+			}
+		}
+	}
+
+	dir := filepath.Join(t.TempDir(), "state")
+	saved, err := saveWorlds(context.Background(), eng, dir)
+	if err != nil {
+		t.Fatalf("saveWorlds: %v", err)
+	}
+	if saved != len(ticks) {
+		t.Fatalf("expected %d worlds saved, got %d", len(ticks), saved)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("read state dir: %v", err)
+	}
+	if len(entries) != len(ticks) {
+		t.Fatalf("expected only the %d state files, got %v", len(ticks), entries)
+	}
+	for id, n := range ticks {
+		data, err := os.ReadFile(filepath.Join(dir, url.PathEscape(id)+savedWorldSuffix))
+		if err != nil {
+			t.Fatalf("read %s: %v", id, err)
+		}
+		ws, err := physics.DecompressWorldState(data)
+		if err != nil {
+			t.Fatalf("decode %s: %v", id, err)
+		}
+		if ws.GetTick() != int64(n) {
+			t.Fatalf("%s: expected tick %d, got %d", id, n, ws.GetTick())
+		}
+		if wantEntities := min(n, 1); len(ws.GetEntities()) != wantEntities {
+			t.Fatalf("%s: expected %d entities, got %d", id, wantEntities, len(ws.GetEntities()))
+		}
+	}
+}
+
+func TestSaveWorlds_StopsWhenContextIsDone(t *testing.T) {
+	eng := physics.New(physics.Config{})
+	if _, err := eng.InitializeWorld("alpha", false, nil); err != nil {
+		t.Fatalf("init: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	saved, err := saveWorlds(ctx, eng, t.TempDir())
+	if err == nil || saved != 0 {
+		t.Fatalf("expected a cancelled save to stop before writing, got saved=%d err=%v", saved, err)
+	}
+}
+
+func TestEvictWorld_DropsMetricsAndSavesState(t *testing.T) {
+	now := time.Unix(1000, 0)
+	dir := filepath.Join(t.TempDir(), "state")
+	eng := physics.New(physics.Config{
+		WorldIdleTTL: time.Minute,
+		Now:          func() time.Time { return now },
+		TickObserver: observeTick,
+		OnEvict:      evictWorld(dir),
+	})
+	if _, _, err := eng.Tick("idle-world", 0, 0); err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+	if n := testutil.CollectAndCount(physicsTickDuration); n == 0 {
+		t.Fatalf("expected a tick duration series before eviction")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if ids := eng.EvictIdleWorlds(); len(ids) != 1 {
+		t.Fatalf("expected one evicted world, got %v", ids)
+	}
+	if err := testutil.CollectAndCompare(physicsTickDuration, strings.NewReader("")); err != nil {
+		t.Fatalf("expected tick duration series to be dropped: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "idle-world"+savedWorldSuffix))
+	if err != nil {
+		t.Fatalf("read evicted world: %v", err)
+	}
+	ws, err := physics.DecompressWorldState(data)
+	if err != nil {
+		t.Fatalf("decode evicted world: %v", err)
+	}
+	if ws.GetTick() != 1 {
+		t.Fatalf("expected saved tick 1, got %d", ws.GetTick())
+	}
+}
@@ -0,0 +1,31 @@
+// Package demoserver holds gRPC server setup shared by the demo module binaries,
+// mirroring bindery-core's moduleserver package.
+package demoserver
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+)
+
+// RegisterStandardServices registers grpc.health.v1.Health and server reflection on s.
+//
+// Health starts NOT_SERVING for both the overall server ("") and the EngineModule
+// service; call MarkServing once the listener is up.
+func RegisterStandardServices(s *grpc.Server) *health.Server {
+	hs := health.NewServer()
+	hs.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	hs.SetServingStatus(enginev1.EngineModule_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(s, hs)
+	reflection.Register(s)
+	return hs
+}
+
+// MarkServing reports SERVING for the overall server and the EngineModule service.
+func MarkServing(hs *health.Server) {
+	hs.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	hs.SetServingStatus(enginev1.EngineModule_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+}
@@ -0,0 +1,62 @@
+package physics
+
+import (
+	"errors"
+	"time"
+)
+
+// autoTicker is the state of a running StartAutoTick loop.
+type autoTicker struct {
+	stop chan struct{}
+	done chan struct{}
+}
+
+// StartAutoTick advances every world by one step each interval until
+// StopAutoTick is called. It fails if auto-ticking is already running or
+// interval is not positive.
+//
+// Auto-ticks take the same per-world locks as Tick, so explicit Tick calls
+// and enqueued commands can be mixed with the scheduler.
+func (e *Engine) StartAutoTick(interval time.Duration) error {
+	if interval <= 0 {
+		return errors.New("auto-tick interval must be positive")
+	}
+	e.autoTickMu.Lock()
+	defer e.autoTickMu.Unlock()
+	if e.autoTick != nil {
+		return errors.New("auto-tick is already running")
+	}
+	at := &autoTicker{stop: make(chan struct{}), done: make(chan struct{})}
+	e.autoTick = at
+	go func() {
+		defer close(at.done)
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-at.stop:
+				return
+			case <-t.C:
+				_ = e.TickAll()
+			}
+		}
+	}()
+	e.log.Debug("auto-tick started", "interval", interval)
+	return nil
+}
+
+// StopAutoTick stops the StartAutoTick loop and waits for an in-flight
+// TickAll to finish, so no auto-tick runs after it returns. It is a no-op if
+// auto-ticking is not running.
+func (e *Engine) StopAutoTick() {
+	e.autoTickMu.Lock()
+	at := e.autoTick
+	e.autoTick = nil
+	e.autoTickMu.Unlock()
+	if at == nil {
+		return
+	}
+	close(at.stop)
+	<-at.done
+	e.log.Debug("auto-tick stopped")
+}
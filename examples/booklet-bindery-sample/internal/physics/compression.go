@@ -0,0 +1,77 @@
+package physics
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/proto"
+
+	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+)
+
+// Snapshot metadata describing how GetStateSnapshotOk.compressed_world_state is encoded.
+const (
+	MetadataContentEncoding = "content-encoding"
+	ContentEncodingGzip     = "gzip"
+)
+
+// CompressWorldState serializes ws and gzips it for GetStateSnapshotOk.compressed_world_state.
+func CompressWorldState(ws *enginev1.WorldState) ([]byte, error) {
+	raw, err := proto.Marshal(ws)
+	if err != nil {
+		return nil, fmt.Errorf("marshal world state: %w", err)
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(raw); err != nil {
+		return nil, fmt.Errorf("gzip world state: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("gzip world state: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// DecompressWorldState reverses CompressWorldState.
+func DecompressWorldState(data []byte) (*enginev1.WorldState, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("gunzip world state: %w", err)
+	}
+	defer zr.Close()
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("gunzip world state: %w", err)
+	}
+	var ws enginev1.WorldState
+	if err := proto.Unmarshal(raw, &ws); err != nil {
+		return nil, fmt.Errorf("unmarshal world state: %w", err)
+	}
+	return &ws, nil
+}
+
+// SaveWorld writes worldID's current state, components included, to out in the
+// CompressWorldState encoding. Unlike Snapshot it never creates worlds: unknown
+// ids fail with ErrWorldNotFound.
+func (e *Engine) SaveWorld(worldID string, out io.Writer) error {
+	worldID = normalizeID(worldID)
+	e.mu.Lock()
+	w := e.worlds[worldID]
+	e.mu.Unlock()
+	if w == nil {
+		return fmt.Errorf("%w: %q", ErrWorldNotFound, worldID)
+	}
+
+	ws, err := w.snapshot(worldID, nil, nil, nil, true)
+	if err != nil {
+		return err
+	}
+	data, err := CompressWorldState(ws)
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(data)
+	return err
+}
@@ -0,0 +1,99 @@
+package physics
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidWorldConfig is returned by InitializeWorld when the per-world config
+// contains a malformed value or a required key the engine does not understand.
+var ErrInvalidWorldConfig = errors.New("invalid world config")
+
+// Recognized per-world config keys. Each overrides the matching engine Config
+// field for a single world.
+const (
+	ConfigKeyMaxCommandsPerTick  = "physics.maxCommandsPerTick"
+	ConfigKeySpawnJitter         = "physics.spawnJitter"
+	ConfigKeyEventRetentionTicks = "physics.eventRetentionTicks"
+	ConfigKeyIntegrateVelocity   = "physics.integrateVelocity"
+)
+
+// configRequiredPrefix marks a key the caller requires the engine to honor.
+// Unknown keys are ignored unless they carry this prefix, in which case
+// initialization fails rather than silently running with defaults.
+const configRequiredPrefix = "!"
+
+// worldParams are the tunables a world is created with.
+type worldParams struct {
+	maxCommandsPerTick int
+	spawnJitter        float64
+	eventRetention     int64
+	dedupeWindow       int
+	maxEntities        int
+	maxQueued          int
+	integrateVelocity  bool
+}
+
+func (e *Engine) defaultParams() worldParams {
+	return worldParams{
+		maxCommandsPerTick: e.maxCommandsPerTick,
+		spawnJitter:        e.spawnJitter,
+		eventRetention:     e.eventRetention,
+		dedupeWindow:       e.dedupeWindow,
+		maxEntities:        e.maxEntities,
+		maxQueued:          e.maxQueued,
+		integrateVelocity:  e.integrateVelocity,
+	}
+}
+
+// applyWorldConfig overlays recognized keys from values onto p.
+func applyWorldConfig(p worldParams, values map[string]string) (worldParams, error) {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, raw := range keys {
+		key := strings.TrimSpace(raw)
+		required := strings.HasPrefix(key, configRequiredPrefix)
+		key = strings.TrimPrefix(key, configRequiredPrefix)
+		value := strings.TrimSpace(values[raw])
+
+		switch key {
+		case ConfigKeyMaxCommandsPerTick:
+			n, err := strconv.Atoi(value)
+			if err != nil || n <= 0 {
+				return p, fmt.Errorf("%w: %s=%q must be a positive integer", ErrInvalidWorldConfig, key, value)
+			}
+			p.maxCommandsPerTick = n
+		case ConfigKeySpawnJitter:
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil || f < 0 || math.IsNaN(f) || math.IsInf(f, 0) {
+				return p, fmt.Errorf("%w: %s=%q must be a finite non-negative number", ErrInvalidWorldConfig, key, value)
+			}
+			p.spawnJitter = f
+		case ConfigKeyEventRetentionTicks:
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || n <= 0 {
+				return p, fmt.Errorf("%w: %s=%q must be a positive integer", ErrInvalidWorldConfig, key, value)
+			}
+			p.eventRetention = n
+		case ConfigKeyIntegrateVelocity:
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return p, fmt.Errorf("%w: %s=%q must be a boolean", ErrInvalidWorldConfig, key, value)
+			}
+			p.integrateVelocity = b
+		default:
+			if required {
+				return p, fmt.Errorf("%w: unknown required key %q", ErrInvalidWorldConfig, key)
+			}
+		}
+	}
+	return p, nil
+}
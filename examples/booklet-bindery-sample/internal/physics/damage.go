@@ -0,0 +1,56 @@
+package physics
+
+import (
+	"encoding/json"
+	"fmt"
+
+	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+)
+
+// CommandTypeDamage is the OpaqueCommand type the engine applies as damage. The
+// contract has no typed damage command, so the payload is JSON:
+// {"entityId": "...", "amount": N} with N > 0.
+const CommandTypeDamage = "demo.damage"
+
+type damagePayload struct {
+	EntityID string `json:"entityId"`
+	Amount   int32  `json:"amount"`
+}
+
+func parseDamage(op *enginev1.OpaqueCommand) (damagePayload, error) {
+	var d damagePayload
+	if err := json.Unmarshal(op.GetData(), &d); err != nil {
+		return d, fmt.Errorf("opaque %s: invalid payload: %w", CommandTypeDamage, err)
+	}
+	d.EntityID = normalizeID(d.EntityID)
+	if d.EntityID == "" {
+		return d, fmt.Errorf("opaque %s: entityId is empty", CommandTypeDamage)
+	}
+	if d.Amount <= 0 {
+		return d, fmt.Errorf("opaque %s: amount=%d must be positive", CommandTypeDamage, d.Amount)
+	}
+	return d, nil
+}
+
+// applyDamageLocked lowers the target entity's current health by the damage
+// amount, stopping at 0.
+func applyDamageLocked(w *world, op *enginev1.OpaqueCommand) error {
+	d, err := parseDamage(op)
+	if err != nil {
+		return rejectf(enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_INVALID_PAYLOAD, enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "%s", err.Error())
+	}
+	e, ok := w.entities[d.EntityID]
+	if !ok {
+		return rejectf(enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_ENTITY_NOT_FOUND, enginev1.StatusCode_STATUS_CODE_NOT_FOUND, "entity %q not found", d.EntityID)
+	}
+	for _, c := range e.GetComponents() {
+		if h := c.GetHealth(); h != nil {
+			h.Current -= d.Amount
+			if h.Current < 0 {
+				h.Current = 0
+			}
+			return nil
+		}
+	}
+	return rejectf(enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_INVALID_PAYLOAD, enginev1.StatusCode_STATUS_CODE_FAILED_PRECONDITION, "entity %q has no health component", d.EntityID)
+}
@@ -0,0 +1,39 @@
+package physics
+
+// commandIDWindow remembers the most recent distinct command ids up to a fixed
+// capacity. Once full, remembering a new id forgets the oldest one, so memory
+// stays bounded while retries of recent commands are still recognized.
+type commandIDWindow struct {
+	ids   map[string]struct{}
+	order []string // ring buffer of remembered ids, oldest at next once full
+	next  int
+}
+
+func newCommandIDWindow(capacity int) *commandIDWindow {
+	return &commandIDWindow{
+		ids:   make(map[string]struct{}, capacity),
+		order: make([]string, 0, capacity),
+	}
+}
+
+func (c *commandIDWindow) contains(id string) bool {
+	_, ok := c.ids[id]
+	return ok
+}
+
+// add remembers id, evicting the oldest id when the window is full.
+func (c *commandIDWindow) add(id string) {
+	if c.contains(id) {
+		return
+	}
+	if len(c.order) < cap(c.order) {
+		c.order = append(c.order, id)
+	} else {
+		delete(c.ids, c.order[c.next])
+		c.order[c.next] = id
+		c.next = (c.next + 1) % len(c.order)
+	}
+	c.ids[id] = struct{}{}
+}
+
+func (c *commandIDWindow) len() int { return len(c.ids) }
@@ -1,10 +1,20 @@
 package physics
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
+	"io"
+	"log/slog"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
@@ -14,12 +24,177 @@ type Config struct {
 	// MaxCommandsPerTick bounds how many queued commands are applied per tick step.
 	// If <= 0, a safe default is used.
 	MaxCommandsPerTick int
+
+	// TickObserver, if set, is called after every Tick with the wall-clock time
+	// spent stepping the world. It must not call back into the Engine.
+	TickObserver func(worldID string, d time.Duration)
+
+	// SpawnJitter, if > 0 and finite, places spawns that carry no transform at a
+	// random offset within this radius on the X/Z plane. Offsets are drawn from the
+	// world's seeded RNG, so identical command sequences replay identically.
+	SpawnJitter float64
+
+	// EventRetentionTicks bounds how many recent ticks of events each world
+	// keeps for GetEvents. If <= 0, a safe default is used.
+	EventRetentionTicks int64
+
+	// MaxStepsPerTick bounds how many ticks a single Tick call may advance
+	// toward its targetTick. If <= 0, 1000 is used.
+	MaxStepsPerTick int64
+
+	// CommandDedupeWindow bounds how many recent command ids each world
+	// remembers to make ApplyCommand idempotent. Once exceeded, the oldest ids
+	// are forgotten and a command re-sent with one of them is treated as new.
+	// If <= 0, 4096 is used.
+	CommandDedupeWindow int
+
+	// MaxEntitiesPerWorld caps how many entities a world may hold. Spawns past
+	// the cap are rejected with STATUS_CODE_FAILED_PRECONDITION. If <= 0, worlds
+	// are unbounded.
+	MaxEntitiesPerWorld int
+
+	// MaxQueuedCommands caps how many commands a world may hold awaiting a
+	// tick. Past the cap EnqueueCommand fails with ErrQueueFull so clients back
+	// off while ticking is stalled; dry-run commands are never counted. If <= 0,
+	// queues are unbounded.
+	MaxQueuedCommands int
+
+	// IntegrateVelocity, if true, advances every entity's position by its
+	// transform velocity once per tick, after that tick's commands apply.
+	// Entities with a pending MoveTowardCommand are moved by that instead.
+	IntegrateVelocity bool
+
+	// RequireExplicitInit, if true, makes EnqueueCommand, Tick, Snapshot, and
+	// GetEvents fail with ErrWorldNotInitialized for worlds that InitializeWorld
+	// has not created, instead of creating them on first use. This catches
+	// mistyped world ids that would otherwise run against a fresh empty world.
+	RequireExplicitInit bool
+
+	// WorldIdleTTL, if > 0, lets EvictIdleWorlds drop worlds that no client has
+	// initialized, commanded, ticked, or snapshotted for this long. Auto-ticks
+	// do not count as use. If <= 0, worlds are kept until reset or restart.
+	WorldIdleTTL time.Duration
+
+	// OnEvict, if set, is called with the final state of each world that
+	// EvictIdleWorlds removes (e.g. to persist it). It runs after the world is
+	// dropped from the engine and must not call back into the Engine.
+	OnEvict func(worldID string, state *enginev1.WorldState)
+
+	// Now is the clock used for idle tracking. If nil, time.Now is used.
+	Now func() time.Time
+
+	// TickRecorder, if set, receives a TickRecord for every tick a world
+	// advances, in tick order, so callers can keep a log for ReplayFromEvents.
+	// It runs while the world is locked and must not call back into the Engine.
+	TickRecorder func(worldID string, rec TickRecord)
+
+	// Logger receives debug logs for world init, command rejection, and tick
+	// completion, and info logs for idle-world eviction. Records are emitted
+	// after world locks are released. If nil, logs are discarded.
+	Logger *slog.Logger
+}
+
+// ErrTickConflict is returned by EnqueueCommand when the world has advanced past
+// the caller's expected tick.
+var ErrTickConflict = errors.New("tick conflict")
+
+// ErrQueueFull is returned by EnqueueCommand when the world already holds
+// MaxQueuedCommands commands awaiting a tick.
+var ErrQueueFull = errors.New("command queue full")
+
+// Tick metadata reported when a Tick call stopped short of its target tick
+// because of MaxStepsPerTick.
+const (
+	MetadataTickClamped = "tickClamped"
+	MetadataTargetTick  = "targetTick"
+)
+
+// TickMetadata returns the TickOk metadata for a Tick call that asked for
+// targetTick and reached newTick, or nil if the target (if any) was reached.
+func TickMetadata(targetTick, newTick int64) map[string]string {
+	if targetTick <= 0 || newTick >= targetTick {
+		return nil
+	}
+	return map[string]string{
+		MetadataTickClamped: "true",
+		MetadataTargetTick:  strconv.FormatInt(targetTick, 10),
+	}
+}
+
+// FilterEvents returns the events whose type starts with one of prefixes, in
+// order. Empty prefixes return events unchanged.
+func FilterEvents(events []*enginev1.Event, prefixes []string) []*enginev1.Event {
+	if len(prefixes) == 0 {
+		return events
+	}
+	var out []*enginev1.Event
+	for _, ev := range events {
+		for _, p := range prefixes {
+			if strings.HasPrefix(ev.GetType(), p) {
+				out = append(out, ev)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// ErrEventsNotRetained is returned by GetEvents for ranges that start before
+// the world's retention window.
+var ErrEventsNotRetained = errors.New("events no longer retained")
+
+// ErrWorldNotInitialized is returned in RequireExplicitInit mode by calls on a
+// world that InitializeWorld has not created.
+var ErrWorldNotInitialized = errors.New("world not initialized")
+
+// ErrWorldNotFound is returned by ResetWorld and reported by SnapshotMany for
+// worlds the engine does not hold.
+var ErrWorldNotFound = errors.New("world not found")
+
+// SnapshotResult is the outcome of snapshotting one world in SnapshotMany.
+type SnapshotResult struct {
+	WorldID string
+	State   *enginev1.WorldState
+	Err     error
+}
+
+// WorldStats is a point-in-time view of a world's counters.
+type WorldStats struct {
+	WorldID        string
+	Ticks          int64
+	Entities       int64
+	QueuedCommands int64
 }
 
+// StatsReader exposes per-world counters without taking world locks, so
+// metrics collectors can scrape while ticks are in progress.
+type StatsReader interface {
+	WorldStats() []WorldStats
+}
+
+var _ StatsReader = (*Engine)(nil)
+
 type Engine struct {
 	mu                 sync.Mutex
 	worlds             map[string]*world
 	maxCommandsPerTick int
+	tickObserver       func(worldID string, d time.Duration)
+	spawnJitter        float64
+	eventRetention     int64
+	maxStepsPerTick    int64
+	dedupeWindow       int
+	maxEntities        int
+	maxQueued          int
+	integrateVelocity  bool
+	requireInit        bool
+	idleTTL            time.Duration
+	onEvict            func(worldID string, state *enginev1.WorldState)
+	now                func() time.Time
+	tickRecorder       func(worldID string, rec TickRecord)
+	log                *slog.Logger
+
+	autoTickMu sync.Mutex
+	autoTick   *autoTicker
 }
 
 func New(cfg Config) *Engine {
@@ -27,27 +202,119 @@ func New(cfg Config) *Engine {
 	if maxCommandsPerTick <= 0 {
 		maxCommandsPerTick = 16
 	}
+	eventRetention := cfg.EventRetentionTicks
+	if eventRetention <= 0 {
+		eventRetention = 256
+	}
+	maxStepsPerTick := cfg.MaxStepsPerTick
+	if maxStepsPerTick <= 0 {
+		maxStepsPerTick = 1000
+	}
+	spawnJitter := cfg.SpawnJitter
+	if math.IsNaN(spawnJitter) || math.IsInf(spawnJitter, 0) {
+		spawnJitter = 0
+	}
+	dedupeWindow := cfg.CommandDedupeWindow
+	if dedupeWindow <= 0 {
+		dedupeWindow = 4096
+	}
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+	now := cfg.Now
+	if now == nil {
+		now = time.Now
+	}
 	return &Engine{
+		log:                logger,
 		worlds:             make(map[string]*world),
 		maxCommandsPerTick: maxCommandsPerTick,
+		tickObserver:       cfg.TickObserver,
+		spawnJitter:        spawnJitter,
+		eventRetention:     eventRetention,
+		maxStepsPerTick:    maxStepsPerTick,
+		dedupeWindow:       dedupeWindow,
+		maxEntities:        cfg.MaxEntitiesPerWorld,
+		maxQueued:          cfg.MaxQueuedCommands,
+		integrateVelocity:  cfg.IntegrateVelocity,
+		requireInit:        cfg.RequireExplicitInit,
+		idleTTL:            cfg.WorldIdleTTL,
+		onEvict:            cfg.OnEvict,
+		now:                now,
+		tickRecorder:       cfg.TickRecorder,
 	}
 }
 
-func (e *Engine) InitializeWorld(worldID string) (int64, error) {
+// InitializeWorld creates worldID if it does not exist and returns its tick.
+//
+// Re-initializing an existing world is a no-op that reports the current tick,
+// so retried requests never discard state. force replaces the world with a
+// fresh one at tick 0.
+//
+// config overrides engine settings for this world only (see the ConfigKey
+// constants); it is applied when the world is created and ignored otherwise.
+func (e *Engine) InitializeWorld(worldID string, force bool, config map[string]string) (int64, error) {
 	worldID = normalizeID(worldID)
 	if worldID == "" {
 		return 0, errors.New("worldID is empty")
 	}
+	params, err := applyWorldConfig(e.defaultParams(), config)
+	if err != nil {
+		return 0, err
+	}
 
 	e.mu.Lock()
-	defer e.mu.Unlock()
+	tick, created := int64(0), true
+	if w, ok := e.worlds[worldID]; ok && !force {
+		w.mu.Lock()
+		tick, created = w.tick, false
+		w.mu.Unlock()
+		w.touch(e.now())
+	} else {
+		w := newWorld(worldID, params)
+		w.touch(e.now())
+		e.worlds[worldID] = w
+	}
+	e.mu.Unlock()
 
-	w := newWorld(e.maxCommandsPerTick)
-	e.worlds[worldID] = w
+	e.log.Debug("world initialized", "world", worldID, "tick", tick, "created", created, "force", force)
+	return tick, nil
+}
+
+// ResetWorld restarts an existing world at tick 0 and returns the new tick.
+//
+// Entities, queued commands, seen command ids, and retained events are discarded
+// and the RNG is re-seeded, while the world keeps the settings it was
+// initialized with. Unknown worlds fail with ErrWorldNotFound.
+func (e *Engine) ResetWorld(worldID string) (int64, error) {
+	worldID = normalizeID(worldID)
+	if worldID == "" {
+		return 0, errors.New("worldID is empty")
+	}
+
+	e.mu.Lock()
+	w, ok := e.worlds[worldID]
+	if !ok {
+		e.mu.Unlock()
+		return 0, fmt.Errorf("%w: %q", ErrWorldNotFound, worldID)
+	}
+	w.mu.Lock()
+	params := w.paramsLocked()
+	w.mu.Unlock()
+	fresh := newWorld(worldID, params)
+	fresh.touch(e.now())
+	e.worlds[worldID] = fresh
+	e.mu.Unlock()
+
+	e.log.Debug("world reset", "world", worldID)
 	return 0, nil
 }
 
-func (e *Engine) EnqueueCommand(worldID string, cmd *enginev1.Command, dryRun bool) (int64, error) {
+// EnqueueCommand validates cmd and queues it for the next tick, returning the
+// tick it will apply at. If expectedTick is non-zero and the world has already
+// advanced past it, the command is rejected with ErrTickConflict.
+func (e *Engine) EnqueueCommand(worldID string, cmd *enginev1.Command, dryRun bool, expectedTick int64) (int64, error) {
 	worldID = normalizeID(worldID)
 	if worldID == "" {
 		return 0, errors.New("worldID is empty")
@@ -59,32 +326,137 @@ func (e *Engine) EnqueueCommand(worldID string, cmd *enginev1.Command, dryRun bo
 		return 0, errors.New("command.command_id is empty")
 	}
 
-	w := e.getOrCreateWorld(worldID)
-	return w.enqueue(cmd, dryRun)
+	w, err := e.lookupWorld(worldID)
+	if err != nil {
+		return 0, err
+	}
+	tick, err := w.enqueue(cmd, dryRun, expectedTick)
+	if err != nil {
+		e.log.Debug("command rejected", "world", worldID, "tick", tick, "commandId", cmd.GetCommandId(), "kind", commandKind(cmd), "error", err.Error())
+	}
+	return tick, err
 }
 
 func (e *Engine) Tick(worldID string, expectedCurrentTick, targetTick int64) (int64, []*enginev1.Event, error) {
+	return e.TickContext(context.Background(), worldID, expectedCurrentTick, targetTick)
+}
+
+// TickContext is Tick, but checks ctx before each step and stops once it is
+// done. Steps already taken stay applied: the returned tick and events cover
+// them, alongside ctx.Err().
+func (e *Engine) TickContext(ctx context.Context, worldID string, expectedCurrentTick, targetTick int64) (int64, []*enginev1.Event, error) {
 	worldID = normalizeID(worldID)
 	if worldID == "" {
 		return 0, nil, errors.New("worldID is empty")
 	}
 
-	w := e.getOrCreateWorld(worldID)
-	return w.step(expectedCurrentTick, targetTick)
+	w, err := e.lookupWorld(worldID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return e.stepWorld(ctx, worldID, w, expectedCurrentTick, targetTick)
+}
+
+// stepWorld runs one Tick call against w, which is already resolved so
+// TickAll can step worlds without counting as client use.
+func (e *Engine) stepWorld(ctx context.Context, worldID string, w *world, expectedCurrentTick, targetTick int64) (int64, []*enginev1.Event, error) {
+	var record func(TickRecord)
+	if e.tickRecorder != nil {
+		record = func(rec TickRecord) { e.tickRecorder(worldID, rec) }
+	}
+	start := time.Now()
+	newTick, events, err := w.step(ctx, expectedCurrentTick, targetTick, e.maxStepsPerTick, record)
+	if err != nil {
+		return newTick, events, err
+	}
+	if targetTick > newTick {
+		e.log.Debug("tick clamped", "world", worldID, "tick", newTick, "targetTick", targetTick, "maxSteps", e.maxStepsPerTick)
+	}
+	if e.tickObserver != nil {
+		e.tickObserver(worldID, time.Since(start))
+	}
+
+	for _, ev := range events {
+		if rej := ev.GetCommandRejected(); rej != nil {
+			e.log.Debug("command rejected", "world", worldID, "tick", ev.GetTick(), "commandId", rej.GetCommandId(), "reason", rej.GetReason().String(), "error", rej.GetMessage())
+		}
+	}
+	e.log.Debug("tick completed", "world", worldID, "tick", newTick, "queueDepth", w.stats.queued.Load(), "events", len(events))
+	return newTick, events, nil
 }
 
-// TickAll advances all known worlds by one step (used for demo auto-ticking).
+// GetEvents returns the events emitted by worldID in the inclusive tick range
+// [fromTick, toTick]. A toTick of 0 (or past the current tick) means the current
+// tick. Ranges starting before the retention window fail with ErrEventsNotRetained.
+func (e *Engine) GetEvents(worldID string, fromTick, toTick int64) (*enginev1.GetEventsOk, error) {
+	worldID = normalizeID(worldID)
+	if worldID == "" {
+		return nil, errors.New("worldID is empty")
+	}
+
+	w, err := e.lookupWorld(worldID)
+	if err != nil {
+		return nil, err
+	}
+	return w.eventsInRange(fromTick, toTick)
+}
+
+// WorldStats returns the counters of every known world, sorted by world id.
+func (e *Engine) WorldStats() []WorldStats {
+	e.mu.Lock()
+	out := make([]WorldStats, 0, len(e.worlds))
+	for id, w := range e.worlds {
+		out = append(out, WorldStats{
+			WorldID:        id,
+			Ticks:          w.stats.ticks.Load(),
+			Entities:       w.stats.entities.Load(),
+			QueuedCommands: w.stats.queued.Load(),
+		})
+	}
+	e.mu.Unlock()
+
+	sort.Slice(out, func(i, j int) bool { return out[i].WorldID < out[j].WorldID })
+	return out
+}
+
+// ListWorlds summarizes every world held by the engine, sorted by world id.
+func (e *Engine) ListWorlds() []*enginev1.WorldSummary {
+	e.mu.Lock()
+	worlds := make(map[string]*world, len(e.worlds))
+	for id, w := range e.worlds {
+		worlds[id] = w
+	}
+	e.mu.Unlock()
+
+	out := make([]*enginev1.WorldSummary, 0, len(worlds))
+	for id, w := range worlds {
+		w.mu.Lock()
+		out = append(out, &enginev1.WorldSummary{
+			WorldId:     id,
+			Tick:        w.tick,
+			EntityCount: int64(len(w.entities)),
+		})
+		w.mu.Unlock()
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].WorldId < out[j].WorldId })
+	return out
+}
+
+// TickAll advances all known worlds by one step (used by StartAutoTick).
+//
+// Auto-ticks do not refresh a world's idle timer.
 func (e *Engine) TickAll() map[string]int64 {
 	e.mu.Lock()
-	ids := make([]string, 0, len(e.worlds))
-	for id := range e.worlds {
-		ids = append(ids, id)
+	worlds := make(map[string]*world, len(e.worlds))
+	for id, w := range e.worlds {
+		worlds[id] = w
 	}
 	e.mu.Unlock()
 
-	out := make(map[string]int64, len(ids))
-	for _, id := range ids {
-		newTick, _, err := e.Tick(id, 0, 0)
+	out := make(map[string]int64, len(worlds))
+	for id, w := range worlds {
+		newTick, _, err := e.stepWorld(context.Background(), id, w, 0, 0)
 		if err == nil {
 			out[id] = newTick
 		}
@@ -93,24 +465,78 @@ func (e *Engine) TickAll() map[string]int64 {
 }
 
 func (e *Engine) Snapshot(worldID string, atTick *int64, entityIDs []string, includeComponents bool) (*enginev1.WorldState, error) {
+	return e.SnapshotByTags(worldID, atTick, entityIDs, nil, includeComponents)
+}
+
+// SnapshotByTags is Snapshot restricted to entities that carry every tag in tags.
+func (e *Engine) SnapshotByTags(worldID string, atTick *int64, entityIDs, tags []string, includeComponents bool) (*enginev1.WorldState, error) {
 	worldID = normalizeID(worldID)
 	if worldID == "" {
 		return nil, errors.New("worldID is empty")
 	}
 
-	w := e.getOrCreateWorld(worldID)
-	return w.snapshot(worldID, atTick, entityIDs, includeComponents)
+	w, err := e.lookupWorld(worldID)
+	if err != nil {
+		return nil, err
+	}
+	return w.snapshot(worldID, atTick, entityIDs, tags, includeComponents)
 }
 
-func (e *Engine) getOrCreateWorld(worldID string) *world {
+// SnapshotMany returns the latest snapshot of each world, in request order.
+//
+// Unlike Snapshot it never creates worlds: unknown ids yield ErrWorldNotFound in
+// their own result. Each world is locked only while its own snapshot is taken.
+func (e *Engine) SnapshotMany(worldIDs []string, includeComponents bool) []SnapshotResult {
+	e.mu.Lock()
+	now := e.now()
+	worlds := make([]*world, len(worldIDs))
+	for i, id := range worldIDs {
+		if w := e.worlds[normalizeID(id)]; w != nil {
+			w.touch(now)
+			worlds[i] = w
+		}
+	}
+	e.mu.Unlock()
+
+	out := make([]SnapshotResult, len(worldIDs))
+	for i, id := range worldIDs {
+		id = normalizeID(id)
+		out[i].WorldID = id
+		switch {
+		case id == "":
+			out[i].Err = errors.New("worldID is empty")
+		case worlds[i] == nil:
+			out[i].Err = fmt.Errorf("%w: %q", ErrWorldNotFound, id)
+		default:
+			out[i].State, out[i].Err = worlds[i].snapshot(id, nil, nil, nil, includeComponents)
+		}
+	}
+	return out
+}
+
+// lookupWorld returns worldID, creating it with the engine defaults unless the
+// engine requires explicit initialization. Either way the world counts as used.
+func (e *Engine) lookupWorld(worldID string) (*world, error) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	if w, ok := e.worlds[worldID]; ok {
-		return w
+		w.touch(e.now())
+		return w, nil
+	}
+	if e.requireInit {
+		return nil, fmt.Errorf("%w: %q (call InitializeWorld first)", ErrWorldNotInitialized, worldID)
 	}
-	w := newWorld(e.maxCommandsPerTick)
+	w := newWorld(worldID, e.defaultParams())
+	w.touch(e.now())
 	e.worlds[worldID] = w
-	return w
+	return w, nil
+}
+
+// worldSeed derives a stable RNG seed from the world id (FNV-1a).
+func worldSeed(worldID string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(worldID))
+	return h.Sum64()
 }
 
 type world struct {
@@ -118,48 +544,118 @@ type world struct {
 	tick               int64
 	entities           map[string]*enginev1.Entity
 	queue              []*enginev1.Command
-	seenCommandIDs     map[string]struct{}
+	moveTargets        map[string]moveTarget
+	seenCommandIDs     *commandIDWindow
 	nextGeneratedID    int64
 	maxCommandsPerTick int
+	maxEntities        int
+	maxQueued          int
+	integrateVelocity  bool
+	spawnJitter        float64
+
+	// eventLog holds the events of the last eventRetention ticks, oldest first.
+	eventLog       []tickEvents
+	eventRetention int64
+
+	// rng is the only source of randomness for world mechanics. It is seeded
+	// from the world id and only consumed while applying commands, so the
+	// outcome depends solely on the seed and the command sequence.
+	seed uint64
+	rng  *rand.Rand
+
+	stats worldStats
+
+	// lastUsed is the UnixNano time of the last client access, read by
+	// EvictIdleWorlds without taking mu.
+	lastUsed atomic.Int64
+}
+
+// worldStats mirrors world state into atomics so readers never block on w.mu.
+type worldStats struct {
+	ticks    atomic.Int64
+	entities atomic.Int64
+	queued   atomic.Int64
+}
+
+// paramsLocked returns the settings w was created with.
+func (w *world) paramsLocked() worldParams {
+	return worldParams{
+		maxCommandsPerTick: w.maxCommandsPerTick,
+		spawnJitter:        w.spawnJitter,
+		eventRetention:     w.eventRetention,
+		dedupeWindow:       cap(w.seenCommandIDs.order),
+		maxEntities:        w.maxEntities,
+		maxQueued:          w.maxQueued,
+		integrateVelocity:  w.integrateVelocity,
+	}
 }
 
-func newWorld(maxCommandsPerTick int) *world {
+func (w *world) publishStatsLocked() {
+	w.stats.entities.Store(int64(len(w.entities)))
+	w.stats.queued.Store(int64(len(w.queue)))
+}
+
+func newWorld(worldID string, p worldParams) *world {
+	maxCommandsPerTick := p.maxCommandsPerTick
 	if maxCommandsPerTick <= 0 {
 		maxCommandsPerTick = 16
 	}
+	dedupeWindow := p.dedupeWindow
+	if dedupeWindow <= 0 {
+		dedupeWindow = 4096
+	}
+	seed := worldSeed(worldID)
 	return &world{
 		entities:           make(map[string]*enginev1.Entity),
 		queue:              nil,
-		seenCommandIDs:     make(map[string]struct{}),
+		moveTargets:        make(map[string]moveTarget),
+		seenCommandIDs:     newCommandIDWindow(dedupeWindow),
 		nextGeneratedID:    1,
 		maxCommandsPerTick: maxCommandsPerTick,
+		maxEntities:        p.maxEntities,
+		maxQueued:          p.maxQueued,
+		integrateVelocity:  p.integrateVelocity,
+		spawnJitter:        p.spawnJitter,
+		eventRetention:     p.eventRetention,
+		seed:               seed,
+		rng:                rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15)),
 	}
 }
 
-func (w *world) enqueue(cmd *enginev1.Command, dryRun bool) (int64, error) {
+func (w *world) enqueue(cmd *enginev1.Command, dryRun bool, expectedTick int64) (int64, error) {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
 	id := normalizeID(cmd.GetCommandId())
-	if _, ok := w.seenCommandIDs[id]; ok {
+	if w.seenCommandIDs.contains(id) {
 		// Idempotent accept.
 		return w.tick, nil
 	}
 
-	if err := validateCommandLocked(w, cmd); err != nil {
+	if expectedTick != 0 && w.tick > expectedTick {
+		return w.tick, fmt.Errorf("%w: expected_current_tick=%d but world is at tick=%d", ErrTickConflict, expectedTick, w.tick)
+	}
+
+	if err := validateCommandPayload(cmd); err != nil {
 		return w.tick, err
 	}
 	if dryRun {
 		return w.tick, nil
 	}
+	if w.maxQueued > 0 && len(w.queue) >= w.maxQueued {
+		return w.tick, fmt.Errorf("%w: world already holds %d queued commands", ErrQueueFull, w.maxQueued)
+	}
 
-	w.seenCommandIDs[id] = struct{}{}
+	w.seenCommandIDs.add(id)
 	w.queue = append(w.queue, cmd)
+	w.publishStatsLocked()
 	// Commands are applied on the next tick step.
 	return w.tick + 1, nil
 }
 
-func (w *world) step(expectedCurrentTick, targetTick int64) (int64, []*enginev1.Event, error) {
+// step advances the world by one tick, or toward targetTick by at most maxSteps ticks.
+// record, if non-nil, is called with each tick's TickRecord.
+func (w *world) step(ctx context.Context, expectedCurrentTick, targetTick, maxSteps int64, record func(TickRecord)) (int64, []*enginev1.Event, error) {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
@@ -170,50 +666,75 @@ func (w *world) step(expectedCurrentTick, targetTick int64) (int64, []*enginev1.
 	steps := int64(1)
 	if targetTick > 0 && targetTick > w.tick {
 		steps = targetTick - w.tick
-		if steps > 1000 {
-			steps = 1000
+		if steps > maxSteps {
+			steps = maxSteps
 		}
 	}
 
 	var events []*enginev1.Event
-	for i := int64(0); i < steps; i++ {
-		w.tick++
-		events = append(events, w.applyQueuedCommandsLocked(w.tick)...)
+	var err error
+	taken := int64(0)
+	for ; taken < steps; taken++ {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		rec := w.advanceLocked(len(w.queue))
+		if record != nil {
+			record(rec)
+		}
+		events = append(events, rec.Events...)
 	}
-	return w.tick, events, nil
+	w.stats.ticks.Add(taken)
+	w.publishStatsLocked()
+	return w.tick, events, err
 }
 
-func (w *world) applyQueuedCommandsLocked(tick int64) []*enginev1.Event {
+// advanceLocked runs one tick: it applies up to limit queued commands (further
+// capped by maxCommandsPerTick), then velocity integration and moves.
+func (w *world) advanceLocked(limit int) TickRecord {
+	w.tick++
+	batch, applied := w.applyQueuedCommandsLocked(w.tick, limit)
+	if w.integrateVelocity {
+		w.integrateVelocityLocked()
+	}
+	applied = append(applied, w.advanceMovesLocked(w.tick)...)
+	w.recordEventsLocked(w.tick, applied)
+	return TickRecord{Tick: w.tick, Commands: batch, Events: applied}
+}
+
+func (w *world) applyQueuedCommandsLocked(tick int64, limit int) ([]*enginev1.Command, []*enginev1.Event) {
 	max := w.maxCommandsPerTick
-	if max <= 0 {
-		max = len(w.queue)
+	if max <= 0 || max > limit {
+		max = limit
 	}
 	if max > len(w.queue) {
 		max = len(w.queue)
 	}
 
+	batch := make([]*enginev1.Command, max)
+	copy(batch, w.queue[:max])
+	w.queue = w.queue[max:]
+	orderBatch(batch)
+
 	var events []*enginev1.Event
-	for i := 0; i < max; i++ {
-		cmd := w.queue[0]
-		w.queue = w.queue[1:]
-
-		if err := applyCommandLocked(w, cmd); err != nil {
-			events = append(events, &enginev1.Event{
-				Type: "physics.command.error",
-				Tick: tick,
-				Payload: &enginev1.Event_Opaque{
-					Opaque: []byte(err.Error()),
-				},
-			})
+	for _, cmd := range batch {
+		generatedID, err := applyCommandLocked(w, cmd)
+		if err != nil {
+			events = append(events, rejectionEvent(cmd, tick, err))
 			continue
 		}
 
-		b, _ := json.Marshal(map[string]any{
+		payload := map[string]any{
 			"commandId": cmd.GetCommandId(),
 			"actorId":   cmd.GetActorId(),
 			"kind":      commandKind(cmd),
 			"tick":      tick,
-		})
+		}
+		if generatedID != "" {
+			// Spawns apply on the next tick, so this event is where callers learn the id.
+			payload["generatedEntityIds"] = []string{generatedID}
+		}
+		b, _ := json.Marshal(payload)
 		events = append(events, &enginev1.Event{
 			Type: "physics.command.applied",
 			Tick: tick,
@@ -222,10 +743,90 @@ func (w *world) applyQueuedCommandsLocked(tick int64) []*enginev1.Event {
 			},
 		})
 	}
-	return events
+	return batch, events
 }
 
-func (w *world) snapshot(worldID string, atTick *int64, entityIDs []string, includeComponents bool) (*enginev1.WorldState, error) {
+// orderBatch orders one tick's commands by (priority desc, issued_at, command_id) when
+// any of them sets a priority. A batch of default-priority commands keeps FIFO order.
+func orderBatch(batch []*enginev1.Command) {
+	prioritized := false
+	for _, cmd := range batch {
+		if cmd.GetPriority() != 0 {
+			prioritized = true
+			break
+		}
+	}
+	if !prioritized {
+		return
+	}
+	sort.SliceStable(batch, func(i, j int) bool {
+		a, b := batch[i], batch[j]
+		if a.GetPriority() != b.GetPriority() {
+			return a.GetPriority() > b.GetPriority()
+		}
+		if a.GetIssuedAtUnixMillis() != b.GetIssuedAtUnixMillis() {
+			return a.GetIssuedAtUnixMillis() < b.GetIssuedAtUnixMillis()
+		}
+		return normalizeID(a.GetCommandId()) < normalizeID(b.GetCommandId())
+	})
+}
+
+type tickEvents struct {
+	tick   int64
+	events []*enginev1.Event
+}
+
+func (w *world) recordEventsLocked(tick int64, events []*enginev1.Event) {
+	if len(events) > 0 {
+		w.eventLog = append(w.eventLog, tickEvents{tick: tick, events: events})
+	}
+	oldest := w.oldestRetainedTickLocked()
+	drop := 0
+	for drop < len(w.eventLog) && w.eventLog[drop].tick < oldest {
+		drop++
+	}
+	if drop > 0 {
+		w.eventLog = append(w.eventLog[:0], w.eventLog[drop:]...)
+	}
+}
+
+func (w *world) oldestRetainedTickLocked() int64 {
+	oldest := w.tick - w.eventRetention + 1
+	if oldest < 1 {
+		oldest = 1
+	}
+	return oldest
+}
+
+func (w *world) eventsInRange(fromTick, toTick int64) (*enginev1.GetEventsOk, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	oldest := w.oldestRetainedTickLocked()
+	if fromTick < 1 {
+		return nil, fmt.Errorf("from_tick=%d must be >= 1", fromTick)
+	}
+	if fromTick < oldest {
+		return nil, fmt.Errorf("%w: from_tick=%d precedes oldest retained tick=%d", ErrEventsNotRetained, fromTick, oldest)
+	}
+	if toTick <= 0 || toTick > w.tick {
+		toTick = w.tick
+	}
+
+	out := &enginev1.GetEventsOk{OldestRetainedTick: oldest, CurrentTick: w.tick}
+	for _, te := range w.eventLog {
+		if te.tick < fromTick {
+			continue
+		}
+		if te.tick > toTick {
+			break
+		}
+		out.Events = append(out.Events, te.events...)
+	}
+	return out, nil
+}
+
+func (w *world) snapshot(worldID string, atTick *int64, entityIDs, tags []string, includeComponents bool) (*enginev1.WorldState, error) {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
@@ -248,8 +849,13 @@ func (w *world) snapshot(worldID string, atTick *int64, entityIDs []string, incl
 				continue
 			}
 		}
+		if !hasAllTags(e, tags) {
+			continue
+		}
 		entities = append(entities, cloneEntity(e, includeComponents))
 	}
+	// Natural order keeps generated ids in spawn order (e-2 before e-10).
+	sort.Slice(entities, func(i, j int) bool { return naturalLess(entities[i].EntityId, entities[j].EntityId) })
 
 	return &enginev1.WorldState{
 		WorldId:  worldID,
@@ -257,14 +863,31 @@ func (w *world) snapshot(worldID string, atTick *int64, entityIDs []string, incl
 		Entities: entities,
 		Metadata: map[string]string{
 			"generatedAtUnixMillis": fmt.Sprintf("%d", time.Now().UnixMilli()),
+			"rngSeed":               strconv.FormatUint(w.seed, 10),
 		},
 	}, nil
 }
 
-func validateCommandLocked(w *world, cmd *enginev1.Command) error {
-	switch cmd.GetPayload().(type) {
+// ValidateCommand runs the stateless checks EnqueueCommand applies to cmd (payload
+// present, required ids non-empty, spawn components in range) without reading or
+// creating any world. Passing does not mean the command will apply: entity existence
+// is only checked when the command runs on a tick.
+func ValidateCommand(cmd *enginev1.Command) error {
+	if cmd == nil {
+		return errors.New("command is nil")
+	}
+	if normalizeID(cmd.GetCommandId()) == "" {
+		return errors.New("command.command_id is empty")
+	}
+	return validateCommandPayload(cmd)
+}
+
+func validateCommandPayload(cmd *enginev1.Command) error {
+	switch p := cmd.GetPayload().(type) {
 	case *enginev1.Command_SpawnEntity:
-		// ok
+		if _, err := spawnComponents(p.SpawnEntity); err != nil {
+			return err
+		}
 	case *enginev1.Command_Move:
 		if normalizeID(cmd.GetMove().GetEntityId()) == "" {
 			return errors.New("move.entity_id is empty")
@@ -273,75 +896,278 @@ func validateCommandLocked(w *world, cmd *enginev1.Command) error {
 		if normalizeID(cmd.GetDespawnEntity().GetEntityId()) == "" {
 			return errors.New("despawn_entity.entity_id is empty")
 		}
+	case *enginev1.Command_SetVelocity:
+		if normalizeID(p.SetVelocity.GetEntityId()) == "" {
+			return errors.New("set_velocity.entity_id is empty")
+		}
+		if p.SetVelocity.GetVelocity() == nil {
+			return errors.New("set_velocity.velocity is nil")
+		}
+	case *enginev1.Command_MoveToward:
+		mt := p.MoveToward
+		if normalizeID(mt.GetEntityId()) == "" {
+			return errors.New("move_toward.entity_id is empty")
+		}
+		if mt.GetTarget() == nil {
+			return errors.New("move_toward.target is nil")
+		}
+		if s := mt.GetMaxSpeed(); !(s > 0) || math.IsInf(s, 0) {
+			return fmt.Errorf("move_toward.max_speed=%v must be positive", s)
+		}
+	case *enginev1.Command_TagEntity:
+		return validateTagCommand("tag_entity", p.TagEntity.GetEntityId(), p.TagEntity.GetTags())
+	case *enginev1.Command_UntagEntity:
+		return validateTagCommand("untag_entity", p.UntagEntity.GetEntityId(), p.UntagEntity.GetTags())
 	case *enginev1.Command_Opaque:
-		// ok
+		if p.Opaque.GetType() == CommandTypeDamage {
+			if _, err := parseDamage(p.Opaque); err != nil {
+				return err
+			}
+		}
 	default:
 		return errors.New("command payload is missing or unknown")
 	}
-	_ = w
 	return nil
 }
 
-func applyCommandLocked(w *world, cmd *enginev1.Command) error {
+// applyCommandLocked applies cmd to w. For spawns without an entity id it returns
+// the id the engine generated.
+func applyCommandLocked(w *world, cmd *enginev1.Command) (string, error) {
 	switch p := cmd.GetPayload().(type) {
 	case *enginev1.Command_SpawnEntity:
 		id := normalizeID(p.SpawnEntity.GetEntityId())
+		var generated string
 		if id == "" {
 			id = w.generateEntityIDLocked()
+			generated = id
 		}
 		if _, ok := w.entities[id]; ok {
-			return fmt.Errorf("entity %q already exists", id)
-		}
-		w.entities[id] = &enginev1.Entity{
-			EntityId: id,
-			Type:     "demo",
-			Components: []*enginev1.Component{
-				{
-					Type: "transform",
-					Payload: &enginev1.Component_Transform{
-						Transform: &enginev1.TransformComponent{
-							Position:      &enginev1.Vec3{X: 0, Y: 0, Z: 0},
-							RotationEuler: &enginev1.Vec3{X: 0, Y: 0, Z: 0},
-							Scale:         &enginev1.Vec3{X: 1, Y: 1, Z: 1},
-						},
-					},
-				},
-			},
+			return "", rejectf(enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_ENTITY_ALREADY_EXISTS, enginev1.StatusCode_STATUS_CODE_CONFLICT, "entity %q already exists", id)
+		}
+		if w.maxEntities > 0 && len(w.entities) >= w.maxEntities {
+			return "", rejectf(enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_ENTITY_LIMIT_EXCEEDED, enginev1.StatusCode_STATUS_CODE_FAILED_PRECONDITION, "world already holds the maximum of %d entities", w.maxEntities)
+		}
+		components, err := spawnComponents(p.SpawnEntity)
+		if err != nil {
+			return "", rejectf(enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_INVALID_PAYLOAD, enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "%v", err)
+		}
+		if w.spawnJitter > 0 && !hasTransform(p.SpawnEntity.GetComponents()) {
+			w.jitterSpawnLocked(components)
+		}
+		entity := &enginev1.Entity{
+			EntityId:   id,
+			Type:       "demo",
+			Components: components,
 			Metadata: map[string]string{
 				"spawnedBy": normalizeID(cmd.GetActorId()),
 			},
 		}
-		return nil
+		for _, c := range p.SpawnEntity.GetComponents() {
+			if tc := c.GetTags(); tc != nil {
+				tagEntity(entity, tc.GetTags())
+			}
+		}
+		w.entities[id] = entity
+		return generated, nil
 	case *enginev1.Command_Move:
 		entityID := normalizeID(p.Move.GetEntityId())
 		e, ok := w.entities[entityID]
 		if !ok {
-			return fmt.Errorf("entity %q not found", entityID)
+			return "", rejectf(enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_ENTITY_NOT_FOUND, enginev1.StatusCode_STATUS_CODE_NOT_FOUND, "entity %q not found", entityID)
 		}
 		pos := p.Move.GetPosition()
 		if pos == nil {
-			return errors.New("move.position is nil")
+			return "", rejectf(enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_INVALID_PAYLOAD, enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "move.position is nil")
 		}
 		setEntityPosition(e, pos)
-		return nil
+		if v := p.Move.GetVelocity(); v != nil {
+			setEntityVelocity(e, v)
+		}
+		delete(w.moveTargets, entityID)
+		return "", nil
+	case *enginev1.Command_SetVelocity:
+		entityID := normalizeID(p.SetVelocity.GetEntityId())
+		e, ok := w.entities[entityID]
+		if !ok {
+			return "", rejectf(enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_ENTITY_NOT_FOUND, enginev1.StatusCode_STATUS_CODE_NOT_FOUND, "entity %q not found", entityID)
+		}
+		if !hasTransform(e.GetComponents()) {
+			setEntityPosition(e, &enginev1.Vec3{})
+		}
+		setEntityVelocity(e, p.SetVelocity.GetVelocity())
+		return "", nil
+	case *enginev1.Command_MoveToward:
+		entityID := normalizeID(p.MoveToward.GetEntityId())
+		if _, ok := w.entities[entityID]; !ok {
+			return "", rejectf(enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_ENTITY_NOT_FOUND, enginev1.StatusCode_STATUS_CODE_NOT_FOUND, "entity %q not found", entityID)
+		}
+		w.moveTargets[entityID] = moveTarget{target: cloneVec3(p.MoveToward.GetTarget()), maxSpeed: p.MoveToward.GetMaxSpeed()}
+		return "", nil
 	case *enginev1.Command_DespawnEntity:
 		entityID := normalizeID(p.DespawnEntity.GetEntityId())
 		if _, ok := w.entities[entityID]; !ok {
-			return fmt.Errorf("entity %q not found", entityID)
+			return "", rejectf(enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_ENTITY_NOT_FOUND, enginev1.StatusCode_STATUS_CODE_NOT_FOUND, "entity %q not found", entityID)
 		}
 		delete(w.entities, entityID)
-		return nil
+		delete(w.moveTargets, entityID)
+		return "", nil
+	case *enginev1.Command_TagEntity:
+		entityID := normalizeID(p.TagEntity.GetEntityId())
+		e, ok := w.entities[entityID]
+		if !ok {
+			return "", rejectf(enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_ENTITY_NOT_FOUND, enginev1.StatusCode_STATUS_CODE_NOT_FOUND, "entity %q not found", entityID)
+		}
+		tagEntity(e, p.TagEntity.GetTags())
+		return "", nil
+	case *enginev1.Command_UntagEntity:
+		entityID := normalizeID(p.UntagEntity.GetEntityId())
+		e, ok := w.entities[entityID]
+		if !ok {
+			return "", rejectf(enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_ENTITY_NOT_FOUND, enginev1.StatusCode_STATUS_CODE_NOT_FOUND, "entity %q not found", entityID)
+		}
+		untagEntity(e, p.UntagEntity.GetTags())
+		return "", nil
 	case *enginev1.Command_Opaque:
-		return nil
+		if p.Opaque.GetType() == CommandTypeDamage {
+			return "", applyDamageLocked(w, p.Opaque)
+		}
+		return "", nil
 	default:
-		return errors.New("command payload is missing or unknown")
+		return "", rejectf(enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_UNKNOWN_COMMAND, enginev1.StatusCode_STATUS_CODE_INVALID_ARGUMENT, "command payload is missing or unknown")
 	}
 }
 
+// spawnComponents builds the initial component set for a spawn.
+//
+// Requested components are copied and normalized (missing types are derived from the
+// payload, missing transform vectors get identity defaults, and a default transform is
+// added if none was given); the spawn velocity is then applied to the transform. The
+// result is rejected if a health component is out of range.
+func spawnComponents(spawn *enginev1.SpawnEntityCommand) ([]*enginev1.Component, error) {
+	components := make([]*enginev1.Component, 0, len(spawn.GetComponents())+1)
+	var transform *enginev1.TransformComponent
+	for _, c := range spawn.GetComponents() {
+		if c == nil {
+			continue
+		}
+		// Tags are stored as entity metadata, not as a component. cloneComponent
+		// does not copy them, so they are checked on the original.
+		if p, ok := c.GetPayload().(*enginev1.Component_Tags); ok {
+			if err := validateTags("spawn_entity: tags", p.Tags.GetTags()); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		c = cloneComponent(c)
+		switch p := c.GetPayload().(type) {
+		case *enginev1.Component_Transform:
+			if c.Type == "" {
+				c.Type = "transform"
+			}
+			if p.Transform == nil {
+				p.Transform = &enginev1.TransformComponent{}
+			}
+			normalizeTransform(p.Transform)
+			if transform == nil {
+				transform = p.Transform
+			}
+		case *enginev1.Component_Health:
+			if c.Type == "" {
+				c.Type = "health"
+			}
+		}
+		components = append(components, c)
+	}
+	if transform == nil {
+		transform = &enginev1.TransformComponent{}
+		normalizeTransform(transform)
+		components = append([]*enginev1.Component{{
+			Type:    "transform",
+			Payload: &enginev1.Component_Transform{Transform: transform},
+		}}, components...)
+	}
+	if v := spawn.GetVelocity(); v != nil {
+		transform.Velocity = cloneVec3(v)
+	}
+
+	for _, c := range components {
+		if err := validateComponent(c); err != nil {
+			return nil, fmt.Errorf("spawn_entity: %w", err)
+		}
+	}
+	return components, nil
+}
+
+func validateTagCommand(field, entityID string, tags []string) error {
+	if normalizeID(entityID) == "" {
+		return fmt.Errorf("%s.entity_id is empty", field)
+	}
+	if len(tags) == 0 {
+		return fmt.Errorf("%s.tags is empty", field)
+	}
+	return validateTags(field+".tags", tags)
+}
+
+func hasTransform(components []*enginev1.Component) bool {
+	for _, c := range components {
+		if c.GetTransform() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// jitterSpawnLocked offsets the spawn transform by a uniformly distributed point
+// within spawnJitter of the origin on the X/Z plane.
+func (w *world) jitterSpawnLocked(components []*enginev1.Component) {
+	for _, c := range components {
+		t := c.GetTransform()
+		if t == nil {
+			continue
+		}
+		angle := w.rng.Float64() * 2 * math.Pi
+		radius := w.spawnJitter * math.Sqrt(w.rng.Float64())
+		t.Position.X += radius * math.Cos(angle)
+		t.Position.Z += radius * math.Sin(angle)
+		return
+	}
+}
+
+func normalizeTransform(t *enginev1.TransformComponent) {
+	if t.Position == nil {
+		t.Position = &enginev1.Vec3{X: 0, Y: 0, Z: 0}
+	}
+	if t.RotationEuler == nil {
+		t.RotationEuler = &enginev1.Vec3{X: 0, Y: 0, Z: 0}
+	}
+	if t.Scale == nil {
+		t.Scale = &enginev1.Vec3{X: 1, Y: 1, Z: 1}
+	}
+}
+
+func validateComponent(c *enginev1.Component) error {
+	switch p := c.GetPayload().(type) {
+	case *enginev1.Component_Health:
+		if max := p.Health.GetMax(); max < 0 {
+			return fmt.Errorf("health.max=%d must not be negative", max)
+		}
+		if cur, max := p.Health.GetCurrent(), p.Health.GetMax(); cur > max {
+			return fmt.Errorf("health.current=%d exceeds health.max=%d", cur, max)
+		}
+	}
+	return nil
+}
+
+// generateEntityIDLocked returns the next "e-N" id, skipping any an explicit spawn
+// already holds so a generated spawn is never rejected as a duplicate.
 func (w *world) generateEntityIDLocked() string {
-	id := w.nextGeneratedID
-	w.nextGeneratedID++
-	return fmt.Sprintf("e-%d", id)
+	for {
+		id := fmt.Sprintf("e-%d", w.nextGeneratedID)
+		w.nextGeneratedID++
+		if _, taken := w.entities[id]; !taken {
+			return id
+		}
+	}
 }
 
 func setEntityPosition(e *enginev1.Entity, pos *enginev1.Vec3) {
@@ -376,6 +1202,19 @@ func setEntityPosition(e *enginev1.Entity, pos *enginev1.Vec3) {
 	})
 }
 
+// setEntityVelocity updates the velocity of the entity's transform, if it has one.
+func setEntityVelocity(e *enginev1.Entity, v *enginev1.Vec3) {
+	if e == nil {
+		return
+	}
+	for _, c := range e.Components {
+		if t := c.GetTransform(); t != nil {
+			t.Velocity = cloneVec3(v)
+			return
+		}
+	}
+}
+
 func cloneEntity(e *enginev1.Entity, includeComponents bool) *enginev1.Entity {
 	if e == nil {
 		return nil
@@ -430,6 +1269,7 @@ func cloneTransform(t *enginev1.TransformComponent) *enginev1.TransformComponent
 		Position:      cloneVec3(t.Position),
 		RotationEuler: cloneVec3(t.RotationEuler),
 		Scale:         cloneVec3(t.Scale),
+		Velocity:      cloneVec3(t.Velocity),
 	}
 }
 
@@ -464,6 +1304,44 @@ func normalizeID(s string) string {
 	return s
 }
 
+// naturalLess orders strings with embedded numbers numerically, so "e-2" sorts
+// before "e-10". Digit runs compare by value; ids that compare equal that way
+// (e.g. "e-2" and "e-02") fall back to byte order, keeping the order total.
+func naturalLess(a, b string) bool {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if isDigit(a[i]) && isDigit(b[j]) {
+			si, sj := i, j
+			for i < len(a) && isDigit(a[i]) {
+				i++
+			}
+			for j < len(b) && isDigit(b[j]) {
+				j++
+			}
+			na := strings.TrimLeft(a[si:i], "0")
+			nb := strings.TrimLeft(b[sj:j], "0")
+			if len(na) != len(nb) {
+				return len(na) < len(nb)
+			}
+			if na != nb {
+				return na < nb
+			}
+			continue
+		}
+		if a[i] != b[j] {
+			return a[i] < b[j]
+		}
+		i++
+		j++
+	}
+	if len(a)-i != len(b)-j {
+		return len(a)-i < len(b)-j
+	}
+	return a < b
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
 func commandKind(cmd *enginev1.Command) string {
 	switch cmd.GetPayload().(type) {
 	case *enginev1.Command_SpawnEntity:
@@ -472,6 +1350,14 @@ func commandKind(cmd *enginev1.Command) string {
 		return "move"
 	case *enginev1.Command_DespawnEntity:
 		return "despawn"
+	case *enginev1.Command_MoveToward:
+		return "move_toward"
+	case *enginev1.Command_SetVelocity:
+		return "set_velocity"
+	case *enginev1.Command_TagEntity:
+		return "tag"
+	case *enginev1.Command_UntagEntity:
+		return "untag"
 	case *enginev1.Command_Opaque:
 		return "opaque"
 	default:
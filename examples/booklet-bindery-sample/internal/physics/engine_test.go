@@ -1,7 +1,18 @@
 package physics
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
 	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
 
 	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
 )
@@ -16,14 +27,14 @@ func TestEngine_QueuedCommandsAppliedOnTick(t *testing.T) {
 		CommandId: "c1",
 		ActorId:   "a1",
 		Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}},
-	}, false); err != nil {
+	}, false, 0); err != nil {
 		t.Fatalf("enqueue c1: %v", err)
 	}
 	if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
 		CommandId: "c2",
 		ActorId:   "a1",
 		Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e2"}},
-	}, false); err != nil {
+	}, false, 0); err != nil {
 		t.Fatalf("enqueue c2: %v", err)
 	}
 
@@ -60,7 +71,7 @@ func TestEngine_MoveUpdatesTransform(t *testing.T) {
 		CommandId: "spawn",
 		ActorId:   "a1",
 		Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}},
-	}, false); err != nil {
+	}, false, 0); err != nil {
 		t.Fatalf("enqueue spawn: %v", err)
 	}
 	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
@@ -74,7 +85,7 @@ func TestEngine_MoveUpdatesTransform(t *testing.T) {
 			EntityId: "e1",
 			Position: &enginev1.Vec3{X: 1, Y: 2, Z: 3},
 		}},
-	}, false); err != nil {
+	}, false, 0); err != nil {
 		t.Fatalf("enqueue move: %v", err)
 	}
 	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
@@ -99,3 +110,1715 @@ func TestEngine_MoveUpdatesTransform(t *testing.T) {
 		t.Fatalf("expected position (1,2,3), got %+v", gotPos)
 	}
 }
+
+func TestEngine_WorldStatsTrackTicks(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10})
+	worldID := "world-1"
+
+	if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+		CommandId: "spawn",
+		ActorId:   "a1",
+		Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}},
+	}, false, 0); err != nil {
+		t.Fatalf("enqueue spawn: %v", err)
+	}
+
+	stats := e.WorldStats()
+	if len(stats) != 1 || stats[0].Ticks != 0 || stats[0].QueuedCommands != 1 {
+		t.Fatalf("unexpected stats before tick: %+v", stats)
+	}
+
+	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("tick 1: %v", err)
+	}
+	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("tick 2: %v", err)
+	}
+
+	stats = e.WorldStats()
+	if len(stats) != 1 {
+		t.Fatalf("expected 1 world, got %d", len(stats))
+	}
+	if got := stats[0].Ticks; got != 2 {
+		t.Fatalf("expected tick counter 2, got %d", got)
+	}
+	if got := stats[0].Entities; got != 1 {
+		t.Fatalf("expected 1 entity, got %d", got)
+	}
+	if got := stats[0].QueuedCommands; got != 0 {
+		t.Fatalf("expected empty queue, got %d", got)
+	}
+}
+
+func TestEngine_SpawnWithVelocityAndComponents(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10})
+	worldID := "world-1"
+
+	if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+		CommandId: "spawn",
+		ActorId:   "a1",
+		Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{
+			EntityId: "e1",
+			Velocity: &enginev1.Vec3{X: 1, Y: 0, Z: -2},
+			Components: []*enginev1.Component{
+				{Payload: &enginev1.Component_Health{Health: &enginev1.HealthComponent{Current: 80, Max: 100}}},
+			},
+		}},
+	}, false, 0); err != nil {
+		t.Fatalf("enqueue spawn: %v", err)
+	}
+	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+
+	snap, err := e.Snapshot(worldID, nil, []string{"e1"}, true)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if len(snap.Entities) != 1 {
+		t.Fatalf("expected 1 entity, got %d", len(snap.Entities))
+	}
+
+	var transform *enginev1.TransformComponent
+	var health *enginev1.HealthComponent
+	for _, c := range snap.Entities[0].Components {
+		switch {
+		case c.GetTransform() != nil:
+			transform = c.GetTransform()
+		case c.GetHealth() != nil:
+			health = c.GetHealth()
+			if c.GetType() != "health" {
+				t.Fatalf("expected health component type to be normalized, got %q", c.GetType())
+			}
+		}
+	}
+	if transform == nil {
+		t.Fatalf("expected default transform to be added")
+	}
+	if v := transform.GetVelocity(); v.GetX() != 1 || v.GetY() != 0 || v.GetZ() != -2 {
+		t.Fatalf("expected velocity (1,0,-2), got %v", v)
+	}
+	if s := transform.GetScale(); s.GetX() != 1 || s.GetY() != 1 || s.GetZ() != 1 {
+		t.Fatalf("expected identity scale, got %v", s)
+	}
+	if health == nil || health.Current != 80 || health.Max != 100 {
+		t.Fatalf("expected health 80/100, got %v", health)
+	}
+}
+
+func TestEngine_SpawnRejectsInvalidHealth(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10})
+	worldID := "world-1"
+
+	cases := map[string]*enginev1.HealthComponent{
+		"negative-max":        {Current: 0, Max: -1},
+		"current-exceeds-max": {Current: 11, Max: 10},
+	}
+	for name, h := range cases {
+		_, err := e.EnqueueCommand(worldID, &enginev1.Command{
+			CommandId: "spawn-" + name,
+			ActorId:   "a1",
+			Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{
+				EntityId:   name,
+				Components: []*enginev1.Component{{Type: "health", Payload: &enginev1.Component_Health{Health: h}}},
+			}},
+		}, false, 0)
+		if err == nil {
+			t.Fatalf("%s: expected validation error", name)
+		}
+	}
+
+	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+	snap, err := e.Snapshot(worldID, nil, nil, false)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if len(snap.Entities) != 0 {
+		t.Fatalf("expected no entities from rejected spawns, got %d", len(snap.Entities))
+	}
+}
+
+func TestEngine_InitializeWorldPreservesStateWithoutForce(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10})
+	worldID := "world-1"
+
+	if tick, err := e.InitializeWorld(worldID, false, nil); err != nil || tick != 0 {
+		t.Fatalf("initial init: tick=%d err=%v", tick, err)
+	}
+	if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+		CommandId: "spawn",
+		Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}},
+	}, false, 0); err != nil {
+		t.Fatalf("enqueue spawn: %v", err)
+	}
+	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+
+	tick, err := e.InitializeWorld(worldID, false, nil)
+	if err != nil {
+		t.Fatalf("reinit: %v", err)
+	}
+	if tick != 1 {
+		t.Fatalf("expected reinit to report current tick 1, got %d", tick)
+	}
+	snap, err := e.Snapshot(worldID, nil, nil, false)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if snap.Tick != 1 || len(snap.Entities) != 1 {
+		t.Fatalf("expected state preserved (tick=1, 1 entity), got tick=%d entities=%d", snap.Tick, len(snap.Entities))
+	}
+}
+
+func TestEngine_InitializeWorldForceResets(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10})
+	worldID := "world-1"
+
+	if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+		CommandId: "spawn",
+		Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}},
+	}, false, 0); err != nil {
+		t.Fatalf("enqueue spawn: %v", err)
+	}
+	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+
+	tick, err := e.InitializeWorld(worldID, true, nil)
+	if err != nil {
+		t.Fatalf("forced reinit: %v", err)
+	}
+	if tick != 0 {
+		t.Fatalf("expected forced reinit to start at tick 0, got %d", tick)
+	}
+	snap, err := e.Snapshot(worldID, nil, nil, false)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if snap.Tick != 0 || len(snap.Entities) != 0 {
+		t.Fatalf("expected fresh world, got tick=%d entities=%d", snap.Tick, len(snap.Entities))
+	}
+
+	// The reset world must accept a previously seen command id again.
+	if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+		CommandId: "spawn",
+		Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}},
+	}, false, 0); err != nil {
+		t.Fatalf("enqueue spawn after reset: %v", err)
+	}
+	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("tick after reset: %v", err)
+	}
+	if snap, _ = e.Snapshot(worldID, nil, nil, false); len(snap.Entities) != 1 {
+		t.Fatalf("expected spawn to apply after reset, got %d entities", len(snap.Entities))
+	}
+}
+
+func TestEngine_ResetWorldRestoresInitialState(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10})
+	worldID := "world-1"
+
+	if _, err := e.InitializeWorld(worldID, false, map[string]string{ConfigKeyMaxCommandsPerTick: "1"}); err != nil {
+		t.Fatalf("init: %v", err)
+	}
+	spawn := func(id string) *enginev1.Command {
+		return &enginev1.Command{
+			CommandId: "spawn-" + id,
+			Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: id}},
+		}
+	}
+	for _, id := range []string{"e1", "e2"} {
+		if _, err := e.EnqueueCommand(worldID, spawn(id), false, 0); err != nil {
+			t.Fatalf("enqueue %s: %v", id, err)
+		}
+	}
+	// One command per tick: e1 applies, e2 stays queued.
+	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+
+	tick, err := e.ResetWorld(worldID)
+	if err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+	if tick != 0 {
+		t.Fatalf("expected tick 0 after reset, got %d", tick)
+	}
+	snap, err := e.Snapshot(worldID, nil, nil, false)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if snap.GetTick() != 0 || len(snap.GetEntities()) != 0 {
+		t.Fatalf("expected an empty world at tick 0, got tick=%d entities=%d", snap.GetTick(), len(snap.GetEntities()))
+	}
+	if stats := e.WorldStats(); len(stats) != 1 || stats[0].QueuedCommands != 0 {
+		t.Fatalf("expected the queue to be cleared, got %+v", stats)
+	}
+
+	// Seen command ids are forgotten, so the same spawn applies again, and the
+	// per-world config survives (still one command per tick).
+	if _, err := e.EnqueueCommand(worldID, spawn("e1"), false, 0); err != nil {
+		t.Fatalf("re-enqueue e1: %v", err)
+	}
+	if _, err := e.EnqueueCommand(worldID, spawn("e2"), false, 0); err != nil {
+		t.Fatalf("re-enqueue e2: %v", err)
+	}
+	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+	snap, err = e.Snapshot(worldID, nil, nil, false)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if len(snap.GetEntities()) != 1 || snap.GetEntities()[0].GetEntityId() != "e1" {
+		t.Fatalf("expected only e1 after one tick, got %v", snap.GetEntities())
+	}
+
+	if _, err := e.ResetWorld("missing"); !errors.Is(err, ErrWorldNotFound) {
+		t.Fatalf("expected ErrWorldNotFound for unknown world, got %v", err)
+	}
+}
+
+func TestEngine_MoveMissingEntityEmitsStructuredRejection(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10})
+	worldID := "world-1"
+
+	if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+		CommandId: "move-ghost",
+		ActorId:   "a1",
+		Payload: &enginev1.Command_Move{Move: &enginev1.MoveCommand{
+			EntityId: "ghost",
+			Position: &enginev1.Vec3{X: 1},
+		}},
+	}, false, 0); err != nil {
+		t.Fatalf("enqueue move: %v", err)
+	}
+	_, events, err := e.Tick(worldID, 0, 0)
+	if err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+
+	ev := events[0]
+	if ev.GetType() != "physics.command.error" || ev.GetTick() != 1 {
+		t.Fatalf("unexpected event envelope type=%q tick=%d", ev.GetType(), ev.GetTick())
+	}
+	rej := ev.GetCommandRejected()
+	if rej == nil {
+		t.Fatalf("expected command_rejected payload, got %T", ev.GetPayload())
+	}
+	if rej.GetCommandId() != "move-ghost" || rej.GetActorId() != "a1" {
+		t.Fatalf("unexpected command identity %q/%q", rej.GetCommandId(), rej.GetActorId())
+	}
+	if rej.GetReason() != enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_ENTITY_NOT_FOUND {
+		t.Fatalf("expected ENTITY_NOT_FOUND, got %v", rej.GetReason())
+	}
+	if rej.GetCode() != enginev1.StatusCode_STATUS_CODE_NOT_FOUND {
+		t.Fatalf("expected STATUS_CODE_NOT_FOUND, got %v", rej.GetCode())
+	}
+	if rej.GetMessage() != `entity "ghost" not found` {
+		t.Fatalf("unexpected message %q", rej.GetMessage())
+	}
+}
+
+func TestEngine_ListWorldsReportsTicksAndEntities(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10})
+
+	for _, id := range []string{"world-b", "world-a"} {
+		if _, err := e.InitializeWorld(id, false, nil); err != nil {
+			t.Fatalf("init %s: %v", id, err)
+		}
+	}
+	if _, err := e.EnqueueCommand("world-a", &enginev1.Command{
+		CommandId: "spawn",
+		Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}},
+	}, false, 0); err != nil {
+		t.Fatalf("enqueue spawn: %v", err)
+	}
+	if _, _, err := e.Tick("world-a", 0, 3); err != nil {
+		t.Fatalf("tick world-a: %v", err)
+	}
+
+	got := e.ListWorlds()
+	if len(got) != 2 {
+		t.Fatalf("expected 2 worlds, got %d", len(got))
+	}
+	if got[0].GetWorldId() != "world-a" || got[0].GetTick() != 3 || got[0].GetEntityCount() != 1 {
+		t.Fatalf("unexpected world-a summary %v", got[0])
+	}
+	if got[1].GetWorldId() != "world-b" || got[1].GetTick() != 0 || got[1].GetEntityCount() != 0 {
+		t.Fatalf("unexpected world-b summary %v", got[1])
+	}
+}
+
+func TestEngine_AppliedSpawnEventReportsGeneratedEntityID(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10})
+	worldID := "world-1"
+
+	for _, cmd := range []*enginev1.Command{
+		{CommandId: "auto", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{}}},
+		{CommandId: "named", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "hero"}}},
+	} {
+		if _, err := e.EnqueueCommand(worldID, cmd, false, 0); err != nil {
+			t.Fatalf("enqueue %s: %v", cmd.GetCommandId(), err)
+		}
+	}
+	_, events, err := e.Tick(worldID, 0, 0)
+	if err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected 2 applied events, got %d", len(events))
+	}
+
+	var auto, named struct {
+		CommandID          string   `json:"commandId"`
+		GeneratedEntityIDs []string `json:"generatedEntityIds"`
+	}
+	if err := json.Unmarshal(events[0].GetOpaque(), &auto); err != nil {
+		t.Fatalf("decode auto event: %v", err)
+	}
+	if err := json.Unmarshal(events[1].GetOpaque(), &named); err != nil {
+		t.Fatalf("decode named event: %v", err)
+	}
+	if auto.CommandID != "auto" || len(auto.GeneratedEntityIDs) != 1 || auto.GeneratedEntityIDs[0] != "e-1" {
+		t.Fatalf("expected generated id e-1 for auto spawn, got %+v", auto)
+	}
+	if named.CommandID != "named" || named.GeneratedEntityIDs != nil {
+		t.Fatalf("expected no generated ids for a named spawn, got %+v", named)
+	}
+
+	snap, err := e.Snapshot(worldID, nil, []string{"e-1"}, false)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if len(snap.Entities) != 1 {
+		t.Fatalf("expected the reported id to reference the spawned entity")
+	}
+}
+
+func TestEngine_GeneratedEntityIDSkipsExplicitIDs(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10})
+	worldID := "world-1"
+
+	for _, cmd := range []*enginev1.Command{
+		{CommandId: "claim-1", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e-1"}}},
+		{CommandId: "claim-2", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e-2"}}},
+		{CommandId: "auto", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{}}},
+	} {
+		if _, err := e.EnqueueCommand(worldID, cmd, false, 0); err != nil {
+			t.Fatalf("enqueue %s: %v", cmd.GetCommandId(), err)
+		}
+	}
+	_, events, err := e.Tick(worldID, 0, 0)
+	if err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(events))
+	}
+	var auto struct {
+		GeneratedEntityIDs []string `json:"generatedEntityIds"`
+	}
+	if err := json.Unmarshal(events[2].GetOpaque(), &auto); err != nil {
+		t.Fatalf("decode auto event: %v", err)
+	}
+	if events[2].GetType() != "physics.command.applied" || len(auto.GeneratedEntityIDs) != 1 || auto.GeneratedEntityIDs[0] != "e-3" {
+		t.Fatalf("expected the generated spawn to apply as e-3, got %s %+v", events[2].GetType(), auto)
+	}
+}
+
+func TestEngine_TickClampsToMaxStepsPerTick(t *testing.T) {
+	e := New(Config{MaxStepsPerTick: 5})
+	worldID := "world-1"
+
+	// Exactly the cap: the target is reached and nothing is reported.
+	newTick, _, err := e.Tick(worldID, 0, 5)
+	if err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+	if newTick != 5 {
+		t.Fatalf("expected tick 5, got %d", newTick)
+	}
+	if md := TickMetadata(5, newTick); md != nil {
+		t.Fatalf("expected no metadata when the target is reached, got %v", md)
+	}
+
+	// One past the cap: the world stops at the cap and the clamp is reported.
+	newTick, _, err = e.Tick(worldID, 0, 11)
+	if err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+	if newTick != 10 {
+		t.Fatalf("expected tick clamped to 10, got %d", newTick)
+	}
+	md := TickMetadata(11, newTick)
+	if md[MetadataTickClamped] != "true" || md[MetadataTargetTick] != "11" {
+		t.Fatalf("expected clamp metadata, got %v", md)
+	}
+
+	if md := TickMetadata(0, newTick); md != nil {
+		t.Fatalf("expected no metadata for a single-step tick, got %v", md)
+	}
+}
+
+func TestEngine_TickDefaultStepCap(t *testing.T) {
+	e := New(Config{})
+	newTick, _, err := e.Tick("world-1", 0, 1500)
+	if err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+	if newTick != 1000 {
+		t.Fatalf("expected default cap of 1000 steps, got %d", newTick)
+	}
+}
+
+// cancelAfterCtx reports itself cancelled once Err has been called more than n
+// times, so a test can cancel at an exact step of a multi-tick advance.
+type cancelAfterCtx struct {
+	context.Context
+	n int
+}
+
+func (c *cancelAfterCtx) Err() error {
+	if c.n <= 0 {
+		return context.Canceled
+	}
+	c.n--
+	return nil
+}
+
+func TestEngine_TickContextStopsWhenCancelled(t *testing.T) {
+	e := New(Config{})
+	worldID := "world-1"
+
+	newTick, _, err := e.TickContext(&cancelAfterCtx{Context: context.Background(), n: 3}, worldID, 0, 100)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if newTick != 3 {
+		t.Fatalf("expected the advance to stop after 3 steps, got tick %d", newTick)
+	}
+
+	// The steps taken before the cancel stay applied.
+	ws, err := e.Snapshot(worldID, nil, nil, false)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if ws.GetTick() != 3 {
+		t.Fatalf("expected world at tick 3, got %d", ws.GetTick())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if newTick, _, err := e.TickContext(ctx, worldID, 0, 0); !errors.Is(err, context.Canceled) || newTick != 3 {
+		t.Fatalf("expected an already-cancelled context to take no step, got tick %d err %v", newTick, err)
+	}
+}
+
+func TestEngine_SnapshotOrdersGeneratedIDsNaturally(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 20})
+	worldID := "world-1"
+
+	for i := 0; i < 12; i++ {
+		if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+			CommandId: fmt.Sprintf("spawn-%d", i),
+			Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{}},
+		}, false, 0); err != nil {
+			t.Fatalf("enqueue spawn %d: %v", i, err)
+		}
+	}
+	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+
+	snap, err := e.Snapshot(worldID, nil, nil, false)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if len(snap.Entities) != 12 {
+		t.Fatalf("expected 12 entities, got %d", len(snap.Entities))
+	}
+	for i, ent := range snap.Entities {
+		if want := fmt.Sprintf("e-%d", i+1); ent.GetEntityId() != want {
+			t.Fatalf("entity %d: expected %s, got %s", i, want, ent.GetEntityId())
+		}
+	}
+}
+
+func TestNaturalLess(t *testing.T) {
+	ordered := []string{"", "a", "a-2", "a-10", "b", "e-02", "e-2", "e-2x", "e-10", "player", "player1", "player9", "player10"}
+	for i := range ordered {
+		for j := range ordered {
+			if got, want := naturalLess(ordered[i], ordered[j]), i < j; got != want {
+				t.Fatalf("naturalLess(%q, %q) = %v, want %v", ordered[i], ordered[j], got, want)
+			}
+		}
+	}
+}
+
+func TestEngine_SnapshotManyReportsPerWorldResults(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10})
+
+	for _, id := range []string{"world-a", "world-b"} {
+		if _, err := e.InitializeWorld(id, false, nil); err != nil {
+			t.Fatalf("init %s: %v", id, err)
+		}
+	}
+	if _, err := e.EnqueueCommand("world-b", &enginev1.Command{
+		CommandId: "spawn",
+		Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}},
+	}, false, 0); err != nil {
+		t.Fatalf("enqueue spawn: %v", err)
+	}
+	if _, _, err := e.Tick("world-b", 0, 2); err != nil {
+		t.Fatalf("tick world-b: %v", err)
+	}
+
+	got := e.SnapshotMany([]string{"world-b", "missing", "world-a"}, true)
+	if len(got) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(got))
+	}
+	if got[0].WorldID != "world-b" || got[0].Err != nil || got[0].State.GetTick() != 2 || len(got[0].State.GetEntities()) != 1 {
+		t.Fatalf("unexpected world-b result %+v", got[0])
+	}
+	if got[1].WorldID != "missing" || !errors.Is(got[1].Err, ErrWorldNotFound) || got[1].State != nil {
+		t.Fatalf("expected ErrWorldNotFound for missing world, got %+v", got[1])
+	}
+	if got[2].WorldID != "world-a" || got[2].Err != nil || got[2].State.GetTick() != 0 {
+		t.Fatalf("unexpected world-a result %+v", got[2])
+	}
+
+	if worlds := e.ListWorlds(); len(worlds) != 2 {
+		t.Fatalf("expected SnapshotMany not to create worlds, got %d", len(worlds))
+	}
+}
+
+func TestEngine_SeededWorldsReplayIdentically(t *testing.T) {
+	run := func() ([]*enginev1.Event, *enginev1.WorldState) {
+		e := New(Config{MaxCommandsPerTick: 10, SpawnJitter: 5})
+		worldID := "world-replay"
+		for i := 0; i < 4; i++ {
+			if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+				CommandId: fmt.Sprintf("spawn-%d", i),
+				ActorId:   "a1",
+				Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: fmt.Sprintf("e%d", i)}},
+			}, false, 0); err != nil {
+				t.Fatalf("enqueue spawn-%d: %v", i, err)
+			}
+		}
+		_, events, err := e.Tick(worldID, 0, 0)
+		if err != nil {
+			t.Fatalf("tick: %v", err)
+		}
+		snap, err := e.Snapshot(worldID, nil, nil, true)
+		if err != nil {
+			t.Fatalf("snapshot: %v", err)
+		}
+		return events, snap
+	}
+
+	eventsA, snapA := run()
+	eventsB, snapB := run()
+
+	if len(eventsA) != len(eventsB) || len(eventsA) != 4 {
+		t.Fatalf("expected 4 events from each run, got %d and %d", len(eventsA), len(eventsB))
+	}
+	for i := range eventsA {
+		a, b := eventsA[i], eventsB[i]
+		if a.GetType() != b.GetType() || a.GetTick() != b.GetTick() || string(a.GetOpaque()) != string(b.GetOpaque()) {
+			t.Fatalf("event %d differs: %v vs %v", i, a, b)
+		}
+	}
+
+	if snapA.GetMetadata()["rngSeed"] == "" || snapA.GetMetadata()["rngSeed"] != snapB.GetMetadata()["rngSeed"] {
+		t.Fatalf("expected matching rngSeed metadata, got %q and %q", snapA.GetMetadata()["rngSeed"], snapB.GetMetadata()["rngSeed"])
+	}
+
+	positions := func(ws *enginev1.WorldState) map[string]*enginev1.Vec3 {
+		out := make(map[string]*enginev1.Vec3)
+		for _, ent := range ws.GetEntities() {
+			for _, c := range ent.GetComponents() {
+				if tr := c.GetTransform(); tr != nil {
+					out[ent.GetEntityId()] = tr.GetPosition()
+				}
+			}
+		}
+		return out
+	}
+	posA, posB := positions(snapA), positions(snapB)
+	jittered := false
+	for id, a := range posA {
+		b := posB[id]
+		if a.GetX() != b.GetX() || a.GetY() != b.GetY() || a.GetZ() != b.GetZ() {
+			t.Fatalf("entity %s position differs: %v vs %v", id, a, b)
+		}
+		if math.Hypot(a.GetX(), a.GetZ()) > 5 {
+			t.Fatalf("entity %s placed outside jitter radius: %v", id, a)
+		}
+		if a.GetX() != 0 || a.GetZ() != 0 {
+			jittered = true
+		}
+	}
+	if !jittered {
+		t.Fatalf("expected spawn jitter to move at least one entity off the origin")
+	}
+}
+
+func TestEngine_GetEventsWithinRetentionWindow(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 1, EventRetentionTicks: 8})
+	worldID := "world-1"
+
+	// One spawn per tick: ticks 1..3 each emit one applied event.
+	for i := 0; i < 3; i++ {
+		if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+			CommandId: fmt.Sprintf("spawn-%d", i),
+			Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: fmt.Sprintf("e%d", i)}},
+		}, false, 0); err != nil {
+			t.Fatalf("enqueue spawn-%d: %v", i, err)
+		}
+	}
+	if _, _, err := e.Tick(worldID, 0, 4); err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+
+	got, err := e.GetEvents(worldID, 2, 3)
+	if err != nil {
+		t.Fatalf("GetEvents: %v", err)
+	}
+	if len(got.GetEvents()) != 2 || got.GetEvents()[0].GetTick() != 2 || got.GetEvents()[1].GetTick() != 3 {
+		t.Fatalf("expected events for ticks 2 and 3, got %v", got.GetEvents())
+	}
+	if got.GetCurrentTick() != 4 || got.GetOldestRetainedTick() != 1 {
+		t.Fatalf("unexpected window current=%d oldest=%d", got.GetCurrentTick(), got.GetOldestRetainedTick())
+	}
+
+	all, err := e.GetEvents(worldID, 1, 0)
+	if err != nil {
+		t.Fatalf("GetEvents to current: %v", err)
+	}
+	if len(all.GetEvents()) != 3 {
+		t.Fatalf("expected 3 events up to the current tick, got %d", len(all.GetEvents()))
+	}
+}
+
+func TestEngine_GetEventsOutsideRetentionWindow(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10, EventRetentionTicks: 4})
+	worldID := "world-1"
+
+	if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+		CommandId: "spawn",
+		Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}},
+	}, false, 0); err != nil {
+		t.Fatalf("enqueue spawn: %v", err)
+	}
+	if _, _, err := e.Tick(worldID, 0, 10); err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+
+	// Ticks 7..10 are retained; the spawn event at tick 1 has been dropped.
+	if _, err := e.GetEvents(worldID, 1, 10); !errors.Is(err, ErrEventsNotRetained) {
+		t.Fatalf("expected ErrEventsNotRetained, got %v", err)
+	}
+	got, err := e.GetEvents(worldID, 7, 10)
+	if err != nil {
+		t.Fatalf("GetEvents in window: %v", err)
+	}
+	if got.GetOldestRetainedTick() != 7 || len(got.GetEvents()) != 0 {
+		t.Fatalf("expected empty window starting at 7, got oldest=%d events=%d", got.GetOldestRetainedTick(), len(got.GetEvents()))
+	}
+}
+
+func TestEngine_InitializeWorldAppliesConfigOverride(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10})
+
+	if _, err := e.InitializeWorld("slow", false, map[string]string{
+		ConfigKeyMaxCommandsPerTick: "1",
+		"other.module.setting":      "ignored",
+	}); err != nil {
+		t.Fatalf("init slow: %v", err)
+	}
+	if _, err := e.InitializeWorld("fast", false, nil); err != nil {
+		t.Fatalf("init fast: %v", err)
+	}
+
+	for _, worldID := range []string{"slow", "fast"} {
+		for i := 0; i < 3; i++ {
+			if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+				CommandId: fmt.Sprintf("spawn-%d", i),
+				Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{}},
+			}, false, 0); err != nil {
+				t.Fatalf("enqueue %s spawn-%d: %v", worldID, i, err)
+			}
+		}
+		if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+			t.Fatalf("tick %s: %v", worldID, err)
+		}
+	}
+
+	worlds := e.ListWorlds()
+	if len(worlds) != 2 {
+		t.Fatalf("expected 2 worlds, got %d", len(worlds))
+	}
+	// Sorted by id: fast, slow.
+	if got := worlds[0].GetEntityCount(); got != 3 {
+		t.Fatalf("expected fast world to apply 3 spawns, got %d", got)
+	}
+	if got := worlds[1].GetEntityCount(); got != 1 {
+		t.Fatalf("expected slow world override to apply 1 spawn per tick, got %d", got)
+	}
+}
+
+func TestEngine_InitializeWorldRejectsUnknownRequiredKey(t *testing.T) {
+	e := New(Config{})
+
+	_, err := e.InitializeWorld("world-1", false, map[string]string{"!physics.gravity": "9.8"})
+	if !errors.Is(err, ErrInvalidWorldConfig) {
+		t.Fatalf("expected ErrInvalidWorldConfig, got %v", err)
+	}
+	if len(e.ListWorlds()) != 0 {
+		t.Fatalf("expected rejected world not to be created")
+	}
+
+	if _, err := e.InitializeWorld("world-1", false, map[string]string{"!" + ConfigKeySpawnJitter: "1.5"}); err != nil {
+		t.Fatalf("expected recognized required key to be accepted, got %v", err)
+	}
+	if _, err := e.InitializeWorld("world-2", false, map[string]string{ConfigKeyMaxCommandsPerTick: "zero"}); !errors.Is(err, ErrInvalidWorldConfig) {
+		t.Fatalf("expected malformed value to be rejected, got %v", err)
+	}
+	for _, v := range []string{"NaN", "Inf", "+Inf", "-Inf", "-1"} {
+		if _, err := e.InitializeWorld("world-2", false, map[string]string{ConfigKeySpawnJitter: v}); !errors.Is(err, ErrInvalidWorldConfig) {
+			t.Fatalf("expected spawn jitter %q to be rejected, got %v", v, err)
+		}
+	}
+}
+
+func TestEngine_EnqueueCommandExpectedTick(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10})
+	worldID := "world-1"
+
+	if _, _, err := e.Tick(worldID, 0, 2); err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+
+	spawn := func(id string) *enginev1.Command {
+		return &enginev1.Command{
+			CommandId: id,
+			Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: id}},
+		}
+	}
+
+	// Matching expectation is accepted.
+	applied, err := e.EnqueueCommand(worldID, spawn("matching"), false, 2)
+	if err != nil {
+		t.Fatalf("enqueue with matching expected tick: %v", err)
+	}
+	if applied != 3 {
+		t.Fatalf("expected command to apply at tick 3, got %d", applied)
+	}
+
+	// A client that observed tick 1 is acting on a stale view.
+	if _, err := e.EnqueueCommand(worldID, spawn("stale"), false, 1); !errors.Is(err, ErrTickConflict) {
+		t.Fatalf("expected ErrTickConflict for stale expected tick, got %v", err)
+	}
+
+	// Retrying an already-accepted command stays idempotent even after the world advances.
+	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+	if _, err := e.EnqueueCommand(worldID, spawn("matching"), false, 2); err != nil {
+		t.Fatalf("expected idempotent retry to succeed, got %v", err)
+	}
+
+	snap, err := e.Snapshot(worldID, nil, nil, false)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if len(snap.Entities) != 1 || snap.Entities[0].GetEntityId() != "matching" {
+		t.Fatalf("expected only the matching spawn to apply, got %v", snap.Entities)
+	}
+}
+
+func TestEngine_LogsCommandRejection(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	e := New(Config{MaxCommandsPerTick: 10, Logger: logger})
+	worldID := "world-1"
+
+	if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+		CommandId: "move-ghost",
+		Payload:   &enginev1.Command_Move{Move: &enginev1.MoveCommand{EntityId: "ghost", Position: &enginev1.Vec3{}}},
+	}, false, 0); err != nil {
+		t.Fatalf("enqueue move: %v", err)
+	}
+	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+
+	var rejected, completed map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var rec map[string]any
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			t.Fatalf("decode log line %q: %v", line, err)
+		}
+		switch rec["msg"] {
+		case "command rejected":
+			rejected = rec
+		case "tick completed":
+			completed = rec
+		}
+	}
+
+	if rejected == nil {
+		t.Fatalf("expected a command rejected log, got:\n%s", buf.String())
+	}
+	if rejected["world"] != worldID || rejected["commandId"] != "move-ghost" || rejected["tick"] != float64(1) {
+		t.Fatalf("unexpected rejection log fields %v", rejected)
+	}
+	if rejected["reason"] != enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_ENTITY_NOT_FOUND.String() {
+		t.Fatalf("expected ENTITY_NOT_FOUND reason, got %v", rejected["reason"])
+	}
+	if completed == nil || completed["events"] != float64(1) || completed["queueDepth"] != float64(0) {
+		t.Fatalf("unexpected tick completed log %v", completed)
+	}
+}
+
+func TestCompressWorldStateRoundTrip(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 100})
+	worldID := "world-1"
+	for i := 0; i < 50; i++ {
+		if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+			CommandId: fmt.Sprintf("spawn-%d", i),
+			ActorId:   "a1",
+			Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{
+				EntityId: fmt.Sprintf("e%d", i),
+				Components: []*enginev1.Component{
+					{Payload: &enginev1.Component_Health{Health: &enginev1.HealthComponent{Current: 100, Max: 100}}},
+				},
+			}},
+		}, false, 0); err != nil {
+			t.Fatalf("enqueue spawn: %v", err)
+		}
+	}
+	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+
+	ws, err := e.Snapshot(worldID, nil, nil, true)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	data, err := CompressWorldState(ws)
+	if err != nil {
+		t.Fatalf("compress: %v", err)
+	}
+	if raw, _ := proto.Marshal(ws); len(data) >= len(raw) {
+		t.Fatalf("expected compressed payload (%d bytes) to be smaller than the raw snapshot (%d bytes)", len(data), len(raw))
+	}
+
+	got, err := DecompressWorldState(data)
+	if err != nil {
+		t.Fatalf("decompress: %v", err)
+	}
+	if !proto.Equal(got, ws) {
+		t.Fatalf("round-tripped snapshot differs from the original")
+	}
+
+	if _, err := DecompressWorldState([]byte("not gzip")); err == nil {
+		t.Fatalf("expected error for a payload that is not gzip")
+	}
+}
+
+func TestValidateCommand(t *testing.T) {
+	valid := &enginev1.Command{
+		CommandId: "spawn-1",
+		ActorId:   "a1",
+		Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{
+			Components: []*enginev1.Component{{Payload: &enginev1.Component_Health{Health: &enginev1.HealthComponent{Current: 5, Max: 10}}}},
+		}},
+	}
+	if err := ValidateCommand(valid); err != nil {
+		t.Fatalf("expected valid command, got %v", err)
+	}
+
+	cases := map[string]struct {
+		cmd  *enginev1.Command
+		want string
+	}{
+		"nil command":        {cmd: nil, want: "command is nil"},
+		"empty command id":   {cmd: &enginev1.Command{CommandId: " ", Payload: &enginev1.Command_Opaque{Opaque: &enginev1.OpaqueCommand{Type: "chat.send"}}}, want: "command_id is empty"},
+		"missing payload":    {cmd: &enginev1.Command{CommandId: "c1"}, want: "payload is missing"},
+		"move without id":    {cmd: &enginev1.Command{CommandId: "c1", Payload: &enginev1.Command_Move{Move: &enginev1.MoveCommand{}}}, want: "move.entity_id is empty"},
+		"despawn without id": {cmd: &enginev1.Command{CommandId: "c1", Payload: &enginev1.Command_DespawnEntity{DespawnEntity: &enginev1.DespawnEntityCommand{}}}, want: "despawn_entity.entity_id is empty"},
+		"tag without tags":   {cmd: &enginev1.Command{CommandId: "c1", Payload: &enginev1.Command_TagEntity{TagEntity: &enginev1.TagEntityCommand{EntityId: "e1"}}}, want: "tag_entity.tags is empty"},
+		"tag with comma": {cmd: &enginev1.Command{CommandId: "c1", Payload: &enginev1.Command_UntagEntity{UntagEntity: &enginev1.UntagEntityCommand{
+			EntityId: "e1", Tags: []string{"a,b"},
+		}}}, want: "must not contain a comma"},
+		"move_toward without speed": {cmd: &enginev1.Command{CommandId: "c1", Payload: &enginev1.Command_MoveToward{MoveToward: &enginev1.MoveTowardCommand{
+			EntityId: "e1", Target: &enginev1.Vec3{X: 1},
+		}}}, want: "max_speed=0 must be positive"},
+		"negative health max": {cmd: &enginev1.Command{CommandId: "c1", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{
+			Components: []*enginev1.Component{{Payload: &enginev1.Component_Health{Health: &enginev1.HealthComponent{Max: -1}}}},
+		}}}, want: "must not be negative"},
+		"health over max": {cmd: &enginev1.Command{CommandId: "c1", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{
+			Components: []*enginev1.Component{{Payload: &enginev1.Component_Health{Health: &enginev1.HealthComponent{Current: 11, Max: 10}}}},
+		}}}, want: "exceeds health.max"},
+	}
+	for name, tc := range cases {
+		err := ValidateCommand(tc.cmd)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("%s: expected error containing %q, got %v", name, tc.want, err)
+		}
+	}
+}
+
+func TestEngine_HigherPriorityCommandAppliesFirstWithinTick(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10})
+	worldID := "world-1"
+
+	if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+		CommandId: "spawn",
+		Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}},
+	}, false, 0); err != nil {
+		t.Fatalf("enqueue spawn: %v", err)
+	}
+	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("tick spawn: %v", err)
+	}
+
+	// The move is enqueued (and issued) first, but the despawn outranks it.
+	if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+		CommandId:          "move",
+		IssuedAtUnixMillis: 100,
+		Payload:            &enginev1.Command_Move{Move: &enginev1.MoveCommand{EntityId: "e1", Position: &enginev1.Vec3{X: 1}}},
+	}, false, 0); err != nil {
+		t.Fatalf("enqueue move: %v", err)
+	}
+	if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+		CommandId:          "despawn",
+		IssuedAtUnixMillis: 200,
+		Priority:           10,
+		Payload:            &enginev1.Command_DespawnEntity{DespawnEntity: &enginev1.DespawnEntityCommand{EntityId: "e1"}},
+	}, false, 0); err != nil {
+		t.Fatalf("enqueue despawn: %v", err)
+	}
+
+	_, events, err := e.Tick(worldID, 0, 0)
+	if err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+	if events[0].GetType() != "physics.command.applied" || !strings.Contains(string(events[0].GetOpaque()), `"commandId":"despawn"`) {
+		t.Fatalf("expected despawn applied first, got %s %s", events[0].GetType(), events[0].GetOpaque())
+	}
+	rejected := events[1].GetCommandRejected()
+	if rejected.GetCommandId() != "move" || rejected.GetReason() != enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_ENTITY_NOT_FOUND {
+		t.Fatalf("expected move rejected for the despawned entity, got %+v", rejected)
+	}
+}
+
+func TestEngine_DefaultPriorityKeepsFIFO(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10})
+	worldID := "world-1"
+
+	// Issued-at runs backwards, but without priorities the enqueue order wins.
+	for i, id := range []string{"c-b", "c-a", "c-c"} {
+		if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+			CommandId:          id,
+			IssuedAtUnixMillis: int64(100 - i),
+			Payload:            &enginev1.Command_Opaque{Opaque: &enginev1.OpaqueCommand{}},
+		}, false, 0); err != nil {
+			t.Fatalf("enqueue %s: %v", id, err)
+		}
+	}
+	_, events, err := e.Tick(worldID, 0, 0)
+	if err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+	for i, want := range []string{"c-b", "c-a", "c-c"} {
+		if !strings.Contains(string(events[i].GetOpaque()), fmt.Sprintf(`"commandId":%q`, want)) {
+			t.Fatalf("event %d: expected %s, got %s", i, want, events[i].GetOpaque())
+		}
+	}
+}
+
+func TestEngine_CommandDedupeWindowIsBounded(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10, CommandDedupeWindow: 3})
+	worldID := "world-1"
+
+	enqueue := func(id string) {
+		t.Helper()
+		if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+			CommandId: id,
+			Payload:   &enginev1.Command_Opaque{Opaque: &enginev1.OpaqueCommand{}},
+		}, false, 0); err != nil {
+			t.Fatalf("enqueue %s: %v", id, err)
+		}
+	}
+	for i := 1; i <= 10; i++ {
+		enqueue(fmt.Sprintf("c%d", i))
+		if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+			t.Fatalf("tick %d: %v", i, err)
+		}
+	}
+	w := e.worlds[worldID]
+	if got := w.seenCommandIDs.len(); got != 3 {
+		t.Fatalf("expected dedupe set bounded at 3, got %d", got)
+	}
+
+	// c10 is still remembered, so a retry is an idempotent accept.
+	enqueue("c10")
+	if got := len(w.queue); got != 0 {
+		t.Fatalf("expected recent duplicate to be ignored, queue has %d", got)
+	}
+	// c1 fell out of the window and is treated as a new command.
+	enqueue("c1")
+	if got := len(w.queue); got != 1 {
+		t.Fatalf("expected evicted id to be queued again, queue has %d", got)
+	}
+	if got := w.seenCommandIDs.len(); got != 3 {
+		t.Fatalf("expected dedupe set to stay at 3, got %d", got)
+	}
+}
+
+func TestEngine_AutoTickAdvancesUntilStopped(t *testing.T) {
+	e := New(Config{})
+	worldID := "world-1"
+	if _, err := e.InitializeWorld(worldID, false, nil); err != nil {
+		t.Fatalf("init: %v", err)
+	}
+
+	if err := e.StartAutoTick(time.Millisecond); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	if err := e.StartAutoTick(time.Millisecond); err == nil {
+		t.Fatalf("expected second StartAutoTick to fail")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for worldTick(t, e, worldID) < 3 {
+		if time.Now().After(deadline) {
+			e.StopAutoTick()
+			t.Fatalf("auto-tick did not advance the world")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	// Explicit ticks are safe while the scheduler runs.
+	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("explicit tick: %v", err)
+	}
+
+	e.StopAutoTick()
+	stopped := worldTick(t, e, worldID)
+	time.Sleep(20 * time.Millisecond)
+	if got := worldTick(t, e, worldID); got != stopped {
+		t.Fatalf("expected tick to stay at %d after stop, got %d", stopped, got)
+	}
+	e.StopAutoTick() // no-op when not running
+
+	if err := e.StartAutoTick(time.Millisecond); err != nil {
+		t.Fatalf("restart: %v", err)
+	}
+	e.StopAutoTick()
+}
+
+func worldTick(t *testing.T, e *Engine, worldID string) int64 {
+	t.Helper()
+	snap, err := e.Snapshot(worldID, nil, nil, false)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	return snap.GetTick()
+}
+
+func TestEngine_SpawnPastEntityLimitIsRejected(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10, MaxEntitiesPerWorld: 2})
+	worldID := "world-1"
+
+	for _, id := range []string{"e1", "e2", "e3"} {
+		if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+			CommandId: "spawn-" + id,
+			Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: id}},
+		}, false, 0); err != nil {
+			t.Fatalf("enqueue %s: %v", id, err)
+		}
+	}
+	_, events, err := e.Tick(worldID, 0, 0)
+	if err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+
+	var rejected *enginev1.CommandRejectedEvent
+	for _, ev := range events {
+		if r := ev.GetCommandRejected(); r != nil {
+			rejected = r
+		}
+	}
+	if rejected == nil {
+		t.Fatalf("expected a rejection event, got %v", events)
+	}
+	if rejected.GetCommandId() != "spawn-e3" ||
+		rejected.GetReason() != enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_ENTITY_LIMIT_EXCEEDED ||
+		rejected.GetCode() != enginev1.StatusCode_STATUS_CODE_FAILED_PRECONDITION {
+		t.Fatalf("unexpected rejection: %v", rejected)
+	}
+
+	snap, err := e.Snapshot(worldID, nil, nil, false)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if got := len(snap.Entities); got != 2 {
+		t.Fatalf("expected the 2 existing entities to remain, got %d", got)
+	}
+}
+
+func TestEngine_MoveTowardAdvancesEachTickUntilArrival(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10})
+	worldID := "world-1"
+
+	if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+		CommandId: "spawn",
+		Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}},
+	}, false, 0); err != nil {
+		t.Fatalf("enqueue spawn: %v", err)
+	}
+	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("tick spawn: %v", err)
+	}
+	if _, err := e.EnqueueCommand(worldID, &enginev1.Command{
+		CommandId: "walk",
+		Payload: &enginev1.Command_MoveToward{MoveToward: &enginev1.MoveTowardCommand{
+			EntityId: "e1",
+			Target:   &enginev1.Vec3{X: 6, Z: 8},
+			MaxSpeed: 4,
+		}},
+	}, false, 0); err != nil {
+		t.Fatalf("enqueue move_toward: %v", err)
+	}
+
+	// The target is 10 units away: 4 per tick reaches it on the third tick.
+	want := []*enginev1.Vec3{{X: 2.4, Z: 3.2}, {X: 4.8, Z: 6.4}, {X: 6, Z: 8}}
+	for i, w := range want {
+		tick, events, err := e.Tick(worldID, 0, 0)
+		if err != nil {
+			t.Fatalf("tick %d: %v", i, err)
+		}
+		snap, err := e.Snapshot(worldID, nil, nil, true)
+		if err != nil {
+			t.Fatalf("snapshot: %v", err)
+		}
+		got := entityPosition(snap.Entities[0])
+		if math.Abs(got.X-w.X) > 1e-9 || math.Abs(got.Z-w.Z) > 1e-9 || got.Y != 0 {
+			t.Fatalf("tick %d: expected position %+v, got %+v", tick, w, got)
+		}
+
+		var arrived []*enginev1.Event
+		for _, ev := range events {
+			if ev.GetType() == EventTypeArrived {
+				arrived = append(arrived, ev)
+			}
+		}
+		if i < len(want)-1 {
+			if len(arrived) != 0 {
+				t.Fatalf("tick %d: unexpected arrival before reaching the target", tick)
+			}
+			continue
+		}
+		if len(arrived) != 1 {
+			t.Fatalf("tick %d: expected one arrived event, got %v", tick, events)
+		}
+		var payload struct {
+			EntityID string `json:"entityId"`
+			Tick     int64  `json:"tick"`
+		}
+		if err := json.Unmarshal(arrived[0].GetOpaque(), &payload); err != nil {
+			t.Fatalf("decode arrived payload: %v", err)
+		}
+		if payload.EntityID != "e1" || payload.Tick != tick || arrived[0].GetTick() != tick {
+			t.Fatalf("unexpected arrived event at tick %d: %s", tick, arrived[0].GetOpaque())
+		}
+	}
+
+	// Arrival clears the target: further ticks neither move the entity nor re-emit.
+	_, events, err := e.Tick(worldID, 0, 0)
+	if err != nil {
+		t.Fatalf("tick after arrival: %v", err)
+	}
+	if len(events) != 0 {
+		t.Fatalf("expected no events after arrival, got %v", events)
+	}
+}
+
+func TestEngine_MoveCancelsPendingMoveToward(t *testing.T) {
+	h, err := NewHarness(Config{MaxCommandsPerTick: 10}, "world-1", nil)
+	if err != nil {
+		t.Fatalf("new harness: %v", err)
+	}
+	if err := h.Run([]ScriptStep{
+		{Commands: []*enginev1.Command{{CommandId: "spawn", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}}}}},
+		{Commands: []*enginev1.Command{{CommandId: "walk", Payload: &enginev1.Command_MoveToward{MoveToward: &enginev1.MoveTowardCommand{
+			EntityId: "e1", Target: &enginev1.Vec3{X: 100}, MaxSpeed: 1,
+		}}}}},
+		{Commands: []*enginev1.Command{{CommandId: "teleport", Payload: &enginev1.Command_Move{Move: &enginev1.MoveCommand{
+			EntityId: "e1", Position: &enginev1.Vec3{X: -5},
+		}}}}, Ticks: 3},
+	}); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+
+	snap, err := h.Engine.Snapshot(h.WorldID, nil, nil, true)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if got := entityPosition(snap.Entities[0]); got.X != -5 {
+		t.Fatalf("expected the move to cancel move_toward and stay at x=-5, got %+v", got)
+	}
+}
+
+func TestEngine_TagsRoundTripAndFilterSnapshots(t *testing.T) {
+	h, err := NewHarness(Config{MaxCommandsPerTick: 10}, "world-1", nil)
+	if err != nil {
+		t.Fatalf("new harness: %v", err)
+	}
+	spawn := func(id string, tags ...string) *enginev1.Command {
+		var components []*enginev1.Component
+		if len(tags) > 0 {
+			components = append(components, &enginev1.Component{Payload: &enginev1.Component_Tags{Tags: &enginev1.TagsComponent{Tags: tags}}})
+		}
+		return &enginev1.Command{CommandId: "spawn-" + id, Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{
+			EntityId: id, Components: components,
+		}}}
+	}
+	if err := h.Run([]ScriptStep{
+		{Commands: []*enginev1.Command{spawn("e1", "npc", "hostile"), spawn("e2", "npc"), spawn("e3")}},
+		{Commands: []*enginev1.Command{
+			{CommandId: "tag-e3", Payload: &enginev1.Command_TagEntity{TagEntity: &enginev1.TagEntityCommand{EntityId: "e3", Tags: []string{"hostile", "boss"}}}},
+			{CommandId: "untag-e1", Payload: &enginev1.Command_UntagEntity{UntagEntity: &enginev1.UntagEntityCommand{EntityId: "e1", Tags: []string{"hostile", "absent"}}}},
+		}},
+	}); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+
+	snap, err := h.Engine.Snapshot(h.WorldID, nil, nil, true)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	want := map[string]string{"e1": "npc", "e2": "npc", "e3": "boss,hostile"}
+	for _, e := range snap.Entities {
+		if got := e.GetMetadata()[MetadataKeyTags]; got != want[e.EntityId] {
+			t.Fatalf("%s: expected tags %q, got %q", e.EntityId, want[e.EntityId], got)
+		}
+		for _, c := range e.GetComponents() {
+			if c.GetTags() != nil || c.GetPayload() == nil || c.GetType() == "tags" {
+				t.Fatalf("%s: tags component should not be stored, got %v", e.EntityId, c)
+			}
+		}
+	}
+
+	ids := func(tags ...string) []string {
+		snap, err := h.Engine.SnapshotByTags(h.WorldID, nil, nil, tags, false)
+		if err != nil {
+			t.Fatalf("snapshot by %v: %v", tags, err)
+		}
+		var out []string
+		for _, e := range snap.Entities {
+			out = append(out, e.EntityId)
+		}
+		return out
+	}
+	if got := ids("npc"); fmt.Sprint(got) != "[e1 e2]" {
+		t.Fatalf("npc: expected [e1 e2], got %v", got)
+	}
+	if got := ids("hostile"); fmt.Sprint(got) != "[e3]" {
+		t.Fatalf("hostile: expected [e3] after untag, got %v", got)
+	}
+	if got := ids("hostile", "npc"); len(got) != 0 {
+		t.Fatalf("hostile+npc: expected no entities, got %v", got)
+	}
+}
+
+func TestEngine_SetVelocityIntegratesPositionEachTick(t *testing.T) {
+	h, err := NewHarness(Config{MaxCommandsPerTick: 10, IntegrateVelocity: true}, "world-1", nil)
+	if err != nil {
+		t.Fatalf("new harness: %v", err)
+	}
+	position := func() *enginev1.Vec3 {
+		snap, err := h.Engine.Snapshot(h.WorldID, nil, nil, true)
+		if err != nil {
+			t.Fatalf("snapshot: %v", err)
+		}
+		return entityPosition(snap.Entities[0])
+	}
+
+	if err := h.Run([]ScriptStep{
+		{Commands: []*enginev1.Command{{CommandId: "spawn", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}}}}},
+		{Commands: []*enginev1.Command{{CommandId: "push", Payload: &enginev1.Command_SetVelocity{SetVelocity: &enginev1.SetVelocityCommand{
+			EntityId: "e1", Velocity: &enginev1.Vec3{X: 1, Z: -2},
+		}}}}},
+	}); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	// The velocity applies on the tick the command does, so the entity has
+	// already moved once.
+	if got := position(); got.X != 1 || got.Z != -2 {
+		t.Fatalf("expected (1,0,-2) after the first integrated tick, got %+v", got)
+	}
+	if err := h.Advance(3); err != nil {
+		t.Fatalf("advance: %v", err)
+	}
+	if got := position(); got.X != 4 || got.Y != 0 || got.Z != -8 {
+		t.Fatalf("expected (4,0,-8) after four integrated ticks, got %+v", got)
+	}
+
+	// Without integration the velocity is stored but the entity stays put.
+	static, err := NewHarness(Config{MaxCommandsPerTick: 10}, "world-1", nil)
+	if err != nil {
+		t.Fatalf("new harness: %v", err)
+	}
+	if err := static.Run([]ScriptStep{
+		{Commands: []*enginev1.Command{{CommandId: "spawn", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}}}}},
+		{Commands: []*enginev1.Command{{CommandId: "push", Payload: &enginev1.Command_SetVelocity{SetVelocity: &enginev1.SetVelocityCommand{
+			EntityId: "e1", Velocity: &enginev1.Vec3{X: 1},
+		}}}}, Ticks: 3},
+	}); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	snap, err := static.Engine.Snapshot(static.WorldID, nil, nil, true)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	var transform *enginev1.TransformComponent
+	for _, c := range snap.Entities[0].GetComponents() {
+		if transform = c.GetTransform(); transform != nil {
+			break
+		}
+	}
+	if transform.GetPosition().GetX() != 0 || transform.GetVelocity().GetX() != 1 {
+		t.Fatalf("expected velocity stored without moving, got %+v", transform)
+	}
+}
+
+func TestEngine_IntegrateVelocityUsesFixedPoint(t *testing.T) {
+	h, err := NewHarness(Config{MaxCommandsPerTick: 10, IntegrateVelocity: true}, "world-1", nil)
+	if err != nil {
+		t.Fatalf("new harness: %v", err)
+	}
+	if err := h.Run([]ScriptStep{
+		{Commands: []*enginev1.Command{{CommandId: "spawn", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}}}}},
+		{Commands: []*enginev1.Command{{CommandId: "push", Payload: &enginev1.Command_SetVelocity{SetVelocity: &enginev1.SetVelocityCommand{
+			EntityId: "e1", Velocity: &enginev1.Vec3{X: 0.1, Y: -0.3},
+		}}}}, Ticks: 10},
+	}); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	snap, err := h.Engine.Snapshot(h.WorldID, nil, nil, true)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	// Summing 0.1 ten times in float64 gives 0.9999999999999999.
+	if got := entityPosition(snap.Entities[0]); got.X != 1 || got.Y != -3 {
+		t.Fatalf("expected exactly (1,-3,0) after ten ticks, got %+v", got)
+	}
+}
+
+func TestEngine_IntegrateVelocityMovesSpawnedEntity(t *testing.T) {
+	script := []ScriptStep{
+		{Commands: []*enginev1.Command{{CommandId: "spawn", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{
+			EntityId: "e1", Velocity: &enginev1.Vec3{X: 0.5, Y: -1},
+		}}}}, Ticks: 4},
+	}
+	run := func(cfg Config, worldConfig map[string]string) *enginev1.Vec3 {
+		t.Helper()
+		h, err := NewHarness(cfg, "world-1", worldConfig)
+		if err != nil {
+			t.Fatalf("new harness: %v", err)
+		}
+		if err := h.Run(script); err != nil {
+			t.Fatalf("run: %v", err)
+		}
+		snap, err := h.Engine.Snapshot(h.WorldID, nil, nil, true)
+		if err != nil {
+			t.Fatalf("snapshot: %v", err)
+		}
+		return entityPosition(snap.Entities[0])
+	}
+
+	// Spawned on tick 1 and integrated on ticks 1 through 4.
+	if got := run(Config{IntegrateVelocity: true}, nil); got.X != 2 || got.Y != -4 || got.Z != 0 {
+		t.Fatalf("expected (2,-4,0), got %+v", got)
+	}
+	if got := run(Config{}, map[string]string{ConfigKeyIntegrateVelocity: "true"}); got.X != 2 || got.Y != -4 {
+		t.Fatalf("expected the world config to enable integration, got %+v", got)
+	}
+	if got := run(Config{IntegrateVelocity: true}, map[string]string{ConfigKeyIntegrateVelocity: "false"}); got.X != 0 || got.Y != 0 {
+		t.Fatalf("expected the world config to disable integration, got %+v", got)
+	}
+	if _, err := New(Config{}).InitializeWorld("world-2", false, map[string]string{ConfigKeyIntegrateVelocity: "sometimes"}); !errors.Is(err, ErrInvalidWorldConfig) {
+		t.Fatalf("expected ErrInvalidWorldConfig for a non-boolean value, got %v", err)
+	}
+}
+
+func TestEngine_RequireExplicitInit(t *testing.T) {
+	spawn := &enginev1.Command{CommandId: "c1", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e1"}}}
+
+	strict := New(Config{RequireExplicitInit: true})
+	if _, err := strict.EnqueueCommand("typo", spawn, false, 0); !errors.Is(err, ErrWorldNotInitialized) {
+		t.Fatalf("enqueue: expected ErrWorldNotInitialized, got %v", err)
+	}
+	if _, _, err := strict.Tick("typo", 0, 0); !errors.Is(err, ErrWorldNotInitialized) {
+		t.Fatalf("tick: expected ErrWorldNotInitialized, got %v", err)
+	}
+	if _, err := strict.Snapshot("typo", nil, nil, false); !errors.Is(err, ErrWorldNotInitialized) {
+		t.Fatalf("snapshot: expected ErrWorldNotInitialized, got %v", err)
+	}
+	if _, err := strict.GetEvents("typo", 0, 0); !errors.Is(err, ErrWorldNotInitialized) {
+		t.Fatalf("get events: expected ErrWorldNotInitialized, got %v", err)
+	}
+	if got := strict.ListWorlds(); len(got) != 0 {
+		t.Fatalf("expected strict mode not to create worlds, got %v", got)
+	}
+
+	if _, err := strict.InitializeWorld("world-1", false, nil); err != nil {
+		t.Fatalf("init: %v", err)
+	}
+	if _, err := strict.EnqueueCommand("world-1", spawn, false, 0); err != nil {
+		t.Fatalf("enqueue after init: %v", err)
+	}
+	if tick, _, err := strict.Tick("world-1", 0, 0); err != nil || tick != 1 {
+		t.Fatalf("tick after init: tick=%d err=%v", tick, err)
+	}
+
+	// The default keeps creating worlds on first use.
+	lenient := New(Config{})
+	if _, err := lenient.EnqueueCommand("typo", spawn, false, 0); err != nil {
+		t.Fatalf("lenient enqueue: %v", err)
+	}
+	if _, err := lenient.Snapshot("other", nil, nil, false); err != nil {
+		t.Fatalf("lenient snapshot: %v", err)
+	}
+	if got := lenient.ListWorlds(); len(got) != 2 {
+		t.Fatalf("expected lenient mode to auto-create both worlds, got %v", got)
+	}
+}
+
+func TestEngine_EvictIdleWorldsDropsOnlyUnusedWorlds(t *testing.T) {
+	now := time.Unix(1000, 0)
+	saved := map[string]int64{}
+	e := New(Config{
+		WorldIdleTTL: time.Minute,
+		Now:          func() time.Time { return now },
+		OnEvict:      func(worldID string, state *enginev1.WorldState) { saved[worldID] = state.GetTick() },
+	})
+	for _, id := range []string{"idle", "active"} {
+		if _, err := e.InitializeWorld(id, false, nil); err != nil {
+			t.Fatalf("init %s: %v", id, err)
+		}
+	}
+	if _, _, err := e.Tick("idle", 0, 0); err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+
+	now = now.Add(40 * time.Second)
+	if _, err := e.Snapshot("active", nil, nil, false); err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	now = now.Add(30 * time.Second)
+	// Auto-ticks step every world but must not keep idle ones alive.
+	e.TickAll()
+
+	if got := e.EvictIdleWorlds(); len(got) != 1 || got[0] != "idle" {
+		t.Fatalf("expected only the idle world to be evicted, got %v", got)
+	}
+	if tick, ok := saved["idle"]; !ok || tick != 2 {
+		t.Fatalf("expected OnEvict to receive the idle world at tick 2, got %v", saved)
+	}
+	worlds := e.ListWorlds()
+	if len(worlds) != 1 || worlds[0].GetWorldId() != "active" {
+		t.Fatalf("expected only the active world to remain, got %v", worlds)
+	}
+
+	now = now.Add(time.Minute)
+	if got := e.EvictIdleWorlds(); len(got) != 1 || got[0] != "active" {
+		t.Fatalf("expected the active world to be evicted once idle too, got %v", got)
+	}
+}
+
+func TestEngine_ReplayFromEventsRebuildsRecordedSession(t *testing.T) {
+	var log []TickRecord
+	cfg := Config{SpawnJitter: 5}
+	recorded := cfg
+	recorded.TickRecorder = func(worldID string, rec TickRecord) {
+		// Keep only ticks that did something, so replay has to fill the gaps.
+		if len(rec.Commands) > 0 {
+			log = append(log, rec)
+		}
+	}
+	e := New(recorded)
+	worldID := "world-1"
+
+	session := [][]*enginev1.Command{
+		{
+			{CommandId: "c1", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "hero"}}},
+			{CommandId: "c2", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{}}},
+		},
+		nil,
+		{
+			{CommandId: "c3", Payload: &enginev1.Command_Move{Move: &enginev1.MoveCommand{EntityId: "hero", Position: &enginev1.Vec3{X: 1}}}},
+			{CommandId: "c4", Payload: &enginev1.Command_Move{Move: &enginev1.MoveCommand{EntityId: "ghost", Position: &enginev1.Vec3{}}}},
+		},
+		nil,
+		{
+			{CommandId: "c5", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{}}},
+		},
+	}
+	for _, cmds := range session {
+		for _, cmd := range cmds {
+			if _, err := e.EnqueueCommand(worldID, cmd, false, 0); err != nil {
+				t.Fatalf("enqueue %s: %v", cmd.GetCommandId(), err)
+			}
+		}
+		if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+			t.Fatalf("tick: %v", err)
+		}
+	}
+	want, err := e.Snapshot(worldID, nil, nil, true)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+
+	fresh := New(cfg)
+	tick, err := fresh.ReplayFromEvents(worldID, log)
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if tick != want.GetTick() {
+		t.Fatalf("expected replay to reach tick %d, got %d", want.GetTick(), tick)
+	}
+	got, err := fresh.Snapshot(worldID, nil, nil, true)
+	if err != nil {
+		t.Fatalf("snapshot replayed: %v", err)
+	}
+	if len(got.GetEntities()) != 3 {
+		t.Fatalf("expected 3 replayed entities, got %v", got.GetEntities())
+	}
+	for i := range want.GetEntities() {
+		if !proto.Equal(want.GetEntities()[i], got.GetEntities()[i]) {
+			t.Fatalf("entity %d differs after replay:\nwant %v\ngot  %v", i, want.GetEntities()[i], got.GetEntities()[i])
+		}
+	}
+
+	// A replayed command id is remembered, so a client retry stays idempotent.
+	if _, err := fresh.EnqueueCommand(worldID, session[0][0], false, 0); err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if _, _, err := fresh.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("tick replayed: %v", err)
+	}
+	if n := len(mustSnapshot(t, fresh, worldID).GetEntities()); n != 3 {
+		t.Fatalf("expected retried spawn to be deduplicated, got %d entities", n)
+	}
+
+	// Dropping a command changes what later ticks emit.
+	tampered := append([]TickRecord(nil), log...)
+	tampered[1].Commands = tampered[1].Commands[:1]
+	if _, err := New(cfg).ReplayFromEvents(worldID, tampered); !errors.Is(err, ErrReplayDiverged) {
+		t.Fatalf("expected ErrReplayDiverged, got %v", err)
+	}
+}
+
+func mustSnapshot(t *testing.T, e *Engine, worldID string) *enginev1.WorldState {
+	t.Helper()
+	snap, err := e.Snapshot(worldID, nil, nil, false)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	return snap
+}
+
+func TestEngine_SpawnRejectsMalformedTags(t *testing.T) {
+	for name, tags := range map[string][]string{"comma": {"x,y"}, "empty": {"ok", " "}} {
+		t.Run(name, func(t *testing.T) {
+			cmd := &enginev1.Command{CommandId: "spawn-1", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{
+				EntityId:   "e1",
+				Components: []*enginev1.Component{{Type: "tags", Payload: &enginev1.Component_Tags{Tags: &enginev1.TagsComponent{Tags: tags}}}},
+			}}}
+			if err := ValidateCommand(cmd); err == nil {
+				t.Fatalf("expected ValidateCommand to reject tags %q", tags)
+			}
+			e := New(Config{})
+			if _, err := e.EnqueueCommand("world-1", cmd, false, 0); err == nil {
+				t.Fatalf("expected EnqueueCommand to reject tags %q", tags)
+			}
+			if n := len(mustSnapshot(t, e, "world-1").GetEntities()); n != 0 {
+				t.Fatalf("expected no entity to be spawned, got %d", n)
+			}
+		})
+	}
+}
+
+func TestEngine_DamageLowersHealth(t *testing.T) {
+	e := New(Config{MaxCommandsPerTick: 10})
+	worldID := "world-1"
+	damage := func(id, entityID string, amount int) *enginev1.Command {
+		data, _ := json.Marshal(map[string]any{"entityId": entityID, "amount": amount})
+		return &enginev1.Command{CommandId: id, Payload: &enginev1.Command_Opaque{Opaque: &enginev1.OpaqueCommand{Type: CommandTypeDamage, Data: data}}}
+	}
+	if err := ValidateCommand(damage("bad", "e1", 0)); err == nil {
+		t.Fatalf("expected non-positive damage to be invalid")
+	}
+
+	cmds := []*enginev1.Command{
+		{CommandId: "spawn-e1", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{
+			EntityId:   "e1",
+			Components: []*enginev1.Component{{Payload: &enginev1.Component_Health{Health: &enginev1.HealthComponent{Current: 10, Max: 10}}}},
+		}}},
+		{CommandId: "spawn-e2", Payload: &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: "e2"}}},
+	}
+	for _, cmd := range cmds {
+		if _, err := e.EnqueueCommand(worldID, cmd, false, 0); err != nil {
+			t.Fatalf("enqueue %s: %v", cmd.GetCommandId(), err)
+		}
+	}
+	if _, _, err := e.Tick(worldID, 0, 0); err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+	for _, cmd := range []*enginev1.Command{damage("d1", "e1", 4), damage("d2", "e1", 50), damage("d3", "e2", 1)} {
+		if _, err := e.EnqueueCommand(worldID, cmd, false, 0); err != nil {
+			t.Fatalf("enqueue %s: %v", cmd.GetCommandId(), err)
+		}
+	}
+	_, events, err := e.Tick(worldID, 0, 0)
+	if err != nil {
+		t.Fatalf("tick: %v", err)
+	}
+
+	var rejected []string
+	for _, ev := range events {
+		if r := ev.GetCommandRejected(); r != nil {
+			rejected = append(rejected, r.GetCommandId())
+		}
+	}
+	if fmt.Sprint(rejected) != "[d3]" {
+		t.Fatalf("expected only damage to the entity without health to be rejected, got %v", rejected)
+	}
+	snap, err := e.Snapshot(worldID, nil, []string{"e1"}, true)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	var health *enginev1.HealthComponent
+	for _, c := range snap.Entities[0].GetComponents() {
+		if h := c.GetHealth(); h != nil {
+			health = h
+		}
+	}
+	if health.GetCurrent() != 0 || health.GetMax() != 10 {
+		t.Fatalf("expected health to bottom out at 0/10, got %v", health)
+	}
+}
@@ -0,0 +1,126 @@
+package physics
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+)
+
+// ScriptStep is one step of a Harness script: Commands are enqueued in order,
+// then the world advances by Ticks ticks (at least one).
+type ScriptStep struct {
+	Commands []*enginev1.Command
+	Ticks    int64
+}
+
+// Harness drives an Engine in-process, without a gRPC listener, and records
+// every event the world emits. Worlds are seeded by id, so the same script
+// against the same world id always produces the same event stream, which makes
+// the output suitable for golden-file tests.
+type Harness struct {
+	Engine  *Engine
+	WorldID string
+
+	tick   int64
+	events []*enginev1.Event
+}
+
+// NewHarness creates an engine with cfg and initializes worldID with the given
+// per-world config overrides (see the ConfigKey constants).
+func NewHarness(cfg Config, worldID string, worldConfig map[string]string) (*Harness, error) {
+	e := New(cfg)
+	tick, err := e.InitializeWorld(worldID, false, worldConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &Harness{Engine: e, WorldID: worldID, tick: tick}, nil
+}
+
+// Enqueue queues cmds for the next tick, failing on the first rejected command.
+func (h *Harness) Enqueue(cmds ...*enginev1.Command) error {
+	for _, cmd := range cmds {
+		if _, err := h.Engine.EnqueueCommand(h.WorldID, cmd, false, 0); err != nil {
+			return fmt.Errorf("enqueue %q: %w", cmd.GetCommandId(), err)
+		}
+	}
+	return nil
+}
+
+// Advance steps the world forward n ticks (at least one), recording the events.
+// Calls are split as needed to stay within the engine's step cap.
+func (h *Harness) Advance(n int64) error {
+	if n < 1 {
+		n = 1
+	}
+	target := h.tick + n
+	for h.tick < target {
+		tick, events, err := h.Engine.Tick(h.WorldID, h.tick, target)
+		if err != nil {
+			return fmt.Errorf("tick to %d: %w", target, err)
+		}
+		h.tick = tick
+		h.events = append(h.events, events...)
+	}
+	return nil
+}
+
+// Run executes script in order.
+func (h *Harness) Run(script []ScriptStep) error {
+	for i, step := range script {
+		if err := h.Enqueue(step.Commands...); err != nil {
+			return fmt.Errorf("step %d: %w", i, err)
+		}
+		if err := h.Advance(step.Ticks); err != nil {
+			return fmt.Errorf("step %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Tick returns the world's current tick.
+func (h *Harness) Tick() int64 { return h.tick }
+
+// Events returns every event recorded so far, in emission order.
+func (h *Harness) Events() []*enginev1.Event { return h.events }
+
+// FormatEvents renders events one per line in a stable text form:
+//
+//	<tick> <type> <payload>
+//
+// Opaque JSON payloads are re-encoded with sorted keys; rejections are written
+// as their fields. Other opaque payloads are written as quoted strings.
+func FormatEvents(events []*enginev1.Event) string {
+	var b strings.Builder
+	for _, ev := range events {
+		fmt.Fprintf(&b, "%d %s %s\n", ev.GetTick(), ev.GetType(), formatEventPayload(ev))
+	}
+	return b.String()
+}
+
+func formatEventPayload(ev *enginev1.Event) string {
+	switch p := ev.GetPayload().(type) {
+	case *enginev1.Event_CommandRejected:
+		r := p.CommandRejected
+		return fmt.Sprintf("commandId=%s actorId=%s reason=%s code=%s message=%q",
+			r.GetCommandId(), r.GetActorId(), r.GetReason(), r.GetCode(), r.GetMessage())
+	case *enginev1.Event_Opaque:
+		var v any
+		dec := json.NewDecoder(bytes.NewReader(p.Opaque))
+		dec.UseNumber()
+		if err := dec.Decode(&v); err != nil {
+			return fmt.Sprintf("%q", p.Opaque)
+		}
+		// encoding/json sorts map keys, which makes the output independent of
+		// the order the engine built the payload in.
+		out, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%q", p.Opaque)
+		}
+		return string(out)
+	default:
+		return "-"
+	}
+}
@@ -0,0 +1,72 @@
+package physics
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite golden files in testdata")
+
+func TestHarness_SpawnMoveDespawnGolden(t *testing.T) {
+	h, err := NewHarness(Config{MaxCommandsPerTick: 2}, "golden-world", nil)
+	if err != nil {
+		t.Fatalf("NewHarness: %v", err)
+	}
+
+	spawn := func(id, entityID string) *enginev1.Command {
+		return &enginev1.Command{
+			CommandId: id,
+			ActorId:   "scripter",
+			Payload:   &enginev1.Command_SpawnEntity{SpawnEntity: &enginev1.SpawnEntityCommand{EntityId: entityID}},
+		}
+	}
+	move := func(id, entityID string, x float64) *enginev1.Command {
+		return &enginev1.Command{
+			CommandId: id,
+			ActorId:   "scripter",
+			Payload: &enginev1.Command_Move{Move: &enginev1.MoveCommand{
+				EntityId: entityID,
+				Position: &enginev1.Vec3{X: x},
+			}},
+		}
+	}
+	despawn := func(id, entityID string) *enginev1.Command {
+		return &enginev1.Command{
+			CommandId: id,
+			ActorId:   "scripter",
+			Payload:   &enginev1.Command_DespawnEntity{DespawnEntity: &enginev1.DespawnEntityCommand{EntityId: entityID}},
+		}
+	}
+
+	script := []ScriptStep{
+		// Three spawns with a per-tick cap of two: the auto-id spawn spills into tick 2.
+		{Commands: []*enginev1.Command{spawn("c1", "ship-1"), spawn("c2", "ship-1"), spawn("c3", "")}, Ticks: 2},
+		{Commands: []*enginev1.Command{move("c4", "ship-1", 10), move("c5", "ghost", 1)}},
+		{Commands: []*enginev1.Command{despawn("c6", "ship-1"), move("c7", "ship-1", 20)}, Ticks: 3},
+	}
+	if err := h.Run(script); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if h.Tick() != 6 {
+		t.Fatalf("expected tick 6, got %d", h.Tick())
+	}
+
+	got := FormatEvents(h.Events())
+	golden := filepath.Join("testdata", "harness_spawn_move_despawn.golden")
+	if *updateGolden {
+		if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+			t.Fatalf("write golden: %v", err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("read golden (run with -update to create it): %v", err)
+	}
+	if got != string(want) {
+		t.Fatalf("event stream differs from %s (run with -update to accept):\n--- got ---\n%s--- want ---\n%s", golden, got, want)
+	}
+}
@@ -0,0 +1,55 @@
+package physics
+
+import (
+	"sort"
+	"time"
+)
+
+// touch records now as the last time a client used w.
+func (w *world) touch(now time.Time) {
+	w.lastUsed.Store(now.UnixNano())
+}
+
+// EvictIdleWorlds drops every world unused for at least Config.WorldIdleTTL and
+// returns their ids, sorted. Each evicted world's final state is passed to
+// Config.OnEvict, if set. It is a no-op when no TTL is configured.
+//
+// A later call for an evicted world id behaves as for any unknown world.
+func (e *Engine) EvictIdleWorlds() []string {
+	if e.idleTTL <= 0 {
+		return nil
+	}
+
+	e.mu.Lock()
+	cutoff := e.now().Add(-e.idleTTL).UnixNano()
+	evicted := make(map[string]*world)
+	for id, w := range e.worlds {
+		if w.lastUsed.Load() <= cutoff {
+			evicted[id] = w
+			delete(e.worlds, id)
+		}
+	}
+	e.mu.Unlock()
+
+	ids := make([]string, 0, len(evicted))
+	for id := range evicted {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		w := evicted[id]
+		if e.onEvict != nil {
+			// Snapshotting takes w.mu, so calls that resolved w before it was
+			// dropped finish first and their effects are included.
+			state, err := w.snapshot(id, nil, nil, nil, true)
+			if err != nil {
+				e.log.Debug("idle world snapshot failed", "world", id, "error", err.Error())
+			} else {
+				e.onEvict(id, state)
+			}
+		}
+		e.log.Info("evicted idle world", "world", id, "idleTTL", e.idleTTL)
+	}
+	return ids
+}
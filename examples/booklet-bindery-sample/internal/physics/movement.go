@@ -0,0 +1,128 @@
+package physics
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+
+	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+)
+
+// EventTypeArrived is emitted when an entity moved by a MoveTowardCommand reaches
+// its target.
+const EventTypeArrived = "physics.entity.arrived"
+
+// moveTarget is a pending MoveTowardCommand for one entity.
+type moveTarget struct {
+	target   *enginev1.Vec3
+	maxSpeed float64
+}
+
+// stepToward returns the point at most maxStep from pos along the straight line
+// to target, and whether that point is the target.
+func stepToward(pos, target *enginev1.Vec3, maxStep float64) (*enginev1.Vec3, bool) {
+	dx, dy, dz := target.GetX()-pos.GetX(), target.GetY()-pos.GetY(), target.GetZ()-pos.GetZ()
+	dist := math.Sqrt(dx*dx + dy*dy + dz*dz)
+	if dist <= maxStep {
+		return cloneVec3(target), true
+	}
+	f := maxStep / dist
+	return &enginev1.Vec3{X: pos.GetX() + dx*f, Y: pos.GetY() + dy*f, Z: pos.GetZ() + dz*f}, false
+}
+
+// advanceMovesLocked moves every entity with a pending target one step, in entity
+// id order, and returns an arrived event for each entity that reached its target.
+func (w *world) advanceMovesLocked(tick int64) []*enginev1.Event {
+	if len(w.moveTargets) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(w.moveTargets))
+	for id := range w.moveTargets {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return naturalLess(ids[i], ids[j]) })
+
+	var events []*enginev1.Event
+	for _, id := range ids {
+		mt := w.moveTargets[id]
+		e, ok := w.entities[id]
+		if !ok {
+			delete(w.moveTargets, id)
+			continue
+		}
+		next, arrived := stepToward(entityPosition(e), mt.target, mt.maxSpeed)
+		setEntityPosition(e, next)
+		if !arrived {
+			continue
+		}
+		delete(w.moveTargets, id)
+		b, _ := json.Marshal(map[string]any{
+			"entityId": id,
+			"tick":     tick,
+			"position": map[string]float64{"x": next.X, "y": next.Y, "z": next.Z},
+		})
+		events = append(events, &enginev1.Event{
+			Type:    EventTypeArrived,
+			Tick:    tick,
+			Payload: &enginev1.Event_Opaque{Opaque: b},
+		})
+	}
+	return events
+}
+
+// fixedScale is the number of fixed-point steps per world unit used by velocity
+// integration. Positions advance in whole micro-unit steps, so repeated ticks add
+// integers and never accumulate float rounding (ten ticks at 0.1 land exactly on 1).
+const fixedScale = 1_000_000
+
+// maxFixed bounds fixed-point values so converting them never overflows int64.
+const maxFixed = 1 << 62
+
+// toFixed converts world units to fixed-point steps, saturating out-of-range values.
+func toFixed(x float64) int64 {
+	f := math.Round(x * fixedScale)
+	switch {
+	case math.IsNaN(f):
+		return 0
+	case f > maxFixed:
+		return maxFixed
+	case f < -maxFixed:
+		return -maxFixed
+	}
+	return int64(f)
+}
+
+func fromFixed(f int64) float64 { return float64(f) / fixedScale }
+
+// integrateVelocityLocked advances each entity's position by its transform
+// velocity in fixed-point steps. Entities with a pending move target are left to
+// advanceMovesLocked.
+func (w *world) integrateVelocityLocked() {
+	for id, e := range w.entities {
+		if _, ok := w.moveTargets[id]; ok {
+			continue
+		}
+		for _, c := range e.GetComponents() {
+			t := c.GetTransform()
+			if t == nil {
+				continue
+			}
+			if v := t.GetVelocity(); v != nil && t.Position != nil {
+				t.Position.X = fromFixed(toFixed(t.Position.X) + toFixed(v.GetX()))
+				t.Position.Y = fromFixed(toFixed(t.Position.Y) + toFixed(v.GetY()))
+				t.Position.Z = fromFixed(toFixed(t.Position.Z) + toFixed(v.GetZ()))
+			}
+			break
+		}
+	}
+}
+
+// entityPosition returns the position of e's transform, or the origin if it has none.
+func entityPosition(e *enginev1.Entity) *enginev1.Vec3 {
+	for _, c := range e.GetComponents() {
+		if t := c.GetTransform(); t != nil && t.Position != nil {
+			return t.Position
+		}
+	}
+	return &enginev1.Vec3{}
+}
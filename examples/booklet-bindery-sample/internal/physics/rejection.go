@@ -0,0 +1,43 @@
+package physics
+
+import (
+	"errors"
+	"fmt"
+
+	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+)
+
+// commandError is a command failure with a machine-readable reason and status code.
+type commandError struct {
+	reason enginev1.CommandRejectionReason
+	code   enginev1.StatusCode
+	msg    string
+}
+
+func (e *commandError) Error() string { return e.msg }
+
+func rejectf(reason enginev1.CommandRejectionReason, code enginev1.StatusCode, format string, args ...any) error {
+	return &commandError{reason: reason, code: code, msg: fmt.Sprintf(format, args...)}
+}
+
+// rejectionEvent builds the structured physics.command.error event for a command
+// that failed to apply. Errors without a classification are reported as internal.
+func rejectionEvent(cmd *enginev1.Command, tick int64, err error) *enginev1.Event {
+	rejected := &enginev1.CommandRejectedEvent{
+		CommandId: cmd.GetCommandId(),
+		ActorId:   cmd.GetActorId(),
+		Reason:    enginev1.CommandRejectionReason_COMMAND_REJECTION_REASON_UNSPECIFIED,
+		Code:      enginev1.StatusCode_STATUS_CODE_INTERNAL,
+		Message:   err.Error(),
+	}
+	var ce *commandError
+	if errors.As(err, &ce) {
+		rejected.Reason = ce.reason
+		rejected.Code = ce.code
+	}
+	return &enginev1.Event{
+		Type:    "physics.command.error",
+		Tick:    tick,
+		Payload: &enginev1.Event_CommandRejected{CommandRejected: rejected},
+	}
+}
@@ -0,0 +1,90 @@
+package physics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+)
+
+// MetadataKeyTags is the entity metadata key holding the entity's tags, sorted
+// and comma-separated. Tags live in metadata so snapshots round-trip them
+// without a dedicated component.
+const MetadataKeyTags = "tags"
+
+// validateTags rejects empty tags and tags containing the metadata separator.
+func validateTags(field string, tags []string) error {
+	for _, t := range tags {
+		if strings.TrimSpace(t) == "" {
+			return fmt.Errorf("%s contains an empty tag", field)
+		}
+		if strings.Contains(t, ",") {
+			return fmt.Errorf("%s: tag %q must not contain a comma", field, t)
+		}
+	}
+	return nil
+}
+
+// entityTags returns the set of tags stored on e.
+func entityTags(e *enginev1.Entity) map[string]struct{} {
+	raw := e.GetMetadata()[MetadataKeyTags]
+	if raw == "" {
+		return map[string]struct{}{}
+	}
+	parts := strings.Split(raw, ",")
+	set := make(map[string]struct{}, len(parts))
+	for _, t := range parts {
+		set[t] = struct{}{}
+	}
+	return set
+}
+
+// setEntityTags stores set on e, dropping the metadata key when it is empty.
+func setEntityTags(e *enginev1.Entity, set map[string]struct{}) {
+	if len(set) == 0 {
+		delete(e.Metadata, MetadataKeyTags)
+		return
+	}
+	tags := make([]string, 0, len(set))
+	for t := range set {
+		tags = append(tags, t)
+	}
+	sort.Strings(tags)
+	if e.Metadata == nil {
+		e.Metadata = map[string]string{}
+	}
+	e.Metadata[MetadataKeyTags] = strings.Join(tags, ",")
+}
+
+// tagEntity adds tags to e.
+func tagEntity(e *enginev1.Entity, tags []string) {
+	set := entityTags(e)
+	for _, t := range tags {
+		set[strings.TrimSpace(t)] = struct{}{}
+	}
+	setEntityTags(e, set)
+}
+
+// untagEntity removes tags from e.
+func untagEntity(e *enginev1.Entity, tags []string) {
+	set := entityTags(e)
+	for _, t := range tags {
+		delete(set, strings.TrimSpace(t))
+	}
+	setEntityTags(e, set)
+}
+
+// hasAllTags reports whether e carries every tag in want.
+func hasAllTags(e *enginev1.Entity, want []string) bool {
+	if len(want) == 0 {
+		return true
+	}
+	set := entityTags(e)
+	for _, t := range want {
+		if _, ok := set[strings.TrimSpace(t)]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+package moduleserver
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"strings"
+)
+
+// EnvPprofAddr enables the profiling server when set to a listen address
+// such as "localhost:6060".
+const EnvPprofAddr = "BINDERY_PPROF_ADDR"
+
+// PprofHandler serves the net/http/pprof handlers under /debug/pprof/ on its own
+// mux, so enabling it never exposes them on another HTTP server.
+func PprofHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	return mux
+}
+
+// StartPprofFromEnv serves PprofHandler on $BINDERY_PPROF_ADDR in the background
+// and returns the server and its bound address. It returns a nil server when the
+// variable is unset or empty.
+func StartPprofFromEnv() (*http.Server, net.Addr, error) {
+	addr := strings.TrimSpace(os.Getenv(EnvPprofAddr))
+	if addr == "" {
+		return nil, nil, nil
+	}
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("pprof: listen %s: %w", addr, err)
+	}
+	srv := &http.Server{Handler: PprofHandler()}
+	go func() { _ = srv.Serve(lis) }()
+	return srv, lis.Addr(), nil
+}
@@ -0,0 +1,35 @@
+package moduleserver
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestStartPprofFromEnv_DisabledWhenUnset(t *testing.T) {
+	t.Setenv(EnvPprofAddr, "")
+	srv, addr, err := StartPprofFromEnv()
+	if err != nil || srv != nil || addr != nil {
+		t.Fatalf("expected no server, got %v %v %v", srv, addr, err)
+	}
+}
+
+func TestStartPprofFromEnv_ServesIndex(t *testing.T) {
+	t.Setenv(EnvPprofAddr, "127.0.0.1:0")
+	srv, addr, err := StartPprofFromEnv()
+	if err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	defer srv.Close()
+
+	resp, err := http.Get("http://" + addr.String() + "/debug/pprof/")
+	if err != nil {
+		t.Fatalf("get index: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(body), "goroutine") {
+		t.Fatalf("unexpected index response %d: %s", resp.StatusCode, body)
+	}
+}
@@ -0,0 +1,65 @@
+package moduleserver
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Environment variables the RuntimeOrchestrator sets when a module declares
+// spec.runtime.tls. They point at files inside the mounted certificate Secret.
+const (
+	EnvTLSCertFile     = "BINDERY_TLS_CERT_FILE"
+	EnvTLSKeyFile      = "BINDERY_TLS_KEY_FILE"
+	EnvTLSClientCAFile = "BINDERY_TLS_CLIENT_CA_FILE"
+)
+
+// LoadServerTLSConfig builds a server TLS config from a PEM certificate and key.
+//
+// When clientCAFile is non-empty, clients must present a certificate signed by one
+// of the CAs in that file (mutual TLS).
+func LoadServerTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("tls: both certificate and key files are required")
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("tls: load key pair: %w", err)
+	}
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAFile != "" {
+		pem, err := os.ReadFile(clientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("tls: read client CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("tls: no certificates found in %s", clientCAFile)
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return cfg, nil
+}
+
+// TLSServerOptionsFromEnv returns the gRPC server options that enable TLS when
+// BINDERY_TLS_CERT_FILE is set, or nil to serve plaintext.
+func TLSServerOptionsFromEnv() ([]grpc.ServerOption, error) {
+	certFile := os.Getenv(EnvTLSCertFile)
+	if certFile == "" {
+		return nil, nil
+	}
+	cfg, err := LoadServerTLSConfig(certFile, os.Getenv(EnvTLSKeyFile), os.Getenv(EnvTLSClientCAFile))
+	if err != nil {
+		return nil, err
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(cfg))}, nil
+}
@@ -0,0 +1,123 @@
+package moduleserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeSelfSignedCert writes a self-signed certificate and key to dir and returns their paths.
+func writeSelfSignedCert(t *testing.T, dir string) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "bindery-test"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("write cert: %v", err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+	return certFile, keyFile
+}
+
+func TestLoadServerTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeSelfSignedCert(t, dir)
+
+	t.Run("valid", func(t *testing.T) {
+		cfg, err := LoadServerTLSConfig(certFile, keyFile, "")
+		if err != nil {
+			t.Fatalf("LoadServerTLSConfig: %v", err)
+		}
+		if len(cfg.Certificates) != 1 {
+			t.Fatalf("expected 1 certificate, got %d", len(cfg.Certificates))
+		}
+		if cfg.ClientAuth != tls.NoClientCert {
+			t.Fatalf("expected no client auth without a CA, got %v", cfg.ClientAuth)
+		}
+	})
+
+	t.Run("mutual", func(t *testing.T) {
+		// The self-signed cert doubles as the client CA.
+		cfg, err := LoadServerTLSConfig(certFile, keyFile, certFile)
+		if err != nil {
+			t.Fatalf("LoadServerTLSConfig: %v", err)
+		}
+		if cfg.ClientAuth != tls.RequireAndVerifyClientCert || cfg.ClientCAs == nil {
+			t.Fatalf("expected client certs to be required, got %v", cfg.ClientAuth)
+		}
+	})
+
+	t.Run("missing cert", func(t *testing.T) {
+		if _, err := LoadServerTLSConfig(filepath.Join(dir, "absent.crt"), keyFile, ""); err == nil {
+			t.Fatalf("expected error for missing certificate file")
+		}
+		if _, err := LoadServerTLSConfig("", keyFile, ""); err == nil {
+			t.Fatalf("expected error for empty certificate path")
+		}
+	})
+
+	t.Run("bad client CA", func(t *testing.T) {
+		if _, err := LoadServerTLSConfig(certFile, keyFile, keyFile); err == nil {
+			t.Fatalf("expected error when the client CA file holds no certificates")
+		}
+	})
+}
+
+func TestTLSServerOptionsFromEnv(t *testing.T) {
+	certFile, keyFile := writeSelfSignedCert(t, t.TempDir())
+
+	t.Setenv(EnvTLSCertFile, "")
+	opts, err := TLSServerOptionsFromEnv()
+	if err != nil || opts != nil {
+		t.Fatalf("expected plaintext with no cert configured, got opts=%v err=%v", opts, err)
+	}
+
+	t.Setenv(EnvTLSCertFile, certFile)
+	t.Setenv(EnvTLSKeyFile, keyFile)
+	opts, err = TLSServerOptionsFromEnv()
+	if err != nil {
+		t.Fatalf("TLSServerOptionsFromEnv: %v", err)
+	}
+	if len(opts) != 1 {
+		t.Fatalf("expected 1 server option, got %d", len(opts))
+	}
+
+	t.Setenv(EnvTLSKeyFile, "")
+	if _, err := TLSServerOptionsFromEnv(); err == nil {
+		t.Fatalf("expected error when the key file is unset")
+	}
+}
@@ -0,0 +1,53 @@
+package resolver
+
+// capabilityAliases maps capability ids onto one canonical id per equivalence class.
+//
+// Input.CapabilityAliases entries are undirected: {"physics.core": "physics.engine"}
+// lets a physics.core consumer bind a physics.engine provider and the reverse. Ids that
+// never appear in the table only match themselves.
+type capabilityAliases map[string]string
+
+func newCapabilityAliases(table map[string]string) capabilityAliases {
+	if len(table) == 0 {
+		return nil
+	}
+	// Union-find over the listed ids; the lexically smallest id names each class so the
+	// result does not depend on map iteration order.
+	parent := map[string]string{}
+	var find func(string) string
+	find = func(id string) string {
+		p, ok := parent[id]
+		if !ok || p == id {
+			parent[id] = id
+			return id
+		}
+		root := find(p)
+		parent[id] = root
+		return root
+	}
+	for a, b := range table {
+		if a == "" || b == "" {
+			continue
+		}
+		ra, rb := find(a), find(b)
+		switch {
+		case ra < rb:
+			parent[rb] = ra
+		case rb < ra:
+			parent[ra] = rb
+		}
+	}
+	out := make(capabilityAliases, len(parent))
+	for id := range parent {
+		out[id] = find(id)
+	}
+	return out
+}
+
+// canonical returns the id capabilityID is matched under.
+func (a capabilityAliases) canonical(capabilityID string) string {
+	if c, ok := a[capabilityID]; ok {
+		return c
+	}
+	return capabilityID
+}
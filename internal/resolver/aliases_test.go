@@ -0,0 +1,84 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+
+	binderyv1alpha1 "github.com/bayleafwalker/bindery-core/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func aliasInput(requiredID string, aliases map[string]string) Input {
+	return Input{
+		World: binderyv1alpha1.WorldInstance{ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"}},
+		Modules: []binderyv1alpha1.ModuleManifest{
+			mm("physics", []binderyv1alpha1.ProvidedCapability{{
+				CapabilityID: "physics.engine",
+				Version:      "1.0.0",
+				Scope:        binderyv1alpha1.CapabilityScopeWorld,
+				Multiplicity: binderyv1alpha1.MultiplicityOne,
+			}}, nil),
+			mm("legacy-consumer", nil, []binderyv1alpha1.RequiredCapability{{
+				CapabilityID:      requiredID,
+				VersionConstraint: ">=1.0.0",
+				Scope:             binderyv1alpha1.CapabilityScopeWorld,
+				Multiplicity:      binderyv1alpha1.MultiplicityOne,
+				DependencyMode:    binderyv1alpha1.DependencyModeRequired,
+			}}),
+		},
+		CapabilityAliases: aliases,
+	}
+}
+
+func TestDefaultResolver_MatchesAliasedCapability(t *testing.T) {
+	// The alias is declared provider->consumer and consumer->provider; both directions must match.
+	for name, aliases := range map[string]map[string]string{
+		"old to new": {"physics.core": "physics.engine"},
+		"new to old": {"physics.engine": "physics.core"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			plan, err := NewDefault().Resolve(context.Background(), aliasInput("physics.core", aliases))
+			if err != nil {
+				t.Fatalf("Resolve error: %v", err)
+			}
+			if len(plan.Diagnostics.UnresolvedRequired) != 0 {
+				t.Fatalf("expected alias to resolve, got: %+v", plan.Diagnostics.UnresolvedRequired)
+			}
+			var b *binderyv1alpha1.CapabilityBindingSpec
+			for i := range plan.DesiredBindings {
+				if plan.DesiredBindings[i].Spec.Consumer.ModuleManifestName == "legacy-consumer" {
+					b = &plan.DesiredBindings[i].Spec
+				}
+			}
+			if b == nil || b.Provider.ModuleManifestName != "physics" {
+				t.Fatalf("expected legacy-consumer bound to physics, got %+v", b)
+			}
+			if b.CapabilityID != "physics.core" {
+				t.Fatalf("expected binding to keep the consumer's capability id, got %q", b.CapabilityID)
+			}
+		})
+	}
+}
+
+func TestDefaultResolver_AliasesDoNotMatchUnrelatedIDs(t *testing.T) {
+	aliases := map[string]string{"physics.core": "physics.engine"}
+	for _, requiredID := range []string{"physics.legacy", "audio.core"} {
+		plan, err := NewDefault().Resolve(context.Background(), aliasInput(requiredID, aliases))
+		if err != nil {
+			t.Fatalf("Resolve error: %v", err)
+		}
+		if len(plan.Diagnostics.UnresolvedRequired) != 1 {
+			t.Fatalf("expected %s to stay unresolved, got %+v", requiredID, plan.Diagnostics.UnresolvedRequired)
+		}
+	}
+}
+
+func TestNewCapabilityAliases_ChainsShareCanonicalID(t *testing.T) {
+	a := newCapabilityAliases(map[string]string{"physics.v1": "physics.core", "physics.core": "physics.engine"})
+	if a.canonical("physics.v1") != a.canonical("physics.engine") {
+		t.Fatalf("expected chained aliases to share a canonical id: %v", a)
+	}
+	if got := a.canonical("unrelated"); got != "unrelated" {
+		t.Fatalf("expected unknown ids to map to themselves, got %q", got)
+	}
+}
@@ -0,0 +1,48 @@
+package resolver
+
+import (
+	binderyv1alpha1 "github.com/bayleafwalker/bindery-core/api/v1alpha1"
+)
+
+// RequirementCheck is the outcome of checking one requirement. Err wraps
+// ErrInvalidVersionConstraint when the requirement's constraint does not parse.
+type RequirementCheck struct {
+	binderyv1alpha1.RequirementStatus
+	Err error
+}
+
+// CheckRequirements reports, for each of consumer's requirements, whether the modules
+// in in (Modules, ExternalModules, and RealmModules for realmRef, with CapabilityAliases)
+// provide a compatible capability.
+//
+// Matching is Resolve's, and the reported provider is the one Resolve would select
+// (non-deprecated first, then highest version, then module name).
+func CheckRequirements(consumer binderyv1alpha1.ModuleManifest, in Input) []RequirementCheck {
+	if len(consumer.Spec.Requires) == 0 {
+		return nil
+	}
+
+	m := newMatcher(in)
+	out := make([]RequirementCheck, 0, len(consumer.Spec.Requires))
+	for _, req := range consumer.Spec.Requires {
+		rawConstraint, candidates, reason, err := m.match(req)
+		c := RequirementCheck{
+			RequirementStatus: binderyv1alpha1.RequirementStatus{
+				CapabilityID:      req.CapabilityID,
+				VersionConstraint: rawConstraint,
+				Scope:             req.Scope,
+				DependencyMode:    req.DependencyMode,
+				Reason:            reason,
+			},
+			Err: err,
+		}
+		if err == nil && len(candidates) > 0 {
+			best := selectProvidersDeterministic(binderyv1alpha1.MultiplicityOne, candidates)[0]
+			c.Satisfied = true
+			c.Provider = best.moduleName
+			c.ProviderVersion = best.versionRaw
+		}
+		out = append(out, c)
+	}
+	return out
+}
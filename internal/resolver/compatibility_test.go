@@ -0,0 +1,106 @@
+package resolver
+
+import (
+	"errors"
+	"testing"
+
+	binderyv1alpha1 "github.com/bayleafwalker/bindery-core/api/v1alpha1"
+)
+
+func TestCheckRequirements_ReportsPerRequirement(t *testing.T) {
+	physics := mm("physics", []binderyv1alpha1.ProvidedCapability{{
+		CapabilityID: "cap.physics",
+		Version:      "1.2.0",
+		Scope:        binderyv1alpha1.CapabilityScopeWorld,
+		Multiplicity: binderyv1alpha1.MultiplicityOne,
+	}}, nil)
+	consumer := mm("interaction", nil, []binderyv1alpha1.RequiredCapability{
+		{
+			CapabilityID:      "cap.physics",
+			VersionConstraint: "^1.0.0",
+			Scope:             binderyv1alpha1.CapabilityScopeWorld,
+			DependencyMode:    binderyv1alpha1.DependencyModeRequired,
+		},
+		{
+			CapabilityID:      "cap.physics",
+			VersionConstraint: ">=2.0.0",
+			Scope:             binderyv1alpha1.CapabilityScopeWorld,
+			DependencyMode:    binderyv1alpha1.DependencyModeOptional,
+		},
+		{
+			CapabilityID:      "cap.audio",
+			VersionConstraint: "not-a-constraint",
+			Scope:             binderyv1alpha1.CapabilityScopeWorld,
+			DependencyMode:    binderyv1alpha1.DependencyModeRequired,
+		},
+	})
+
+	got := CheckRequirements(consumer, Input{Modules: []binderyv1alpha1.ModuleManifest{physics, consumer}})
+	if len(got) != 3 {
+		t.Fatalf("expected 3 requirement statuses, got %d", len(got))
+	}
+	if !got[0].Satisfied || got[0].Provider != "physics" || got[0].ProviderVersion != "1.2.0" {
+		t.Fatalf("expected first requirement satisfied by physics@1.2.0, got %+v", got[0])
+	}
+	if got[1].Satisfied || got[1].Reason != "no compatible provider found" {
+		t.Fatalf("expected second requirement unsatisfied by version, got %+v", got[1])
+	}
+	if got[2].Satisfied || !errors.Is(got[2].Err, ErrInvalidVersionConstraint) {
+		t.Fatalf("expected third requirement to report invalid constraint, got %+v", got[2])
+	}
+}
+
+func TestCheckRequirements_MatchesLikeResolve(t *testing.T) {
+	physics := mm("physics", []binderyv1alpha1.ProvidedCapability{{
+		CapabilityID: "physics.engine",
+		Version:      "1.0.0",
+		Scope:        binderyv1alpha1.CapabilityScopeWorld,
+		Multiplicity: binderyv1alpha1.MultiplicityOne,
+	}}, nil)
+	audio := mm("audio", []binderyv1alpha1.ProvidedCapability{{
+		CapabilityID: "audio.mixer",
+		Version:      "1.0.0",
+		Scope:        binderyv1alpha1.CapabilityScopeWorld,
+		Multiplicity: binderyv1alpha1.MultiplicityOne,
+	}}, nil)
+	consumer := mm("interaction", nil, []binderyv1alpha1.RequiredCapability{
+		{
+			CapabilityID:      "physics.core",
+			VersionConstraint: "^1.0.0",
+			Scope:             binderyv1alpha1.CapabilityScopeWorld,
+			DependencyMode:    binderyv1alpha1.DependencyModeRequired,
+		},
+		{
+			CapabilityID:      "audio.mixer",
+			VersionConstraint: "^1.0.0",
+			Scope:             binderyv1alpha1.CapabilityScopeWorld,
+			DependencyMode:    binderyv1alpha1.DependencyModeRequired,
+			RealmRef:          &binderyv1alpha1.ObjectRef{Name: "shared"},
+		},
+		{
+			CapabilityID:      "physics.engine",
+			VersionConstraint: "^1.0.0",
+			Scope:             binderyv1alpha1.CapabilityScopeWorld,
+			DependencyMode:    binderyv1alpha1.DependencyModeRequired,
+			RealmRef:          &binderyv1alpha1.ObjectRef{Name: "shared"},
+		},
+	})
+
+	got := CheckRequirements(consumer, Input{
+		Modules:           []binderyv1alpha1.ModuleManifest{physics, consumer},
+		RealmModules:      map[string][]binderyv1alpha1.ModuleManifest{"shared": {audio}},
+		CapabilityAliases: map[string]string{"physics.core": "physics.engine"},
+	})
+	if len(got) != 3 {
+		t.Fatalf("expected 3 requirement statuses, got %d", len(got))
+	}
+	if !got[0].Satisfied || got[0].Provider != "physics" || got[0].CapabilityID != "physics.core" {
+		t.Fatalf("expected aliased requirement satisfied by physics, got %+v", got[0])
+	}
+	if !got[1].Satisfied || got[1].Provider != "audio" {
+		t.Fatalf("expected realmRef requirement satisfied by the realm's audio, got %+v", got[1])
+	}
+	if got[2].Satisfied || got[2].Err != nil {
+		t.Fatalf("expected realmRef requirement to ignore providers outside the realm, got %+v", got[2])
+	}
+}
@@ -2,6 +2,7 @@ package resolver
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"strings"
 
@@ -21,6 +22,9 @@ type provider struct {
 	version      semver.Version
 	scope        binderyv1alpha1.CapabilityScope
 	multiplicity binderyv1alpha1.CapabilityMultiplicity
+	deprecated   bool
+	// realm names the Realm that contributed the provider, if any.
+	realm string
 }
 
 func NewDefault() *DefaultResolver {
@@ -30,80 +34,32 @@ func NewDefault() *DefaultResolver {
 func (r *DefaultResolver) Resolve(ctx context.Context, in Input) (Plan, error) {
 	_ = ctx
 
-	providers := make([]provider, 0)
-	// Helper to add providers from a list of modules
-	addProviders := func(modules []binderyv1alpha1.ModuleManifest) {
-		for _, module := range modules {
-			for _, provided := range module.Spec.Provides {
-				v, err := semver.ParseVersion(strings.TrimSpace(provided.Version))
-				if err != nil {
-					continue
-				}
-				providers = append(providers, provider{
-					moduleName:   module.Name,
-					capabilityID: provided.CapabilityID,
-					versionRaw:   strings.TrimSpace(provided.Version),
-					version:      v,
-					scope:        provided.Scope,
-					multiplicity: provided.Multiplicity,
-				})
-			}
-		}
-	}
-
-	addProviders(in.Modules)
-	addProviders(in.ExternalModules)
-
+	m := newMatcher(in)
 	plan := Plan{}
 
 	for _, consumer := range in.Modules {
 		for _, req := range consumer.Spec.Requires {
-			rawConstraint := strings.TrimSpace(req.VersionConstraint)
-			if rawConstraint == "" {
-				rawConstraint = "*"
-			}
-
-			constraint, err := semver.ParseConstraint(rawConstraint)
-			if err != nil {
-				addUnresolved(&plan.Diagnostics, consumer.Name, req, "invalid versionConstraint")
+			rawConstraint, candidates, reason, err := m.match(req)
+			if err != nil || len(candidates) == 0 {
+				addUnresolved(&plan.Diagnostics, consumer.Name, req, reason)
 				continue
 			}
 
-			candidates := make([]provider, 0)
-			for _, p := range providers {
-				if p.capabilityID != req.CapabilityID {
-					continue
-				}
-				if p.scope != req.Scope {
-					continue
-				}
-				reqMultiplicity := req.Multiplicity
-				if strings.TrimSpace(string(reqMultiplicity)) == "" {
-					reqMultiplicity = binderyv1alpha1.MultiplicityOne
-				}
-				providerMultiplicity := p.multiplicity
-				if strings.TrimSpace(string(providerMultiplicity)) == "" {
-					providerMultiplicity = binderyv1alpha1.MultiplicityOne
-				}
-				// Multiplicity compatibility matrix:
-				// - require "1"   -> provider "1" or "many"
-				// - require "many"-> provider must be "many"
-				if reqMultiplicity == binderyv1alpha1.MultiplicityMany && providerMultiplicity != binderyv1alpha1.MultiplicityMany {
-					continue
-				}
-				if !semver.Satisfies(p.version, constraint) {
-					continue
+			selected := selectProvidersDeterministic(req.Multiplicity, candidates)
+			if in.PreferExisting && req.Multiplicity != binderyv1alpha1.MultiplicityMany {
+				if bound, ok := boundProvider(in.ExistingBindings, consumer.Name, req, candidates); ok && (!bound.deprecated || selected[0].deprecated) {
+					selected = []provider{bound}
 				}
-				candidates = append(candidates, p)
-			}
-
-			if len(candidates) == 0 {
-				addUnresolved(&plan.Diagnostics, consumer.Name, req, "no compatible provider found")
-				continue
 			}
-
-			selected := selectProvidersDeterministic(req.Multiplicity, candidates)
 			for _, p := range selected {
+				if p.deprecated {
+					plan.Diagnostics.DeprecatedSelected = append(plan.Diagnostics.DeprecatedSelected, DeprecatedSelection{
+						ConsumerModuleManifestName: consumer.Name,
+						CapabilityID:               req.CapabilityID,
+						ProviderModuleManifestName: p.moduleName,
+						ProviderVersion:            p.versionRaw,
+					})
+				}
 				plan.DesiredBindings = append(plan.DesiredBindings, binderyv1alpha1.CapabilityBinding{
 					Spec: binderyv1alpha1.CapabilityBindingSpec{
 						CapabilityID: req.CapabilityID,
@@ -178,6 +134,193 @@ func (r *DefaultResolver) Resolve(ctx context.Context, in Input) (Plan, error) {
 	return plan, nil
 }
 
+// matcher finds the providers a requirement can bind, applying capability aliases and
+// realmRef selection. Resolve and CheckRequirements share it so they agree.
+type matcher struct {
+	aliases capabilityAliases
+	// general serves requirements without realmRef; all adds every Realm's providers,
+	// which are only candidates for the requirements that select their Realm.
+	general []provider
+	all     []provider
+}
+
+func newMatcher(in Input) matcher {
+	m := matcher{aliases: newCapabilityAliases(in.CapabilityAliases)}
+	m.general = collectProviders(in.Modules, in.ExternalModules)
+	realm := collectRealmProviders(in.RealmModules)
+	for _, ps := range [][]provider{m.general, realm} {
+		for i := range ps {
+			ps[i].capabilityID = m.aliases.canonical(ps[i].capabilityID)
+		}
+	}
+	m.all = append(append([]provider(nil), m.general...), realm...)
+	return m
+}
+
+// match returns req's normalized constraint and compatible providers. When there are
+// none, reason explains why; err wraps ErrInvalidVersionConstraint if the constraint
+// does not parse.
+func (m matcher) match(req binderyv1alpha1.RequiredCapability) (rawConstraint string, candidates []provider, reason string, err error) {
+	canonical := req
+	canonical.CapabilityID = m.aliases.canonical(req.CapabilityID)
+	pool := m.general
+	if req.RealmRef != nil && req.RealmRef.Name != "" {
+		pool = providersFromRealm(m.all, req.RealmRef.Name)
+	}
+	rawConstraint, candidates, err = compatibleProviders(canonical, pool)
+	if err != nil {
+		return rawConstraint, nil, ErrInvalidVersionConstraint.Error(), fmt.Errorf("%w %q: %v", ErrInvalidVersionConstraint, rawConstraint, err)
+	}
+	if len(candidates) == 0 {
+		reason = realmAwareNoProviderReason(canonical, m.all, pool)
+	}
+	return rawConstraint, candidates, reason, nil
+}
+
+// collectProviders flattens the provided capabilities of the given module sets.
+// Capabilities with an unparseable version are skipped.
+func collectProviders(sets ...[]binderyv1alpha1.ModuleManifest) []provider {
+	providers := make([]provider, 0)
+	for _, modules := range sets {
+		for _, module := range modules {
+			for _, provided := range module.Spec.Provides {
+				v, err := semver.ParseVersion(strings.TrimSpace(provided.Version))
+				if err != nil {
+					continue
+				}
+				providers = append(providers, provider{
+					moduleName:   module.Name,
+					capabilityID: provided.CapabilityID,
+					versionRaw:   strings.TrimSpace(provided.Version),
+					version:      v,
+					scope:        provided.Scope,
+					multiplicity: provided.Multiplicity,
+					deprecated:   provided.Deprecated,
+				})
+			}
+		}
+	}
+	return providers
+}
+
+// collectRealmProviders flattens Input.RealmModules, tagging each provider with its Realm.
+// Realms are visited in name order so the result does not depend on map iteration.
+func collectRealmProviders(realms map[string][]binderyv1alpha1.ModuleManifest) []provider {
+	names := make([]string, 0, len(realms))
+	for name := range realms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var out []provider
+	for _, name := range names {
+		ps := collectProviders(realms[name])
+		for i := range ps {
+			ps[i].realm = name
+		}
+		out = append(out, ps...)
+	}
+	return out
+}
+
+// providersFromRealm returns the providers contributed by the named Realm.
+func providersFromRealm(providers []provider, realm string) []provider {
+	out := make([]provider, 0)
+	for _, p := range providers {
+		if p.realm == realm {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// realmAwareNoProviderReason explains an empty candidate set for req. When req selects a
+// Realm, it says whether the selector itself is what excluded otherwise compatible providers.
+func realmAwareNoProviderReason(req binderyv1alpha1.RequiredCapability, all, pool []provider) string {
+	if req.RealmRef == nil || req.RealmRef.Name == "" {
+		return noProviderReason(req, pool)
+	}
+	if _, candidates, err := compatibleProviders(req, all); err == nil && len(candidates) > 0 {
+		return fmt.Sprintf("realmRef %q matches no compatible provider", req.RealmRef.Name)
+	}
+	return noProviderReason(req, pool)
+}
+
+// compatibleProviders returns the normalized constraint for req and the providers that satisfy
+// its capability, scope, multiplicity, and version constraint.
+func compatibleProviders(req binderyv1alpha1.RequiredCapability, providers []provider) (string, []provider, error) {
+	rawConstraint := strings.TrimSpace(req.VersionConstraint)
+	if rawConstraint == "" {
+		rawConstraint = "*"
+	}
+
+	constraint, err := semver.ParseConstraint(rawConstraint)
+	if err != nil {
+		return rawConstraint, nil, err
+	}
+
+	candidates := make([]provider, 0)
+	for _, p := range providers {
+		if p.capabilityID != req.CapabilityID {
+			continue
+		}
+		if p.scope != req.Scope {
+			continue
+		}
+		if !multiplicityCompatible(req.Multiplicity, p.multiplicity) {
+			continue
+		}
+		if !semver.Satisfies(p.version, constraint) {
+			continue
+		}
+		candidates = append(candidates, p)
+	}
+	return rawConstraint, candidates, nil
+}
+
+// multiplicityCompatible applies the multiplicity matrix; empty means "1".
+//   - require "1"    -> provider "1" or "many"
+//   - require "many" -> provider must be "many"
+func multiplicityCompatible(required, provided binderyv1alpha1.CapabilityMultiplicity) bool {
+	if strings.TrimSpace(string(required)) != string(binderyv1alpha1.MultiplicityMany) {
+		return true
+	}
+	return strings.TrimSpace(string(provided)) == string(binderyv1alpha1.MultiplicityMany)
+}
+
+// noProviderReason explains an empty candidate set. Scope and multiplicity mismatches
+// are named explicitly, since they are usually manifest typos rather than missing modules.
+func noProviderReason(req binderyv1alpha1.RequiredCapability, providers []provider) string {
+	var otherScopes []string
+	seen := map[binderyv1alpha1.CapabilityScope]bool{}
+	inScope, multiplicityOK := 0, 0
+	for _, p := range providers {
+		if p.capabilityID != req.CapabilityID {
+			continue
+		}
+		if p.scope != req.Scope {
+			if !seen[p.scope] {
+				seen[p.scope] = true
+				otherScopes = append(otherScopes, string(p.scope))
+			}
+			continue
+		}
+		inScope++
+		if multiplicityCompatible(req.Multiplicity, p.multiplicity) {
+			multiplicityOK++
+		}
+	}
+	switch {
+	case inScope > 0 && multiplicityOK == 0:
+		return "requires many but providers are single-instance"
+	case inScope == 0 && len(otherScopes) > 0:
+		sort.Strings(otherScopes)
+		return fmt.Sprintf("provider exists but scope %s != required %s", strings.Join(otherScopes, "/"), req.Scope)
+	default:
+		return "no compatible provider found"
+	}
+}
+
 func addUnresolved(diag *Diagnostics, consumerModuleName string, req binderyv1alpha1.RequiredCapability, reason string) {
 	unresolved := UnresolvedRequirement{
 		ConsumerModuleManifestName: consumerModuleName,
@@ -194,9 +337,13 @@ func addUnresolved(diag *Diagnostics, consumerModuleName string, req binderyv1al
 
 func selectProvidersDeterministic(multiplicity binderyv1alpha1.CapabilityMultiplicity, candidates []provider) []provider {
 	// Deterministic ordering:
-	// 1) Higher version wins
-	// 2) Tie-break: module name (ascending)
+	// 1) Non-deprecated providers win regardless of version
+	// 2) Higher version wins
+	// 3) Tie-break: module name (ascending)
 	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].deprecated != candidates[j].deprecated {
+			return !candidates[i].deprecated
+		}
 		vi := candidates[i].version
 		vj := candidates[j].version
 		cmp := semver.Compare(vi, vj)
@@ -212,6 +359,22 @@ func selectProvidersDeterministic(multiplicity binderyv1alpha1.CapabilityMultipl
 	return candidates[:1]
 }
 
+// boundProvider returns the candidate that an existing binding for the consumer's
+// requirement already points at, if it is still among the candidates.
+func boundProvider(existing []binderyv1alpha1.CapabilityBinding, consumerName string, req binderyv1alpha1.RequiredCapability, candidates []provider) (provider, bool) {
+	for _, b := range existing {
+		if b.Spec.Consumer.ModuleManifestName != consumerName || b.Spec.CapabilityID != req.CapabilityID || b.Spec.Scope != req.Scope {
+			continue
+		}
+		for _, p := range candidates {
+			if p.moduleName == b.Spec.Provider.ModuleManifestName && p.versionRaw == strings.TrimSpace(b.Spec.Provider.CapabilityVersion) {
+				return p, true
+			}
+		}
+	}
+	return provider{}, false
+}
+
 func isProvider(moduleName string, bindings []binderyv1alpha1.CapabilityBinding) bool {
 	for _, b := range bindings {
 		if b.Spec.Provider.ModuleManifestName == moduleName {
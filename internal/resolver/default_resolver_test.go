@@ -127,6 +127,97 @@ func TestDefaultResolver_TieBreaksByModuleName(t *testing.T) {
 	}
 }
 
+func TestDefaultResolver_PrefersNonDeprecatedProvider(t *testing.T) {
+	r := NewDefault()
+
+	in := Input{
+		World: binderyv1alpha1.WorldInstance{ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"}},
+		Modules: []binderyv1alpha1.ModuleManifest{
+			mm("provider-old", []binderyv1alpha1.ProvidedCapability{{
+				CapabilityID: "cap.time",
+				Version:      "2.0.0",
+				Scope:        binderyv1alpha1.CapabilityScopeWorld,
+				Multiplicity: binderyv1alpha1.MultiplicityOne,
+				Deprecated:   true,
+			}}, nil),
+			mm("provider-new", []binderyv1alpha1.ProvidedCapability{{
+				CapabilityID: "cap.time",
+				Version:      "1.0.0",
+				Scope:        binderyv1alpha1.CapabilityScopeWorld,
+				Multiplicity: binderyv1alpha1.MultiplicityOne,
+			}}, nil),
+			mm("consumer", nil, []binderyv1alpha1.RequiredCapability{{
+				CapabilityID:      "cap.time",
+				VersionConstraint: ">=1.0.0",
+				Scope:             binderyv1alpha1.CapabilityScopeWorld,
+				Multiplicity:      binderyv1alpha1.MultiplicityOne,
+				DependencyMode:    binderyv1alpha1.DependencyModeRequired,
+			}}),
+		},
+	}
+
+	plan, err := r.Resolve(context.Background(), in)
+	if err != nil {
+		t.Fatalf("Resolve error: %v", err)
+	}
+
+	var b *binderyv1alpha1.CapabilityBindingSpec
+	for i := range plan.DesiredBindings {
+		if plan.DesiredBindings[i].Spec.Consumer.ModuleManifestName == "consumer" {
+			b = &plan.DesiredBindings[i].Spec
+			break
+		}
+	}
+	if b == nil {
+		t.Fatal("expected binding for consumer 'consumer' not found")
+	}
+	if b.Provider.ModuleManifestName != "provider-new" {
+		t.Fatalf("expected non-deprecated provider-new despite lower version, got %q", b.Provider.ModuleManifestName)
+	}
+	if len(plan.Diagnostics.DeprecatedSelected) != 0 {
+		t.Fatalf("expected no deprecation diagnostics, got %+v", plan.Diagnostics.DeprecatedSelected)
+	}
+}
+
+func TestDefaultResolver_SelectsSoleDeprecatedProviderWithDiagnostic(t *testing.T) {
+	r := NewDefault()
+
+	in := Input{
+		World: binderyv1alpha1.WorldInstance{ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"}},
+		Modules: []binderyv1alpha1.ModuleManifest{
+			mm("provider-old", []binderyv1alpha1.ProvidedCapability{{
+				CapabilityID: "cap.time",
+				Version:      "1.0.0",
+				Scope:        binderyv1alpha1.CapabilityScopeWorld,
+				Multiplicity: binderyv1alpha1.MultiplicityOne,
+				Deprecated:   true,
+			}}, nil),
+			mm("consumer", nil, []binderyv1alpha1.RequiredCapability{{
+				CapabilityID:      "cap.time",
+				VersionConstraint: "*",
+				Scope:             binderyv1alpha1.CapabilityScopeWorld,
+				Multiplicity:      binderyv1alpha1.MultiplicityOne,
+				DependencyMode:    binderyv1alpha1.DependencyModeRequired,
+			}}),
+		},
+	}
+
+	plan, err := r.Resolve(context.Background(), in)
+	if err != nil {
+		t.Fatalf("Resolve error: %v", err)
+	}
+	if len(plan.Diagnostics.UnresolvedRequired) != 0 {
+		t.Fatalf("expected deprecated provider to satisfy the requirement, got %+v", plan.Diagnostics.UnresolvedRequired)
+	}
+	if len(plan.Diagnostics.DeprecatedSelected) != 1 {
+		t.Fatalf("expected 1 deprecation diagnostic, got %+v", plan.Diagnostics.DeprecatedSelected)
+	}
+	d := plan.Diagnostics.DeprecatedSelected[0]
+	if d.ConsumerModuleManifestName != "consumer" || d.ProviderModuleManifestName != "provider-old" || d.CapabilityID != "cap.time" {
+		t.Fatalf("unexpected deprecation diagnostic: %+v", d)
+	}
+}
+
 func TestDefaultResolver_MultiplicityManySelectsAll(t *testing.T) {
 	r := NewDefault()
 
@@ -237,6 +328,41 @@ func TestDefaultResolver_UnresolvedRequiredRecorded(t *testing.T) {
 	}
 }
 
+func TestDefaultResolver_ReportsScopeMismatch(t *testing.T) {
+	r := NewDefault()
+
+	in := Input{
+		World: binderyv1alpha1.WorldInstance{ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"}},
+		Modules: []binderyv1alpha1.ModuleManifest{
+			mm("physics", []binderyv1alpha1.ProvidedCapability{{
+				CapabilityID: "cap.physics",
+				Version:      "1.0.0",
+				Scope:        binderyv1alpha1.CapabilityScopeWorld,
+				Multiplicity: binderyv1alpha1.MultiplicityOne,
+			}}, nil),
+			mm("consumer", nil, []binderyv1alpha1.RequiredCapability{{
+				CapabilityID:      "cap.physics",
+				VersionConstraint: ">=1.0.0",
+				Scope:             binderyv1alpha1.CapabilityScopeWorldShard,
+				Multiplicity:      binderyv1alpha1.MultiplicityOne,
+				DependencyMode:    binderyv1alpha1.DependencyModeRequired,
+			}}),
+		},
+	}
+
+	plan, err := r.Resolve(context.Background(), in)
+	if err != nil {
+		t.Fatalf("Resolve error: %v", err)
+	}
+	if len(plan.Diagnostics.UnresolvedRequired) != 1 {
+		t.Fatalf("expected 1 unresolved required, got %d", len(plan.Diagnostics.UnresolvedRequired))
+	}
+	want := "provider exists but scope world != required world-shard"
+	if got := plan.Diagnostics.UnresolvedRequired[0].Reason; got != want {
+		t.Fatalf("expected reason %q, got %q", want, got)
+	}
+}
+
 func TestDefaultResolver_VersionIncompatibleRequired(t *testing.T) {
 	r := NewDefault()
 
@@ -423,7 +549,10 @@ func TestDefaultResolver_FiltersByMultiplicity(t *testing.T) {
 		t.Errorf("expected 0 non-root bindings, got %d", nonRoot)
 	}
 	if len(plan.Diagnostics.UnresolvedRequired) != 1 {
-		t.Errorf("expected 1 unresolved required, got %d", len(plan.Diagnostics.UnresolvedRequired))
+		t.Fatalf("expected 1 unresolved required, got %d", len(plan.Diagnostics.UnresolvedRequired))
+	}
+	if got := plan.Diagnostics.UnresolvedRequired[0].Reason; got != "requires many but providers are single-instance" {
+		t.Errorf("expected multiplicity mismatch reason, got %q", got)
 	}
 }
 
@@ -499,3 +628,176 @@ func TestDefaultResolver_RootBindingUsesModuleDefaultScope(t *testing.T) {
 		t.Fatalf("expected scope=world-shard, got %q", plan.DesiredBindings[0].Spec.Scope)
 	}
 }
+
+func TestDefaultResolver_RealmRefSelectsRealmProvider(t *testing.T) {
+	r := NewDefault()
+
+	chat := func(name, version string) binderyv1alpha1.ModuleManifest {
+		return mm(name, []binderyv1alpha1.ProvidedCapability{{
+			CapabilityID: "cap.chat",
+			Version:      version,
+			Scope:        binderyv1alpha1.CapabilityScopeRealm,
+			Multiplicity: binderyv1alpha1.MultiplicityOne,
+		}}, nil)
+	}
+	require := func(realm string) binderyv1alpha1.RequiredCapability {
+		req := binderyv1alpha1.RequiredCapability{
+			CapabilityID:      "cap.chat",
+			VersionConstraint: ">=1.0.0",
+			Scope:             binderyv1alpha1.CapabilityScopeRealm,
+			Multiplicity:      binderyv1alpha1.MultiplicityOne,
+			DependencyMode:    binderyv1alpha1.DependencyModeRequired,
+		}
+		if realm != "" {
+			req.RealmRef = &binderyv1alpha1.ObjectRef{Name: realm}
+		}
+		return req
+	}
+
+	in := Input{
+		World: binderyv1alpha1.WorldInstance{ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"}},
+		Modules: []binderyv1alpha1.ModuleManifest{
+			mm("lobby", nil, []binderyv1alpha1.RequiredCapability{require("realm-eu")}),
+			mm("guild", nil, []binderyv1alpha1.RequiredCapability{require("realm-apac")}),
+		},
+		RealmModules: map[string][]binderyv1alpha1.ModuleManifest{
+			// realm-us offers the higher version, which would win without a selector.
+			"realm-us": {chat("chat-us", "2.0.0")},
+			"realm-eu": {chat("chat-eu", "1.0.0")},
+		},
+	}
+
+	plan, err := r.Resolve(context.Background(), in)
+	if err != nil {
+		t.Fatalf("Resolve error: %v", err)
+	}
+
+	var lobby *binderyv1alpha1.CapabilityBindingSpec
+	for i := range plan.DesiredBindings {
+		if plan.DesiredBindings[i].Spec.Consumer.ModuleManifestName == "lobby" {
+			lobby = &plan.DesiredBindings[i].Spec
+		}
+	}
+	if lobby == nil {
+		t.Fatal("expected binding for consumer 'lobby'")
+	}
+	if lobby.Provider.ModuleManifestName != "chat-eu" {
+		t.Fatalf("expected realmRef to select chat-eu, got %q", lobby.Provider.ModuleManifestName)
+	}
+
+	if len(plan.Diagnostics.UnresolvedRequired) != 1 {
+		t.Fatalf("expected 1 unresolved required, got %+v", plan.Diagnostics.UnresolvedRequired)
+	}
+	got := plan.Diagnostics.UnresolvedRequired[0]
+	want := `realmRef "realm-apac" matches no compatible provider`
+	if got.ConsumerModuleManifestName != "guild" || got.Reason != want {
+		t.Fatalf("expected guild unresolved with %q, got %+v", want, got)
+	}
+}
+
+func TestDefaultResolver_RealmModulesOnlyServeRealmRef(t *testing.T) {
+	r := NewDefault()
+
+	req := binderyv1alpha1.RequiredCapability{
+		CapabilityID:      "cap.chat",
+		VersionConstraint: ">=1.0.0",
+		Scope:             binderyv1alpha1.CapabilityScopeRealm,
+		Multiplicity:      binderyv1alpha1.MultiplicityOne,
+		DependencyMode:    binderyv1alpha1.DependencyModeRequired,
+	}
+	in := Input{
+		World:   binderyv1alpha1.WorldInstance{ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"}},
+		Modules: []binderyv1alpha1.ModuleManifest{mm("lobby", nil, []binderyv1alpha1.RequiredCapability{req})},
+		RealmModules: map[string][]binderyv1alpha1.ModuleManifest{
+			"realm-eu": {mm("chat-eu", []binderyv1alpha1.ProvidedCapability{{
+				CapabilityID: "cap.chat",
+				Version:      "1.0.0",
+				Scope:        binderyv1alpha1.CapabilityScopeRealm,
+				Multiplicity: binderyv1alpha1.MultiplicityOne,
+			}}, nil)},
+		},
+	}
+
+	plan, err := r.Resolve(context.Background(), in)
+	if err != nil {
+		t.Fatalf("Resolve error: %v", err)
+	}
+	if len(plan.Diagnostics.UnresolvedRequired) != 1 || plan.Diagnostics.UnresolvedRequired[0].ConsumerModuleManifestName != "lobby" {
+		t.Fatalf("expected lobby unresolved without realmRef, got %+v", plan.Diagnostics.UnresolvedRequired)
+	}
+}
+
+func TestDefaultResolver_PreferExistingKeepsCompatibleBinding(t *testing.T) {
+	r := NewDefault()
+
+	physics := func(name, version string) binderyv1alpha1.ModuleManifest {
+		return mm(name, []binderyv1alpha1.ProvidedCapability{{
+			CapabilityID: "cap.physics",
+			Version:      version,
+			Scope:        binderyv1alpha1.CapabilityScopeWorld,
+			Multiplicity: binderyv1alpha1.MultiplicityOne,
+		}}, nil)
+	}
+	input := func(constraint string) Input {
+		return Input{
+			World: binderyv1alpha1.WorldInstance{ObjectMeta: metav1.ObjectMeta{Name: "world-1", Namespace: "default"}},
+			Modules: []binderyv1alpha1.ModuleManifest{
+				physics("physics-a", "1.0.0"),
+				// physics-b was added after physics-a was bound and would win on version.
+				physics("physics-b", "1.5.0"),
+				mm("interaction", nil, []binderyv1alpha1.RequiredCapability{{
+					CapabilityID:      "cap.physics",
+					VersionConstraint: constraint,
+					Scope:             binderyv1alpha1.CapabilityScopeWorld,
+					Multiplicity:      binderyv1alpha1.MultiplicityOne,
+					DependencyMode:    binderyv1alpha1.DependencyModeRequired,
+				}}),
+			},
+			ExistingBindings: []binderyv1alpha1.CapabilityBinding{{
+				Spec: binderyv1alpha1.CapabilityBindingSpec{
+					CapabilityID: "cap.physics",
+					Scope:        binderyv1alpha1.CapabilityScopeWorld,
+					Consumer:     binderyv1alpha1.ConsumerRef{ModuleManifestName: "interaction"},
+					Provider:     binderyv1alpha1.ProviderRef{ModuleManifestName: "physics-a", CapabilityVersion: "1.0.0"},
+				},
+			}},
+			PreferExisting: true,
+		}
+	}
+	providerFor := func(plan Plan) string {
+		for _, b := range plan.DesiredBindings {
+			if b.Spec.Consumer.ModuleManifestName == "interaction" {
+				return b.Spec.Provider.ModuleManifestName
+			}
+		}
+		return ""
+	}
+
+	plan, err := r.Resolve(context.Background(), input(">=1.0.0 <2.0.0"))
+	if err != nil {
+		t.Fatalf("Resolve error: %v", err)
+	}
+	if got := providerFor(plan); got != "physics-a" {
+		t.Fatalf("expected stickiness to keep physics-a, got %q", got)
+	}
+
+	// Raising the constraint past the bound version forces the switch.
+	plan, err = r.Resolve(context.Background(), input(">=1.5.0 <2.0.0"))
+	if err != nil {
+		t.Fatalf("Resolve error: %v", err)
+	}
+	if got := providerFor(plan); got != "physics-b" {
+		t.Fatalf("expected higher constraint to force physics-b, got %q", got)
+	}
+
+	// Without PreferExisting the newer provider wins as usual.
+	in := input(">=1.0.0 <2.0.0")
+	in.PreferExisting = false
+	plan, err = r.Resolve(context.Background(), in)
+	if err != nil {
+		t.Fatalf("Resolve error: %v", err)
+	}
+	if got := providerFor(plan); got != "physics-b" {
+		t.Fatalf("expected physics-b without stickiness, got %q", got)
+	}
+}
@@ -5,4 +5,7 @@ import "errors"
 var (
 	// ErrNotImplemented indicates the resolver scaffolding is present but business logic has not been implemented yet.
 	ErrNotImplemented = errors.New("capability resolver not implemented")
+
+	// ErrInvalidVersionConstraint indicates a requirement's versionConstraint does not parse.
+	ErrInvalidVersionConstraint = errors.New("invalid versionConstraint")
 )
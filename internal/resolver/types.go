@@ -11,9 +11,25 @@ type Input struct {
 	World   binderyv1alpha1.WorldInstance
 	Game    binderyv1alpha1.Booklet
 	Modules []binderyv1alpha1.ModuleManifest
-	// ExternalModules are modules available in the wider context (e.g. Realm/Cluster)
-	// that can satisfy requirements but are not part of the Booklet itself.
+	// ExternalModules are modules available in the wider context (e.g. the world's
+	// own Realm) that can satisfy requirements but are not part of the Booklet itself.
 	ExternalModules []binderyv1alpha1.ModuleManifest
+	// RealmModules are the modules of each Realm a requirement selects via realmRef,
+	// keyed by Realm name. They are the only candidates for such a requirement and
+	// are never offered to requirements without realmRef.
+	RealmModules map[string][]binderyv1alpha1.ModuleManifest
+	// CapabilityAliases declares capability ids that are equivalent for matching, e.g.
+	// {"physics.core": "physics.engine"} after a rename. Each entry works in both
+	// directions; bindings keep the id the consumer required.
+	CapabilityAliases map[string]string
+	// ExistingBindings are the world's current CapabilityBindings. They are only
+	// consulted when PreferExisting is set.
+	ExistingBindings []binderyv1alpha1.CapabilityBinding
+	// PreferExisting keeps a single-provider requirement on its currently-bound
+	// provider while that provider is still a compatible candidate, instead of
+	// switching to a newer one. A constraint the bound provider no longer
+	// satisfies still forces a switch.
+	PreferExisting bool
 }
 
 // Plan is the desired output of the resolver.
@@ -31,6 +47,9 @@ type Plan struct {
 type Diagnostics struct {
 	UnresolvedRequired []UnresolvedRequirement
 	UnresolvedOptional []UnresolvedRequirement
+	// DeprecatedSelected lists bindings whose provider is marked deprecated because
+	// no non-deprecated provider satisfied the requirement.
+	DeprecatedSelected []DeprecatedSelection
 }
 
 type UnresolvedRequirement struct {
@@ -39,3 +58,10 @@ type UnresolvedRequirement struct {
 	Scope                      binderyv1alpha1.CapabilityScope
 	Reason                     string
 }
+
+type DeprecatedSelection struct {
+	ConsumerModuleManifestName string
+	CapabilityID               string
+	ProviderModuleManifestName string
+	ProviderVersion            string
+}
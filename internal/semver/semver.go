@@ -2,6 +2,7 @@ package semver
 
 import (
 	"fmt"
+	"strings"
 
 	mm "github.com/Masterminds/semver/v3"
 )
@@ -19,8 +20,23 @@ type Version struct {
 // - ">=1.2.0 <2.0.0"
 // - "^1.0.0"
 // - "~1.4"
+//
+// Ranges follow npm semantics:
+// - ^x.y.z allows changes that do not modify the left-most non-zero component
+// (^1.2.3 := >=1.2.3 <2.0.0, ^0.2.3 := >=0.2.3 <0.3.0, ^0.0.3 := >=0.0.3 <0.0.4).
+// - ~x.y.z allows patch-level changes (~1.2.3 := >=1.2.3 <1.3.0).
+// - Prerelease versions only match when a comparator names a prerelease on the
+// same major.minor.patch tuple (^1.2.3-beta.2 matches 1.2.3-beta.4 but not
+// 1.2.4-beta.1).
 type Constraint struct {
 	c *mm.Constraints
+
+	// prereleaseTuples holds the major.minor.patch of every comparator that
+	// carries a prerelease tag.
+	prereleaseTuples map[[3]uint64]struct{}
+	// withPrerelease is c with a "-0" floor on every bare comparator, used to
+	// evaluate prerelease versions once their tuple has opted in.
+	withPrerelease *mm.Constraints
 }
 
 func ParseVersion(raw string) (Version, error) {
@@ -31,6 +47,30 @@ func ParseVersion(raw string) (Version, error) {
 	return Version{v: v}, nil
 }
 
+// Prerelease returns the prerelease segment (e.g. "rc.1" for "1.5.0-rc.1"), or "" if none.
+func (v Version) Prerelease() string {
+	if v.v == nil {
+		return ""
+	}
+	return v.v.Prerelease()
+}
+
+// Metadata returns the build metadata segment (e.g. "build.7" for "1.5.0+build.7"), or "" if none.
+func (v Version) Metadata() string {
+	if v.v == nil {
+		return ""
+	}
+	return v.v.Metadata()
+}
+
+// String returns the normalized version, including prerelease and build metadata.
+func (v Version) String() string {
+	if v.v == nil {
+		return ""
+	}
+	return v.v.String()
+}
+
 func MustParseVersion(raw string) Version {
 	v, err := ParseVersion(raw)
 	if err != nil {
@@ -44,7 +84,81 @@ func ParseConstraint(raw string) (Constraint, error) {
 	if err != nil {
 		return Constraint{}, fmt.Errorf("semver: parse constraint %q: %w", raw, err)
 	}
-	return Constraint{c: c}, nil
+	out := Constraint{c: c}
+	out.prereleaseTuples, out.withPrerelease = prereleaseVariants(raw)
+	return out, nil
+}
+
+// prereleaseVariants extracts the version tuples of comparators that include a
+// prerelease tag and, if there are any, builds the constraint used to evaluate
+// opted-in prereleases. The constraint has already been validated by the caller.
+func prereleaseVariants(raw string) (map[[3]uint64]struct{}, *mm.Constraints) {
+	var tuples map[[3]uint64]struct{}
+	var rewritten strings.Builder
+	for _, tok := range splitConstraint(raw) {
+		f := strings.TrimLeft(tok, "^~=<>!")
+		op := tok[:len(tok)-len(f)]
+		v, err := mm.StrictNewVersion(strings.TrimPrefix(f, "v"))
+		switch {
+		case err != nil:
+			// Separators, wildcards and partial versions are kept verbatim.
+		case v.Prerelease() != "":
+			if tuples == nil {
+				tuples = map[[3]uint64]struct{}{}
+			}
+			tuples[[3]uint64{v.Major(), v.Minor(), v.Patch()}] = struct{}{}
+		case strings.HasPrefix(op, "<"):
+			// Only prereleases are checked against this constraint, and a
+			// prerelease is below (or at) release X exactly when it is below
+			// the next patch's "-0" floor, so "<1.2.3" still admits 1.2.3-rc.1.
+			tok = fmt.Sprintf("<%d.%d.%d-0", v.Major(), v.Minor(), v.Patch()+1)
+		case strings.HasPrefix(op, ">") && !strings.HasPrefix(op, ">="):
+			// Likewise a prerelease is above release X only from the next
+			// patch's "-0" floor on, so ">1.2.3" must not admit 1.2.3-rc.1.
+			tok = fmt.Sprintf(">=%d.%d.%d-0", v.Major(), v.Minor(), v.Patch()+1)
+		case strings.HasPrefix(op, "!"):
+			// A prerelease never equals a release version.
+			tok = ">=0.0.0-0"
+		default:
+			// Lower bounds get a "-0" floor so prereleases of the bound count.
+			tok = op + f + "-0"
+		}
+		rewritten.WriteString(tok)
+	}
+	if tuples == nil {
+		return nil, nil
+	}
+	c, err := mm.NewConstraint(rewritten.String())
+	if err != nil {
+		return tuples, nil
+	}
+	return tuples, c
+}
+
+// splitConstraint splits raw into alternating comparator and separator tokens
+// so it can be reassembled after rewriting. An operator separated from its
+// version by whitespace (">= 1.2.3") is re-attached to it.
+func splitConstraint(raw string) []string {
+	var out []string
+	start := 0
+	isSep := func(r byte) bool { return r == ' ' || r == ',' || r == '|' || r == '\t' }
+	for i := 1; i <= len(raw); i++ {
+		if i == len(raw) || isSep(raw[i]) != isSep(raw[start]) {
+			tok := raw[start:i]
+			start = i
+			if n := len(out); n >= 2 && strings.TrimSpace(out[n-1]) == "" && isOperator(out[n-2]) {
+				out = append(out[:n-2], out[n-2]+tok)
+				continue
+			}
+			out = append(out, tok)
+		}
+	}
+	return out
+}
+
+// isOperator reports whether tok is a bare comparison operator with no version.
+func isOperator(tok string) bool {
+	return tok != "" && strings.TrimLeft(tok, "^~=<>!") == ""
 }
 
 func MustParseConstraint(raw string) Constraint {
@@ -59,14 +173,28 @@ func Satisfies(v Version, c Constraint) bool {
 	if v.v == nil || c.c == nil {
 		return false
 	}
+	if v.v.Prerelease() != "" {
+		if _, ok := c.prereleaseTuples[[3]uint64{v.v.Major(), v.v.Minor(), v.v.Patch()}]; !ok {
+			return false
+		}
+		if c.withPrerelease != nil {
+			return c.withPrerelease.Check(v.v)
+		}
+	}
 	return c.c.Check(v.v)
 }
 
-// Compare compares a and b, returning:
+// Compare compares a and b by SemVer 2.0 precedence, returning:
 // -1 if a < b
 //
 //	0 if a == b
 //	1 if a > b
+//
+// A release sorts above its prereleases (1.5.0-rc.1 < 1.5.0). Prerelease
+// identifiers are compared left to right: numeric identifiers numerically,
+// alphanumeric ones lexically, numeric below alphanumeric, and a shorter set
+// below a longer one with an equal prefix. Build metadata is ignored, so
+// 1.0.0+a and 1.0.0+b compare equal.
 func Compare(a, b Version) int {
 	if a.v == nil && b.v == nil {
 		return 0
@@ -78,3 +78,118 @@ func TestParse_Invalid(t *testing.T) {
 		t.Fatalf("expected error for invalid version")
 	}
 }
+
+func TestSatisfies_Ranges(t *testing.T) {
+	cases := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		// Caret: compatible-with.
+		{"^1.2.3", "1.2.3", true},
+		{"^1.2.3", "1.9.9", true},
+		{"^1.2.3", "1.2.2", false},
+		{"^1.2.3", "2.0.0", false},
+		{"^0.2.3", "0.2.3", true},
+		{"^0.2.3", "0.2.9", true},
+		{"^0.2.3", "0.3.0", false},
+		{"^0.0.3", "0.0.3", true},
+		{"^0.0.3", "0.0.4", false},
+
+		// Tilde: approximately.
+		{"~1.2.3", "1.2.3", true},
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"~1.2.3", "1.2.2", false},
+		{"~1.2", "1.2.0", true},
+		{"~1", "1.9.0", true},
+		{"~1", "2.0.0", false},
+
+		// Prereleases are excluded unless the constraint opts in on the same tuple.
+		{"^1.2.3", "1.5.0-rc.1", false},
+		{"~1.2.3", "1.2.4-rc.1", false},
+		{">=1.0.0", "1.0.0-alpha", false},
+		{"^1.2.3-beta.2", "1.2.3-beta.4", true},
+		{"^1.2.3-beta.2", "1.2.3-alpha", false},
+		{"^1.2.3-beta.2", "1.2.4-beta.1", false},
+		{"^1.2.3-beta.2", "1.2.3", true},
+		{"^1.2.3-beta.2", "1.9.0", true},
+		{"~1.2.3-beta.2", "1.2.3-beta.3", true},
+		{">=1.0.0-rc.1 <2.0.0", "1.0.0-rc.2", true},
+		{">=1.2.3-beta.1, <1.2.3", "1.2.3-rc.1", true},
+		{">=1.2.3-beta.1, <=1.2.2", "1.2.3-rc.1", false},
+		{">=1.2.3-beta.1, <=1.2.3", "1.2.3-rc.1", true},
+		{">=1.2.3-beta.1, <1.2.3", "1.2.3", false},
+		{">=1.2.3-beta.1, !=1.2.3", "1.2.3-rc.1", true},
+		{">=1.2.3-beta.1 >1.2.3", "1.2.3-rc.1", false},
+		{">=1.2.3-beta.1, >1.2.2", "1.2.3-rc.1", true},
+		{">= 1.2.3-beta.1 < 1.2.3", "1.2.3-beta.3", true},
+		{">= 1.2.3-beta.1, < 1.2.3", "1.2.3-alpha.1", false},
+		{"^1.0.0 || ^2.0.0-rc.1", "2.0.0-rc.2", true},
+		{"^1.0.0 || ^2.0.0-rc.1", "1.1.0-rc.1", false},
+	}
+
+	for _, tc := range cases {
+		c := MustParseConstraint(tc.constraint)
+		if got := Satisfies(MustParseVersion(tc.version), c); got != tc.want {
+			t.Errorf("Satisfies(%q, %q) = %t, want %t", tc.version, tc.constraint, got, tc.want)
+		}
+	}
+}
+
+func TestCompare_Precedence(t *testing.T) {
+	// Ascending order per SemVer 2.0 section 11.
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.5.0-rc.1",
+		"1.5.0",
+	}
+	for i := 0; i+1 < len(ordered); i++ {
+		a, b := MustParseVersion(ordered[i]), MustParseVersion(ordered[i+1])
+		if Compare(a, b) != -1 {
+			t.Errorf("expected %s < %s", ordered[i], ordered[i+1])
+		}
+		if Compare(b, a) != 1 {
+			t.Errorf("expected %s > %s", ordered[i+1], ordered[i])
+		}
+	}
+}
+
+func TestCompare_IgnoresBuildMetadata(t *testing.T) {
+	a := MustParseVersion("1.0.0+build.1")
+	b := MustParseVersion("1.0.0+build.2")
+	if Compare(a, b) != 0 {
+		t.Fatalf("expected build metadata to be ignored")
+	}
+	if Compare(MustParseVersion("1.0.0-rc.1+x"), MustParseVersion("1.0.0")) != -1 {
+		t.Fatalf("expected 1.0.0-rc.1+x < 1.0.0")
+	}
+}
+
+func TestParseVersion_Segments(t *testing.T) {
+	v := MustParseVersion("1.5.0-rc.1+build.7")
+	if v.Prerelease() != "rc.1" {
+		t.Fatalf("expected prerelease rc.1, got %q", v.Prerelease())
+	}
+	if v.Metadata() != "build.7" {
+		t.Fatalf("expected metadata build.7, got %q", v.Metadata())
+	}
+	if v.String() != "1.5.0-rc.1+build.7" {
+		t.Fatalf("unexpected string %q", v.String())
+	}
+}
+
+func TestMaxSatisfying_PrefersReleaseOverPrerelease(t *testing.T) {
+	c := MustParseConstraint(">=1.5.0-rc.1")
+	best, ok := MaxSatisfying(c, []Version{MustParseVersion("1.5.0-rc.1"), MustParseVersion("1.5.0")})
+	if !ok || best.String() != "1.5.0" {
+		t.Fatalf("expected 1.5.0, got %q (ok=%t)", best.String(), ok)
+	}
+}
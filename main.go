@@ -32,10 +32,25 @@ func main() {
 	var metricsAddr string
 	var probeAddr string
 	var enableLeaderElection bool
+	var enableWebhooks bool
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false, "Enable leader election for controller manager.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false, "Serve admission webhooks (requires serving certificates, see config/webhook).")
+
+	var preferExistingBindings bool
+	flag.BoolVar(&preferExistingBindings, "prefer-existing-bindings", false, "Keep single-provider requirements on their currently-bound provider while it stays compatible, instead of switching to a newer one.")
+	capabilityAliases := controllers.CapabilityAliasFlag{}
+	flag.Var(capabilityAliases, "capability-alias", "Capability ids to match as equivalent, as old=new pairs (comma-separated or repeated), e.g. physics.core=physics.engine.")
+
+	// Per-controller concurrency and requeue backoff, e.g. -capabilityresolver-max-concurrent-reconciles=4.
+	controllerOpts := map[string]*controllers.ControllerOptions{}
+	for _, name := range []string{"capabilityresolver", "modulemanifest", "runtimeorchestrator", "worldshard", "storageorchestrator", "realm", "shardautoscaler"} {
+		o := controllers.DefaultControllerOptions()
+		o.BindFlags(flag.CommandLine, name)
+		controllerOpts[name] = &o
+	}
 
 	opts := zap.Options{Development: true}
 	opts.BindFlags(flag.CommandLine)
@@ -66,15 +81,32 @@ func main() {
 		Scheme:   mgr.GetScheme(),
 		Resolver: resolver.NewDefault(),
 		Recorder: mgr.GetEventRecorderFor("CapabilityResolver"),
+		Options:  *controllerOpts["capabilityresolver"],
+
+		CapabilityAliases: capabilityAliases,
+		PreferExisting:    preferExistingBindings,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "CapabilityResolver")
 		os.Exit(1)
 	}
 
+	if err := (&controllers.ModuleManifestReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("ModuleManifest"),
+		Options:  *controllerOpts["modulemanifest"],
+
+		CapabilityAliases: capabilityAliases,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ModuleManifest")
+		os.Exit(1)
+	}
+
 	if err := (&controllers.RuntimeOrchestratorReconciler{
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
 		Recorder: mgr.GetEventRecorderFor("RuntimeOrchestrator"),
+		Options:  *controllerOpts["runtimeorchestrator"],
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "RuntimeOrchestrator")
 		os.Exit(1)
@@ -84,6 +116,7 @@ func main() {
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
 		Recorder: mgr.GetEventRecorderFor("WorldShard"),
+		Options:  *controllerOpts["worldshard"],
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "WorldShard")
 		os.Exit(1)
@@ -93,6 +126,7 @@ func main() {
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
 		Recorder: mgr.GetEventRecorderFor("StorageOrchestrator"),
+		Options:  *controllerOpts["storageorchestrator"],
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "StorageOrchestrator")
 		os.Exit(1)
@@ -102,6 +136,7 @@ func main() {
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
 		Recorder: mgr.GetEventRecorderFor("Realm"),
+		Options:  *controllerOpts["realm"],
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Realm")
 		os.Exit(1)
@@ -112,11 +147,23 @@ func main() {
 		Scheme:        mgr.GetScheme(),
 		Recorder:      mgr.GetEventRecorderFor("ShardAutoscaler"),
 		MetricsClient: metricsClient,
+		Options:       *controllerOpts["shardautoscaler"],
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ShardAutoscaler")
 		os.Exit(1)
 	}
 
+	if enableWebhooks {
+		if err := binderyv1alpha1.SetupModuleManifestWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ModuleManifest")
+			os.Exit(1)
+		}
+		if err := binderyv1alpha1.SetupWorldInstanceWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "WorldInstance")
+			os.Exit(1)
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -28,6 +28,7 @@ import (
 	"google.golang.org/grpc"
 
 	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+	"github.com/bayleafwalker/bindery-core/internal/moduleserver"
 	"github.com/bayleafwalker/bindery-core/modules/physics-engine-template/publish"
 )
 
@@ -127,7 +128,8 @@ func (s *server) GetStateSnapshot(ctx context.Context, req *enginev1.GetStateSna
 	// TODO(physics): Return a snapshot of physics-relevant world state.
 	// - Support latest vs at_tick selectors
 	// - Optionally filter entities
-	// - Consider compression and/or delta encoding
+	// - Honor req.compress via GetStateSnapshotOk.compressed_world_state
+	// - Consider delta encoding
 
 	worldID := req.GetWorldId()
 	if worldID == "" {
@@ -175,8 +177,18 @@ func main() {
 		panic(fmt.Errorf("listen %s: %w", listenAddr, err))
 	}
 
-	grpcServer := grpc.NewServer()
+	// Serves TLS when the orchestrator mounts a certificate (spec.runtime.tls).
+	tlsOpts, err := moduleserver.TLSServerOptionsFromEnv()
+	if err != nil {
+		panic(err)
+	}
+
+	grpcServer := grpc.NewServer(tlsOpts...)
 	enginev1.RegisterEngineModuleServer(grpcServer, &server{publisher: pub})
+	healthServer := moduleserver.RegisterStandardServices(grpcServer)
+
+	// TODO(physics): Mark serving only after world state is ready to accept RPCs.
+	moduleserver.MarkServing(healthServer)
 
 	if err := grpcServer.Serve(lis); err != nil {
 		panic(fmt.Errorf("grpc serve: %w", err))
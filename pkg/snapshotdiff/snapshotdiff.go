@@ -0,0 +1,160 @@
+// Package snapshotdiff computes the difference between two engine WorldState
+// snapshots, so clients that poll GetStateSnapshot can forward only what
+// changed (for example over server-sent events) instead of the full state.
+package snapshotdiff
+
+import (
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+)
+
+// Diff describes how a world changed between two snapshots. Slices are sorted
+// by entity id (and component key within an entity), so equal inputs always
+// produce equal diffs.
+type Diff struct {
+	FromTick int64
+	ToTick   int64
+
+	// Added holds entities present only in the newer snapshot.
+	Added []*enginev1.Entity
+	// Removed holds the ids of entities present only in the older snapshot.
+	Removed []string
+	// Changed holds entities present in both snapshots that differ.
+	Changed []EntityChange
+}
+
+// Empty reports whether the snapshots hold the same entities.
+func (d *Diff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// EntityChange describes how one entity differs between the snapshots.
+type EntityChange struct {
+	EntityID string
+
+	// Type is the new entity type when it changed, otherwise empty.
+	Type string
+
+	// Metadata holds keys that were added or whose value changed, with the new
+	// value; RemovedMetadata holds keys that were dropped.
+	Metadata        map[string]string
+	RemovedMetadata []string
+
+	// Components lists the components that were added, removed, or changed.
+	Components []ComponentChange
+}
+
+// ComponentChange describes one component of an entity. Before is nil for an
+// added component and After is nil for a removed one.
+type ComponentChange struct {
+	// Key identifies the component within its entity: its type, with
+	// "#<n>" appended for the n-th occurrence of a repeated type ("health",
+	// "health#2", ...).
+	Key    string
+	Before *enginev1.Component
+	After  *enginev1.Component
+}
+
+// Compute returns the diff that turns from into to. Either snapshot may be
+// nil, which is treated as an empty world. Entities and components are shared
+// with the inputs, not copied.
+func Compute(from, to *enginev1.WorldState) *Diff {
+	d := &Diff{FromTick: from.GetTick(), ToTick: to.GetTick()}
+
+	before := make(map[string]*enginev1.Entity, len(from.GetEntities()))
+	for _, e := range from.GetEntities() {
+		before[e.GetEntityId()] = e
+	}
+	seen := make(map[string]struct{}, len(to.GetEntities()))
+	for _, e := range to.GetEntities() {
+		id := e.GetEntityId()
+		seen[id] = struct{}{}
+		old, ok := before[id]
+		if !ok {
+			d.Added = append(d.Added, e)
+			continue
+		}
+		if c, changed := diffEntity(old, e); changed {
+			d.Changed = append(d.Changed, c)
+		}
+	}
+	for id := range before {
+		if _, ok := seen[id]; !ok {
+			d.Removed = append(d.Removed, id)
+		}
+	}
+
+	sort.Slice(d.Added, func(i, j int) bool { return d.Added[i].GetEntityId() < d.Added[j].GetEntityId() })
+	sort.Strings(d.Removed)
+	sort.Slice(d.Changed, func(i, j int) bool { return d.Changed[i].EntityID < d.Changed[j].EntityID })
+	return d
+}
+
+func diffEntity(old, cur *enginev1.Entity) (EntityChange, bool) {
+	c := EntityChange{EntityID: cur.GetEntityId()}
+	changed := false
+
+	if old.GetType() != cur.GetType() {
+		c.Type = cur.GetType()
+		changed = true
+	}
+
+	for k, v := range cur.GetMetadata() {
+		if prev, ok := old.GetMetadata()[k]; !ok || prev != v {
+			if c.Metadata == nil {
+				c.Metadata = map[string]string{}
+			}
+			c.Metadata[k] = v
+			changed = true
+		}
+	}
+	for k := range old.GetMetadata() {
+		if _, ok := cur.GetMetadata()[k]; !ok {
+			c.RemovedMetadata = append(c.RemovedMetadata, k)
+			changed = true
+		}
+	}
+	sort.Strings(c.RemovedMetadata)
+
+	oldComponents := keyComponents(old.GetComponents())
+	curComponents := keyComponents(cur.GetComponents())
+	for key, after := range curComponents {
+		before, ok := oldComponents[key]
+		if !ok {
+			c.Components = append(c.Components, ComponentChange{Key: key, After: after})
+		} else if !proto.Equal(before, after) {
+			c.Components = append(c.Components, ComponentChange{Key: key, Before: before, After: after})
+		}
+	}
+	for key, before := range oldComponents {
+		if _, ok := curComponents[key]; !ok {
+			c.Components = append(c.Components, ComponentChange{Key: key, Before: before})
+		}
+	}
+	sort.Slice(c.Components, func(i, j int) bool { return c.Components[i].Key < c.Components[j].Key })
+
+	return c, changed || len(c.Components) > 0
+}
+
+// keyComponents indexes components by type, numbering repeated types in order.
+func keyComponents(components []*enginev1.Component) map[string]*enginev1.Component {
+	out := make(map[string]*enginev1.Component, len(components))
+	counts := map[string]int{}
+	for _, comp := range components {
+		if comp == nil {
+			continue
+		}
+		t := comp.GetType()
+		counts[t]++
+		key := t
+		if n := counts[t]; n > 1 {
+			key = fmt.Sprintf("%s#%d", t, n)
+		}
+		out[key] = comp
+	}
+	return out
+}
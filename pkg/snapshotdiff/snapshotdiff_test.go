@@ -0,0 +1,109 @@
+package snapshotdiff
+
+import (
+	"reflect"
+	"testing"
+
+	enginev1 "github.com/bayleafwalker/bindery-core/contracts/proto/game/engine/v1"
+)
+
+func entity(id string, x float64, hp int32) *enginev1.Entity {
+	return &enginev1.Entity{
+		EntityId: id,
+		Type:     "demo",
+		Components: []*enginev1.Component{
+			{Type: "transform", Payload: &enginev1.Component_Transform{Transform: &enginev1.TransformComponent{Position: &enginev1.Vec3{X: x}}}},
+			{Type: "health", Payload: &enginev1.Component_Health{Health: &enginev1.HealthComponent{Current: hp, Max: 100}}},
+		},
+		Metadata: map[string]string{"spawnedBy": "a1"},
+	}
+}
+
+func TestCompute(t *testing.T) {
+	from := &enginev1.WorldState{Tick: 10, Entities: []*enginev1.Entity{
+		entity("mover", 0, 100),
+		entity("victim", 5, 100),
+		entity("idle", 1, 50),
+		entity("gone", 2, 100),
+	}}
+	to := &enginev1.WorldState{Tick: 11, Entities: []*enginev1.Entity{
+		entity("victim", 5, 70),
+		entity("mover", 3, 100),
+		entity("idle", 1, 50),
+		entity("new", 0, 100),
+	}}
+
+	d := Compute(from, to)
+	if d.FromTick != 10 || d.ToTick != 11 {
+		t.Fatalf("unexpected ticks %d -> %d", d.FromTick, d.ToTick)
+	}
+	if len(d.Added) != 1 || d.Added[0].GetEntityId() != "new" {
+		t.Fatalf("expected new to be added, got %v", d.Added)
+	}
+	if !reflect.DeepEqual(d.Removed, []string{"gone"}) {
+		t.Fatalf("expected gone to be removed, got %v", d.Removed)
+	}
+	if len(d.Changed) != 2 || d.Changed[0].EntityID != "mover" || d.Changed[1].EntityID != "victim" {
+		t.Fatalf("expected mover and victim to change (idle unchanged), got %+v", d.Changed)
+	}
+
+	moved := d.Changed[0]
+	if len(moved.Components) != 1 || moved.Components[0].Key != "transform" {
+		t.Fatalf("expected only the transform to change, got %+v", moved.Components)
+	}
+	if got := moved.Components[0].After.GetTransform().GetPosition().GetX(); got != 3 {
+		t.Fatalf("expected moved x=3, got %v", got)
+	}
+	if moved.Type != "" || moved.Metadata != nil || moved.RemovedMetadata != nil {
+		t.Fatalf("expected no entity-level changes for the move, got %+v", moved)
+	}
+
+	damaged := d.Changed[1]
+	if len(damaged.Components) != 1 || damaged.Components[0].Key != "health" {
+		t.Fatalf("expected only health to change, got %+v", damaged.Components)
+	}
+	hp := damaged.Components[0]
+	if hp.Before.GetHealth().GetCurrent() != 100 || hp.After.GetHealth().GetCurrent() != 70 {
+		t.Fatalf("expected health 100 -> 70, got %v -> %v", hp.Before.GetHealth(), hp.After.GetHealth())
+	}
+}
+
+func TestCompute_MetadataAndComponentSetChanges(t *testing.T) {
+	old := entity("e1", 0, 100)
+	cur := entity("e1", 0, 100)
+	cur.Metadata = map[string]string{"tags": "boss"}
+	cur.Components = append(cur.Components[:1], &enginev1.Component{Type: "transform", Payload: &enginev1.Component_Opaque{Opaque: []byte("x")}})
+
+	d := Compute(&enginev1.WorldState{Entities: []*enginev1.Entity{old}}, &enginev1.WorldState{Entities: []*enginev1.Entity{cur}})
+	if len(d.Changed) != 1 {
+		t.Fatalf("expected one changed entity, got %+v", d)
+	}
+	c := d.Changed[0]
+	if !reflect.DeepEqual(c.Metadata, map[string]string{"tags": "boss"}) || !reflect.DeepEqual(c.RemovedMetadata, []string{"spawnedBy"}) {
+		t.Fatalf("unexpected metadata change %+v / %v", c.Metadata, c.RemovedMetadata)
+	}
+	var keys []string
+	for _, cc := range c.Components {
+		keys = append(keys, cc.Key)
+	}
+	if !reflect.DeepEqual(keys, []string{"health", "transform#2"}) {
+		t.Fatalf("expected health removed and a second transform added, got %v", keys)
+	}
+	if c.Components[0].After != nil || c.Components[1].Before != nil {
+		t.Fatalf("unexpected before/after on component changes: %+v", c.Components)
+	}
+}
+
+func TestCompute_NilAndIdenticalSnapshots(t *testing.T) {
+	state := &enginev1.WorldState{Tick: 3, Entities: []*enginev1.Entity{entity("e1", 0, 1)}}
+	if d := Compute(state, state); !d.Empty() {
+		t.Fatalf("expected an empty diff for identical snapshots, got %+v", d)
+	}
+	d := Compute(nil, state)
+	if len(d.Added) != 1 || d.FromTick != 0 || d.ToTick != 3 {
+		t.Fatalf("expected a nil base to add every entity, got %+v", d)
+	}
+	if d := Compute(state, nil); !reflect.DeepEqual(d.Removed, []string{"e1"}) {
+		t.Fatalf("expected a nil target to remove every entity, got %+v", d)
+	}
+}